@@ -0,0 +1,105 @@
+package enterprisekgateway
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// DefaultClockSkewSeconds is the clock skew allowed when verifying JWT time constraints.
+	DefaultClockSkewSeconds int32 = 60
+	// DefaultJWKSCacheDuration is how long fetched remote JWKS are cached.
+	DefaultJWKSCacheDuration = 5 * time.Minute
+)
+
+// These are the keys read from the Secrets referenced by a RedisClientConfig when its key
+// fields are not set. They are applied by the consumers of the references rather than
+// defaulted into the objects.
+const (
+	// DefaultRedisCACertKey is the key of the CA certificate in the Secret of
+	// RedisCerts.CACertSecretRef when CACertKey is not set.
+	DefaultRedisCACertKey = "ca.crt"
+	// DefaultRedisPasswordKey is the key of the password in the Secret of a RedisSecretAuth
+	// when PasswordKey is not set.
+	DefaultRedisPasswordKey = "password"
+	// DefaultRedisUsernameKey is the key of the username in the Secret of a RedisSecretAuth
+	// when UsernameKey is not set.
+	DefaultRedisUsernameKey = "username"
+)
+
+func init() {
+	localSchemeBuilder.Register(addDefaultingFuncs)
+}
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+func SetDefaults_TransformationRequestMatcher(obj *TransformationRequestMatcher) {
+	if obj.CaseSensitive == nil {
+		obj.CaseSensitive = ptr.To(true)
+	}
+}
+
+func SetDefaults_TransformationTemplate(obj *TransformationTemplate) {
+	if obj.ParseBodyBehavior == nil {
+		obj.ParseBodyBehavior = ptr.To(ParseAsJson)
+	}
+	// defaulter-gen does not descend into map values, so extractors are defaulted here.
+	for name, extraction := range obj.Extractors {
+		SetDefaults_Extraction(&extraction)
+		obj.Extractors[name] = extraction
+	}
+}
+
+func SetDefaults_Extraction(obj *Extraction) {
+	if obj.Mode == nil {
+		obj.Mode = ptr.To(ModeExtract)
+	}
+}
+
+func SetDefaults_EntJWT(obj *EntJWT) {
+	// validationPolicy can not be set together with disable.
+	if obj.ValidationPolicy == nil && obj.Disable == nil {
+		obj.ValidationPolicy = ptr.To(ValidationPolicyRequireValid)
+	}
+	// defaulter-gen does not descend into map values, so providers are defaulted here.
+	for name, provider := range obj.Providers {
+		SetDefaults_JWTProvider(&provider)
+		if provider.JWKS.Remote != nil {
+			SetDefaults_RemoteJWKS(provider.JWKS.Remote)
+		}
+		obj.Providers[name] = provider
+	}
+}
+
+func SetDefaults_JWTProvider(obj *JWTProvider) {
+	if obj.ClockSkewSeconds == nil {
+		obj.ClockSkewSeconds = ptr.To(DefaultClockSkewSeconds)
+	}
+}
+
+func SetDefaults_RemoteJWKS(obj *RemoteJWKS) {
+	if obj.CacheDuration == nil {
+		obj.CacheDuration = &metav1.Duration{Duration: DefaultJWKSCacheDuration}
+	}
+}
+
+func SetDefaults_EntRBAC(obj *EntRBAC) {
+	// defaulter-gen does not descend into map values, so policies are defaulted here.
+	for name, policy := range obj.Policies {
+		for i := range policy.Principals {
+			SetDefaults_RBACJWTPrincipal(&policy.Principals[i].JWTPrincipal)
+		}
+		obj.Policies[name] = policy
+	}
+}
+
+func SetDefaults_RBACJWTPrincipal(obj *RBACJWTPrincipal) {
+	if obj.Matcher == nil {
+		obj.Matcher = ptr.To(JwtPrincipalClaimMatcherExactString)
+	}
+}
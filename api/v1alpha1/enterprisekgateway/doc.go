@@ -2,6 +2,7 @@ package enterprisekgateway
 
 // +k8s:openapi-gen=true
 // +kubebuilder:object:generate=true
+// +k8s:defaulter-gen=TypeMeta
 // +groupName=enterprisekgateway.solo.io
 // +versionName=v1alpha1
 
@@ -0,0 +1,129 @@
+package enterprisekgateway
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// The Validate methods in this file mirror the OpenAPI and oneOf markers of the shared
+// extensions of EnterpriseKgatewayParametersSpec, like the ones of validation.go. The
+// upstream kgateway proxy settings are not checked.
+
+var (
+	supportedRedisSocketTypes = []string{"tcp", "tls", "unix"}
+	supportedWAFLogLevels     = []string{
+		string(WAFLogLevelError),
+		string(WAFLogLevelWarn),
+		string(WAFLogLevelInfo),
+		string(WAFLogLevelDebug),
+		string(WAFLogLevelTrace),
+	}
+)
+
+// Validate validates the spec against the constraints declared on its fields.
+func (s *EnterpriseKgatewayParametersSpec) Validate() field.ErrorList {
+	return s.validate(field.NewPath("spec"))
+}
+
+func (s *EnterpriseKgatewayParametersSpec) validate(fldPath *field.Path) field.ErrorList {
+	if s.Kube == nil || s.Kube.SharedExtensions == nil {
+		return nil
+	}
+	return s.Kube.SharedExtensions.validate(fldPath.Child("kube", "sharedExtensions"))
+}
+
+func (e *Extensions) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if e.ExtAuth != nil {
+		p := fldPath.Child("extauth")
+		allErrs = append(allErrs, e.ExtAuth.DeploymentConfiguration.validate(p)...)
+		if e.ExtAuth.SessionRedis != nil {
+			allErrs = append(allErrs, e.ExtAuth.SessionRedis.validate(p.Child("sessionRedis"))...)
+		}
+	}
+	if e.RateLimiter != nil {
+		p := fldPath.Child("ratelimiter")
+		allErrs = append(allErrs, e.RateLimiter.DeploymentConfiguration.validate(p)...)
+		if e.RateLimiter.Redis != nil {
+			allErrs = append(allErrs, e.RateLimiter.Redis.validate(p.Child("redis"))...)
+		}
+	}
+	if e.ExtCache != nil {
+		allErrs = append(allErrs, e.ExtCache.validate(fldPath.Child("extCache"))...)
+	}
+	if e.WAF != nil {
+		p := fldPath.Child("waf")
+		allErrs = append(allErrs, e.WAF.DeploymentConfiguration.validate(p)...)
+		if e.WAF.LogLevel != nil {
+			allErrs = append(allErrs, validateEnum(p.Child("logLevel"), string(*e.WAF.LogLevel), supportedWAFLogLevels)...)
+		}
+	}
+	return allErrs
+}
+
+func (d *DeploymentConfiguration) validate(fldPath *field.Path) field.ErrorList {
+	if d.Replicas != nil {
+		return validateMinimum(fldPath.Child("replicas"), int64(*d.Replicas), 0)
+	}
+	return nil
+}
+
+// Validate validates the Redis client configuration.
+func (r *RedisClientConfig) Validate() field.ErrorList {
+	return r.validate(nil)
+}
+
+func (r *RedisClientConfig) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Address == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("address"), ""))
+	}
+	if r.DB != nil {
+		allErrs = append(allErrs, validateMinimum(fldPath.Child("db"), int64(*r.DB), 0)...)
+	}
+	if r.SocketType != nil {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("socketType"), *r.SocketType, supportedRedisSocketTypes)...)
+	}
+	if r.Auth != nil {
+		allErrs = append(allErrs, r.Auth.validate(fldPath.Child("auth"))...)
+	}
+	if c := r.Connection; c != nil {
+		p := fldPath.Child("connection")
+		if c.PoolSize != nil {
+			allErrs = append(allErrs, validateMinimum(p.Child("poolSize"), int64(*c.PoolSize), 1)...)
+		}
+		if c.MinIdleConns != nil {
+			allErrs = append(allErrs, validateMinimum(p.Child("minIdleConns"), int64(*c.MinIdleConns), 0)...)
+		}
+		if c.MaxIdleConns != nil {
+			allErrs = append(allErrs, validateMinimum(p.Child("maxIdleConns"), int64(*c.MaxIdleConns), 0)...)
+		}
+		if c.MaxRetries != nil {
+			allErrs = append(allErrs, validateMinimum(p.Child("maxRetries"), int64(*c.MaxRetries), 0)...)
+		}
+	}
+	return allErrs
+}
+
+func (a *RedisAuth) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, exactlyOneOf(fldPath,
+		oneOfField{"secretRef", a.SecretRef != nil},
+		oneOfField{"aws", a.AWS != nil},
+	)...)
+	if a.SecretRef != nil && a.SecretRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretRef", "name"), ""))
+	}
+	if aws := a.AWS; aws != nil {
+		p := fldPath.Child("aws")
+		for _, f := range []struct{ name, value string }{
+			{"region", aws.Region},
+			{"clusterName", aws.ClusterName},
+			{"userName", aws.UserName},
+		} {
+			if f.value == "" {
+				allErrs = append(allErrs, field.Required(p.Child(f.name), ""))
+			}
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,188 @@
+package enterprisekgateway
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RBACRequest describes an authenticated request evaluated against EntRBAC policies.
+// +kubebuilder:object:generate=false
+type RBACRequest struct {
+	// Claims is the verified JWT payload, as decoded by encoding/json.
+	Claims map[string]interface{}
+
+	// Provider is the name of the JWT provider that verified the token.
+	// Principals that set a provider only match requests from that provider.
+	Provider string
+
+	// Path is the request path. A query string, if present, is ignored.
+	Path string
+
+	// Method is the HTTP method of the request.
+	Method string
+}
+
+// RBACDecision is the result of evaluating an RBACRequest.
+// +kubebuilder:object:generate=false
+type RBACDecision struct {
+	// Allowed reports whether the request is admitted.
+	Allowed bool
+
+	// Disabled reports whether the request was admitted because RBAC is disabled.
+	Disabled bool
+
+	// Policy is the name of the policy that admitted the request.
+	Policy string
+
+	// PrincipalIndex is the index of the admitting principal within Policy, or -1.
+	PrincipalIndex int
+
+	// Principal is the principal that admitted the request.
+	Principal *RBACPrincipal
+}
+
+// Evaluate evaluates req against the RBAC configuration the same way the data plane does:
+// a request is allowed if any policy has a principal whose claims all match and whose
+// permissions admit the request path and method. Policies are evaluated in name order,
+// and the first admitting policy and principal are reported.
+func (r *EntRBAC) Evaluate(req RBACRequest) RBACDecision {
+	if r.Disable != nil {
+		return RBACDecision{Allowed: true, Disabled: true, PrincipalIndex: -1}
+	}
+
+	names := make([]string, 0, len(r.Policies))
+	for name := range r.Policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		policy := r.Policies[name]
+		if idx, ok := policy.Evaluate(req); ok {
+			return RBACDecision{
+				Allowed:        true,
+				Policy:         name,
+				PrincipalIndex: idx,
+				Principal:      &policy.Principals[idx],
+			}
+		}
+	}
+	return RBACDecision{PrincipalIndex: -1}
+}
+
+// Evaluate reports whether the policy admits req, and the index of the first matching principal.
+func (p *RBACPolicy) Evaluate(req RBACRequest) (int, bool) {
+	if p.Permissions != nil && !p.Permissions.Matches(req.Path, req.Method) {
+		return -1, false
+	}
+	delimiter := ""
+	if p.NestedClaimDelimiter != nil {
+		delimiter = *p.NestedClaimDelimiter
+	}
+	for i := range p.Principals {
+		if p.Principals[i].JWTPrincipal.Matches(req.Claims, req.Provider, delimiter) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// Matches reports whether the permissions admit a request with the given path and method.
+// Unset permissions admit every request.
+func (p *RBACPermissions) Matches(path, method string) bool {
+	path, _, _ = strings.Cut(path, "?")
+	if p.PathPrefix != nil && !strings.HasPrefix(path, *p.PathPrefix) {
+		return false
+	}
+	if len(p.Methods) == 0 {
+		return true
+	}
+	for _, m := range p.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches reports whether all claims of the principal match the given JWT claims.
+// A non-empty delimiter enables nested claim names such as `parent.child`.
+func (p *RBACJWTPrincipal) Matches(claims map[string]interface{}, provider, delimiter string) bool {
+	if p.Provider != nil && *p.Provider != provider {
+		return false
+	}
+	matcher := JwtPrincipalClaimMatcherExactString
+	if p.Matcher != nil {
+		matcher = *p.Matcher
+	}
+	for name, expected := range p.Claims {
+		actual, ok := lookupClaim(claims, name, delimiter)
+		if !ok || !matchClaim(matcher, actual, expected) {
+			return false
+		}
+	}
+	return true
+}
+
+func lookupClaim(claims map[string]interface{}, name, delimiter string) (interface{}, bool) {
+	if delimiter == "" {
+		v, ok := claims[name]
+		return v, ok
+	}
+	var current interface{} = claims
+	for _, part := range strings.Split(name, delimiter) {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func matchClaim(matcher RBACJWTPrincipalClaimMatcher, actual interface{}, expected string) bool {
+	switch matcher {
+	case JwtPrincipalClaimMatcherExactString:
+		s, ok := actual.(string)
+		return ok && s == expected
+	case JwtPrincipalClaimMatcherBoolean:
+		b, ok := actual.(bool)
+		want, err := strconv.ParseBool(expected)
+		return ok && err == nil && b == want
+	case JwtPrincipalClaimMatcherListContains:
+		list, ok := actual.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, item := range list {
+			if s, ok := item.(string); ok && s == expected {
+				return true
+			}
+		}
+		return false
+	case JwtPrincipalClaimMatcherSpaceDelimitedStringContains:
+		s, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		tokens := map[string]bool{}
+		for _, t := range strings.Fields(s) {
+			tokens[t] = true
+		}
+		wanted := strings.Fields(expected)
+		if len(wanted) == 0 {
+			return false
+		}
+		for _, t := range wanted {
+			if !tokens[t] {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
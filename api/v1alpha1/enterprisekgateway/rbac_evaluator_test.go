@@ -0,0 +1,132 @@
+package enterprisekgateway
+
+import (
+	"testing"
+
+	upstreamshared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"k8s.io/utils/ptr"
+)
+
+func TestEntRBACEvaluate(t *testing.T) {
+	admins := RBACPolicy{
+		Principals: []RBACPrincipal{
+			{JWTPrincipal: RBACJWTPrincipal{Claims: map[string]string{"sub": "alice"}}},
+			{JWTPrincipal: RBACJWTPrincipal{Claims: map[string]string{"sub": "bob"}, Provider: ptr.To("okta")}},
+		},
+		Permissions: &RBACPermissions{PathPrefix: ptr.To("/admin"), Methods: []string{"GET", "POST"}},
+	}
+	readers := RBACPolicy{
+		Principals: []RBACPrincipal{
+			{JWTPrincipal: RBACJWTPrincipal{Claims: map[string]string{"org.team": "readers"}}},
+		},
+		NestedClaimDelimiter: ptr.To("."),
+	}
+	rbac := &EntRBAC{Policies: map[string]RBACPolicy{"b-readers": readers, "a-admins": admins}}
+
+	tests := []struct {
+		name      string
+		rbac      *EntRBAC
+		req       RBACRequest
+		allowed   bool
+		policy    string
+		principal int
+	}{
+		{
+			name:    "disabled",
+			rbac:    &EntRBAC{Disable: &upstreamshared.PolicyDisable{}, Policies: rbac.Policies},
+			allowed: true, principal: -1,
+		},
+		{
+			name:    "no policies",
+			rbac:    &EntRBAC{},
+			req:     RBACRequest{Claims: map[string]interface{}{"sub": "alice"}},
+			allowed: false, principal: -1,
+		},
+		{
+			name:    "first principal",
+			rbac:    rbac,
+			req:     RBACRequest{Claims: map[string]interface{}{"sub": "alice"}, Path: "/admin/users?x=1", Method: "GET"},
+			allowed: true, policy: "a-admins", principal: 0,
+		},
+		{
+			name:    "provider matches",
+			rbac:    rbac,
+			req:     RBACRequest{Claims: map[string]interface{}{"sub": "bob"}, Provider: "okta", Path: "/admin", Method: "POST"},
+			allowed: true, policy: "a-admins", principal: 1,
+		},
+		{
+			name:    "provider mismatch",
+			rbac:    rbac,
+			req:     RBACRequest{Claims: map[string]interface{}{"sub": "bob"}, Provider: "auth0", Path: "/admin", Method: "POST"},
+			allowed: false, principal: -1,
+		},
+		{
+			name:    "method not permitted",
+			rbac:    rbac,
+			req:     RBACRequest{Claims: map[string]interface{}{"sub": "alice"}, Path: "/admin", Method: "DELETE"},
+			allowed: false, principal: -1,
+		},
+		{
+			name:    "prefix not permitted",
+			rbac:    rbac,
+			req:     RBACRequest{Claims: map[string]interface{}{"sub": "alice"}, Path: "/public", Method: "GET"},
+			allowed: false, principal: -1,
+		},
+		{
+			name: "nested claim",
+			rbac: rbac,
+			req: RBACRequest{
+				Claims: map[string]interface{}{"org": map[string]interface{}{"team": "readers"}},
+				Path:   "/anything", Method: "DELETE",
+			},
+			allowed: true, policy: "b-readers", principal: 0,
+		},
+		{
+			name:    "policies in name order",
+			rbac:    &EntRBAC{Policies: map[string]RBACPolicy{"z": readers, "m": readers}},
+			req:     RBACRequest{Claims: map[string]interface{}{"org": map[string]interface{}{"team": "readers"}}},
+			allowed: true, policy: "m", principal: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tt.rbac.Evaluate(tt.req)
+			if d.Allowed != tt.allowed || d.Policy != tt.policy || d.PrincipalIndex != tt.principal {
+				t.Errorf("Evaluate() = {Allowed: %v, Policy: %q, PrincipalIndex: %d}, want {%v, %q, %d}",
+					d.Allowed, d.Policy, d.PrincipalIndex, tt.allowed, tt.policy, tt.principal)
+			}
+		})
+	}
+}
+
+func TestMatchClaim(t *testing.T) {
+	tests := []struct {
+		name     string
+		matcher  RBACJWTPrincipalClaimMatcher
+		actual   interface{}
+		expected string
+		want     bool
+	}{
+		{"exact", JwtPrincipalClaimMatcherExactString, "alice", "alice", true},
+		{"exact mismatch", JwtPrincipalClaimMatcherExactString, "alice", "Alice", false},
+		{"exact not a string", JwtPrincipalClaimMatcherExactString, true, "true", false},
+		{"boolean", JwtPrincipalClaimMatcherBoolean, true, "true", true},
+		{"boolean mismatch", JwtPrincipalClaimMatcherBoolean, false, "true", false},
+		{"boolean not a bool", JwtPrincipalClaimMatcherBoolean, "true", "true", false},
+		{"boolean invalid expected", JwtPrincipalClaimMatcherBoolean, true, "yes", false},
+		{"list contains", JwtPrincipalClaimMatcherListContains, []interface{}{"a", "b"}, "b", true},
+		{"list does not contain", JwtPrincipalClaimMatcherListContains, []interface{}{"a", "b"}, "c", false},
+		{"list not a list", JwtPrincipalClaimMatcherListContains, "a b", "a", false},
+		{"space delimited all tokens", JwtPrincipalClaimMatcherSpaceDelimitedStringContains, "read write admin", "write read", true},
+		{"space delimited missing token", JwtPrincipalClaimMatcherSpaceDelimitedStringContains, "read write", "read admin", false},
+		{"space delimited empty expected", JwtPrincipalClaimMatcherSpaceDelimitedStringContains, "read", " ", false},
+		{"unknown matcher", "Regex", "alice", "alice", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchClaim(tt.matcher, tt.actual, tt.expected); got != tt.want {
+				t.Errorf("matchClaim(%s, %v, %q) = %v, want %v", tt.matcher, tt.actual, tt.expected, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package enterprisekgateway
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/solo-io/kgateway-client/v2/internal/fullmatch"
+)
+
+// DefaultResponseCodeDetails is the Envoy response code details value for responses
+// received from an upstream, as opposed to local replies.
+const DefaultResponseCodeDetails = "via_upstream"
+
+// MatchRequest returns the index of the first request transformation whose matcher matches
+// req, or -1 if none does. This is the transformation that fires for the request.
+func (r *RequestResponseTransformations) MatchRequest(req *http.Request) int {
+	for i := range r.Requests {
+		if r.Requests[i].Matches(req) {
+			return i
+		}
+	}
+	return -1
+}
+
+// MatchResponse returns the index of the first response transformation that matches resp,
+// or -1 if none does. resp is assumed to come from an upstream; use
+// MatchResponseWithDetails to evaluate local replies.
+func (r *RequestResponseTransformations) MatchResponse(resp *http.Response) int {
+	return r.MatchResponseWithDetails(resp, DefaultResponseCodeDetails)
+}
+
+// MatchResponseWithDetails is like MatchResponse, with the given Envoy response code details.
+func (r *RequestResponseTransformations) MatchResponseWithDetails(resp *http.Response, details string) int {
+	for i := range r.Responses {
+		if r.Responses[i].MatchesResponseWithDetails(resp, details) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Matches reports whether the request transformation applies to req.
+// A request transformation without a matcher applies to every request.
+func (r *RequestMatcher) Matches(req *http.Request) bool {
+	if r.Matcher == nil {
+		return true
+	}
+	return r.Matcher.Matches(req)
+}
+
+// Matches reports whether req satisfies the matcher, following Envoy route match semantics:
+// the path specifier, headers, query parameters, gRPC, TLS context and methods must all match.
+// When no path specifier is set the matcher behaves as a prefix match on "/".
+func (m *TransformationRequestMatcher) Matches(req *http.Request) bool {
+	if !m.matchesPath(req) {
+		return false
+	}
+	for i := range m.Headers {
+		if !m.Headers[i].Matches(requestHeaderValue(req, m.Headers[i].Name)) {
+			return false
+		}
+	}
+	if len(m.QueryParameters) > 0 {
+		params := parseQueryString(requestPath(req))
+		for i := range m.QueryParameters {
+			if !m.QueryParameters[i].Matches(params) {
+				return false
+			}
+		}
+	}
+	if m.Grpc != nil && *m.Grpc && !isGrpcRequest(req) {
+		return false
+	}
+	if m.TlsContext != nil && !m.TlsContext.Matches(req) {
+		return false
+	}
+	if len(m.Methods) > 0 {
+		found := false
+		for _, method := range m.Methods {
+			if method == req.Method {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *TransformationRequestMatcher) matchesPath(req *http.Request) bool {
+	caseSensitive := m.CaseSensitive == nil || *m.CaseSensitive
+	path := requestPath(req)
+	switch {
+	case m.Connect != nil && *m.Connect:
+		return req.Method == http.MethodConnect
+	case m.Prefix != nil:
+		// Prefix matches are evaluated against the full path, including the query string.
+		if caseSensitive {
+			return strings.HasPrefix(path, *m.Prefix)
+		}
+		return len(path) >= len(*m.Prefix) && strings.EqualFold(path[:len(*m.Prefix)], *m.Prefix)
+	case m.Path != nil:
+		path = stripQueryString(path)
+		if caseSensitive {
+			return path == *m.Path
+		}
+		return strings.EqualFold(path, *m.Path)
+	case m.Regex != nil:
+		return fullmatch.MatchString(m.Regex.Regex, stripQueryString(path))
+	default:
+		return strings.HasPrefix(path, "/")
+	}
+}
+
+// Matches reports whether a header with the given value satisfies the matcher. present is
+// false when the header is absent. A header that appears multiple times is matched
+// against its values joined with a comma, as Envoy does.
+func (m *TransformationHeaderMatcher) Matches(value string, present bool) bool {
+	var matched bool
+	switch {
+	case !present:
+		matched = false
+	case m.Value == nil:
+		matched = true
+	case m.Regex != nil && *m.Regex:
+		matched = fullmatch.MatchString(*m.Value, value)
+	default:
+		matched = value == *m.Value
+	}
+	if m.InvertMatch != nil && *m.InvertMatch {
+		return !matched
+	}
+	return matched
+}
+
+// Matches reports whether the parsed query parameters satisfy the matcher.
+func (m *QueryParameterMatcher) Matches(params map[string]string) bool {
+	value, ok := params[m.Name]
+	if !ok {
+		return false
+	}
+	switch {
+	case m.Value == nil:
+		return true
+	case m.Regex != nil && *m.Regex:
+		return fullmatch.MatchString(*m.Value, value)
+	default:
+		return value == *m.Value
+	}
+}
+
+// Matches reports whether the TLS state of req satisfies the options.
+func (o *TlsContextMatchOptions) Matches(req *http.Request) bool {
+	presented, validated := false, false
+	if req.TLS != nil {
+		presented = len(req.TLS.PeerCertificates) > 0
+		validated = len(req.TLS.VerifiedChains) > 0
+	}
+	if o.Presented != nil && *o.Presented != presented {
+		return false
+	}
+	if o.Validated != nil && *o.Validated != validated {
+		return false
+	}
+	return true
+}
+
+// MatchesResponse reports whether the response transformation applies to resp, which is
+// assumed to come from an upstream. Use MatchesResponseWithDetails to evaluate local replies.
+func (r *ResponseMatcher) MatchesResponse(resp *http.Response) bool {
+	return r.MatchesResponseWithDetails(resp, DefaultResponseCodeDetails)
+}
+
+// MatchesResponseWithDetails reports whether the response transformation applies to resp
+// with the given Envoy response code details.
+func (r *ResponseMatcher) MatchesResponseWithDetails(resp *http.Response, details string) bool {
+	for i := range r.Headers {
+		if !r.Headers[i].Matches(responseHeaderValue(resp, r.Headers[i].Name)) {
+			return false
+		}
+	}
+	if r.ResponseCodeDetails != nil && *r.ResponseCodeDetails != details {
+		return false
+	}
+	return true
+}
+
+// requestPath returns the value of the :path pseudo-header for req. Request targets in
+// absolute form, as sent to proxies, are reduced to their path and query.
+func requestPath(req *http.Request) string {
+	if strings.HasPrefix(req.RequestURI, "/") {
+		return req.RequestURI
+	}
+	if req.URL == nil {
+		return "/"
+	}
+	return req.URL.RequestURI()
+}
+
+func requestHeaderValue(req *http.Request, name string) (string, bool) {
+	switch strings.ToLower(name) {
+	case ":method":
+		return req.Method, true
+	case ":path":
+		return requestPath(req), true
+	case ":authority", "host":
+		if req.Host != "" {
+			return req.Host, true
+		}
+		if req.URL != nil && req.URL.Host != "" {
+			return req.URL.Host, true
+		}
+		return "", false
+	case ":scheme":
+		if req.TLS != nil {
+			return "https", true
+		}
+		if req.URL != nil && req.URL.Scheme != "" {
+			return req.URL.Scheme, true
+		}
+		return "http", true
+	}
+	return joinedHeaderValue(req.Header, name)
+}
+
+func responseHeaderValue(resp *http.Response, name string) (string, bool) {
+	if strings.ToLower(name) == ":status" {
+		return strconv.Itoa(resp.StatusCode), true
+	}
+	return joinedHeaderValue(resp.Header, name)
+}
+
+func joinedHeaderValue(h http.Header, name string) (string, bool) {
+	values := h.Values(name)
+	if len(values) == 0 {
+		return "", false
+	}
+	return strings.Join(values, ","), true
+}
+
+func isGrpcRequest(req *http.Request) bool {
+	contentType := req.Header.Get("Content-Type")
+	return contentType == "application/grpc" ||
+		strings.HasPrefix(contentType, "application/grpc+") ||
+		strings.HasPrefix(contentType, "application/grpc;")
+}
+
+func stripQueryString(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	return path
+}
+
+// parseQueryString parses the query string of path without decoding it. When a
+// parameter is repeated, the first value wins.
+func parseQueryString(path string) map[string]string {
+	params := map[string]string{}
+	_, query, ok := strings.Cut(path, "?")
+	if !ok {
+		return params
+	}
+	query, _, _ = strings.Cut(query, "#")
+	for _, pair := range strings.Split(query, "&") {
+		if pair == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(pair, "=")
+		if _, exists := params[key]; !exists {
+			params[key] = value
+		}
+	}
+	return params
+}
@@ -0,0 +1,178 @@
+package enterprisekgateway
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestTransformationRequestMatcherMatches(t *testing.T) {
+	get := func(target string) *http.Request {
+		return httptest.NewRequest(http.MethodGet, target, nil)
+	}
+	withHeader := func(req *http.Request, name string, values ...string) *http.Request {
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+		return req
+	}
+	withTLS := func(req *http.Request, presented, validated bool) *http.Request {
+		req.TLS = &tls.ConnectionState{}
+		if presented {
+			req.TLS.PeerCertificates = []*x509.Certificate{{}}
+		}
+		if validated {
+			req.TLS.VerifiedChains = [][]*x509.Certificate{{{}}}
+		}
+		return req
+	}
+	header := func(name, value string) TransformationHeaderMatcher {
+		return TransformationHeaderMatcher{Name: name, Value: ptr.To(value)}
+	}
+
+	tests := []struct {
+		name    string
+		matcher TransformationRequestMatcher
+		req     *http.Request
+		want    bool
+	}{
+		{name: "no path specifier", req: get("/anything"), want: true},
+		{name: "prefix", matcher: TransformationRequestMatcher{Prefix: ptr.To("/api")}, req: get("/api/v1"), want: true},
+		{name: "prefix mismatch", matcher: TransformationRequestMatcher{Prefix: ptr.To("/api")}, req: get("/web"), want: false},
+		{name: "prefix includes the query string", matcher: TransformationRequestMatcher{Prefix: ptr.To("/api?debug")}, req: get("/api?debug=1"), want: true},
+		{name: "prefix case sensitive", matcher: TransformationRequestMatcher{Prefix: ptr.To("/API")}, req: get("/api/v1"), want: false},
+		{
+			name:    "prefix case insensitive",
+			matcher: TransformationRequestMatcher{Prefix: ptr.To("/API"), CaseSensitive: ptr.To(false)},
+			req:     get("/api/v1"),
+			want:    true,
+		},
+		{name: "path ignores the query string", matcher: TransformationRequestMatcher{Path: ptr.To("/api")}, req: get("/api?debug=1"), want: true},
+		{name: "path is exact", matcher: TransformationRequestMatcher{Path: ptr.To("/api")}, req: get("/api/v1"), want: false},
+		{name: "regex full match", matcher: TransformationRequestMatcher{Regex: &RegexMatcher{Regex: "/api/v[0-9]+"}}, req: get("/api/v12?x=1"), want: true},
+		{name: "regex partial match", matcher: TransformationRequestMatcher{Regex: &RegexMatcher{Regex: "/api"}}, req: get("/api/v1"), want: false},
+		{name: "invalid regex", matcher: TransformationRequestMatcher{Regex: &RegexMatcher{Regex: "("}}, req: get("/("), want: false},
+		{name: "connect", matcher: TransformationRequestMatcher{Connect: ptr.To(true)}, req: httptest.NewRequest(http.MethodConnect, "/", nil), want: true},
+		{name: "connect mismatch", matcher: TransformationRequestMatcher{Connect: ptr.To(true)}, req: get("/"), want: false},
+		{name: "connect false", matcher: TransformationRequestMatcher{Connect: ptr.To(false)}, req: get("/"), want: true},
+		{
+			name:    "joined header values",
+			matcher: TransformationRequestMatcher{Headers: []TransformationHeaderMatcher{header("x-tag", "a,b")}},
+			req:     withHeader(get("/"), "x-tag", "a", "b"),
+			want:    true,
+		},
+		{
+			name: "header regex full match",
+			matcher: TransformationRequestMatcher{Headers: []TransformationHeaderMatcher{
+				{Name: "x-version", Value: ptr.To("v[0-9]"), Regex: ptr.To(true)},
+			}},
+			req:  withHeader(get("/"), "x-version", "v10"),
+			want: false,
+		},
+		{
+			name:    "header presence",
+			matcher: TransformationRequestMatcher{Headers: []TransformationHeaderMatcher{{Name: "x-user"}}},
+			req:     get("/"),
+			want:    false,
+		},
+		{
+			name: "inverted header",
+			matcher: TransformationRequestMatcher{Headers: []TransformationHeaderMatcher{
+				{Name: "x-user", Value: ptr.To("alice"), InvertMatch: ptr.To(true)},
+			}},
+			req:  withHeader(get("/"), "x-user", "bob"),
+			want: true,
+		},
+		{
+			name:    "authority",
+			matcher: TransformationRequestMatcher{Headers: []TransformationHeaderMatcher{header(":authority", "api.example.com")}},
+			req:     get("http://api.example.com/"),
+			want:    true,
+		},
+		{
+			name:    "scheme of a TLS request",
+			matcher: TransformationRequestMatcher{Headers: []TransformationHeaderMatcher{header(":scheme", "https")}},
+			req:     withTLS(get("/"), false, false),
+			want:    true,
+		},
+		{
+			name:    "scheme of a plaintext request",
+			matcher: TransformationRequestMatcher{Headers: []TransformationHeaderMatcher{header(":scheme", "https")}},
+			req:     get("/"),
+			want:    false,
+		},
+		{
+			name: "query parameter regex",
+			matcher: TransformationRequestMatcher{QueryParameters: []QueryParameterMatcher{
+				{Name: "page", Value: ptr.To("[0-9]+"), Regex: ptr.To(true)},
+			}},
+			req:  get("/?page=12&page=x"),
+			want: true,
+		},
+		{name: "grpc", matcher: TransformationRequestMatcher{Grpc: ptr.To(true)}, req: withHeader(get("/"), "Content-Type", "application/grpc+proto"), want: true},
+		{name: "grpc mismatch", matcher: TransformationRequestMatcher{Grpc: ptr.To(true)}, req: withHeader(get("/"), "Content-Type", "application/json"), want: false},
+		{
+			name:    "TLS certificate presented",
+			matcher: TransformationRequestMatcher{TlsContext: &TlsContextMatchOptions{Presented: ptr.To(true)}},
+			req:     withTLS(get("/"), true, false),
+			want:    true,
+		},
+		{
+			name:    "TLS certificate not validated",
+			matcher: TransformationRequestMatcher{TlsContext: &TlsContextMatchOptions{Presented: ptr.To(true), Validated: ptr.To(true)}},
+			req:     withTLS(get("/"), true, false),
+			want:    false,
+		},
+		{
+			name:    "TLS certificate validated",
+			matcher: TransformationRequestMatcher{TlsContext: &TlsContextMatchOptions{Validated: ptr.To(true)}},
+			req:     withTLS(get("/"), true, true),
+			want:    true,
+		},
+		{
+			name:    "no TLS",
+			matcher: TransformationRequestMatcher{TlsContext: &TlsContextMatchOptions{Presented: ptr.To(false)}},
+			req:     get("/"),
+			want:    true,
+		},
+		{name: "method", matcher: TransformationRequestMatcher{Methods: []string{"POST", "GET"}}, req: get("/"), want: true},
+		{name: "method mismatch", matcher: TransformationRequestMatcher{Methods: []string{"POST"}}, req: get("/"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.matcher.Matches(tt.req); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResponseMatcherMatchesResponse(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusNotFound, Header: http.Header{"X-Cache": {"miss", "stale"}}}
+	tests := []struct {
+		name    string
+		matcher ResponseMatcher
+		details string
+		want    bool
+	}{
+		{name: "status", matcher: ResponseMatcher{Headers: []TransformationHeaderMatcher{{Name: ":status", Value: ptr.To("404")}}}, want: true},
+		{name: "joined header values", matcher: ResponseMatcher{Headers: []TransformationHeaderMatcher{{Name: "x-cache", Value: ptr.To("miss,stale")}}}, want: true},
+		{name: "upstream response", matcher: ResponseMatcher{ResponseCodeDetails: ptr.To(DefaultResponseCodeDetails)}, want: true},
+		{name: "local reply", matcher: ResponseMatcher{ResponseCodeDetails: ptr.To(DefaultResponseCodeDetails)}, details: "route_not_found", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			details := tt.details
+			if details == "" {
+				details = DefaultResponseCodeDetails
+			}
+			if got := tt.matcher.MatchesResponseWithDetails(resp, details); got != tt.want {
+				t.Errorf("MatchesResponseWithDetails() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
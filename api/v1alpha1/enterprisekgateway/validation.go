@@ -0,0 +1,684 @@
+package enterprisekgateway
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// The Validate methods in this file mirror the OpenAPI and oneOf markers on the
+// EnterpriseKgatewayTrafficPolicySpec type tree so that manifests can be checked
+// without an API server. Error types and messages follow the ones reported by the
+// apiextensions-apiserver for the generated CRD schema, where the request body is
+// referred to as "body".
+
+const (
+	// maxTransformationItems is the MaxItems/MaxProperties bound shared by most transformation lists.
+	maxTransformationItems = 32
+	// maxRateLimitConfigRefs is the MaxItems bound on GlobalRateLimit.RateLimitConfigRefs.
+	maxRateLimitConfigRefs = 16
+	// maxJWTProviders is the MaxProperties bound on EntJWT.Providers.
+	maxJWTProviders = 32
+	// maxTransformationStringLength is the MaxLength bound on extraction and metadata strings.
+	maxTransformationStringLength = 4096
+	// maxPathMatchLength is the MaxLength bound on prefix and path request matchers.
+	maxPathMatchLength = 1024
+)
+
+// remoteJWKSURLPattern mirrors the Pattern marker on RemoteJWKS.Url.
+const remoteJWKSURLPattern = `^(http|https):\/\/[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?)*(:\d+)?\/.*$`
+
+var remoteJWKSURLRegexp = regexp.MustCompile(remoteJWKSURLPattern)
+
+var (
+	supportedJwtValidationPolicies = []string{
+		string(ValidationPolicyRequireValid),
+		string(ValidationPolicyAllowMissing),
+		string(ValidationPolicyAllowMissingOrFailed),
+	}
+	supportedClaimMatchers = []string{
+		string(JwtPrincipalClaimMatcherExactString),
+		string(JwtPrincipalClaimMatcherBoolean),
+		string(JwtPrincipalClaimMatcherListContains),
+		string(JwtPrincipalClaimMatcherSpaceDelimitedStringContains),
+	}
+	supportedExtractModes = []string{
+		string(ModeExtract),
+		string(ModeSingleReplace),
+		string(ModeReplaceAll),
+	}
+	supportedRequestBodyParses = []string{
+		string(ParseAsJson),
+		string(DontParse),
+	}
+	supportedEscapeCharactersBehaviors = []string{
+		string(EscapeCharactersEscape),
+		string(EscapeCharactersDontEscape),
+	}
+	supportedBodyTransformationTypes = []string{
+		string(BodyTransformationTypeBody),
+		string(BodyTransformationTypePassthrough),
+		string(BodyTransformationTypeMergeExtractorsToBody),
+		string(BodyTransformationTypeMergeJsonKeys),
+	}
+	supportedAWSLambdaTransformFormats = []string{
+		string(AWSLambdaFormatAPIGateway),
+	}
+)
+
+// Validate validates the spec against the constraints declared on its fields.
+func (s *EnterpriseKgatewayTrafficPolicySpec) Validate() field.ErrorList {
+	return s.validate(field.NewPath("spec"))
+}
+
+func (s *EnterpriseKgatewayTrafficPolicySpec) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, atMostOneOf(fldPath,
+		oneOfField{"extAuth", s.ExtAuth != nil},
+		oneOfField{"entExtAuth", s.EntExtAuth != nil},
+	)...)
+	allErrs = append(allErrs, atMostOneOf(fldPath,
+		oneOfField{"rateLimit", s.RateLimit != nil},
+		oneOfField{"entRateLimit", s.EntRateLimit != nil},
+	)...)
+	allErrs = append(allErrs, atMostOneOf(fldPath,
+		oneOfField{"transformation", s.Transformation != nil},
+		oneOfField{"entTransformation", s.EntTransformation != nil},
+	)...)
+	if s.EntRateLimit != nil {
+		allErrs = append(allErrs, s.EntRateLimit.validate(fldPath.Child("entRateLimit"))...)
+	}
+	if s.EntExtAuth != nil {
+		allErrs = append(allErrs, s.EntExtAuth.validate(fldPath.Child("entExtAuth"))...)
+	}
+	if s.EntTransformation != nil {
+		allErrs = append(allErrs, s.EntTransformation.validate(fldPath.Child("entTransformation"))...)
+	}
+	if s.EntJWT != nil {
+		allErrs = append(allErrs, s.EntJWT.validate(fldPath.Child("entJWT"))...)
+	}
+	if s.EntRBAC != nil {
+		allErrs = append(allErrs, s.EntRBAC.validate(fldPath.Child("entRBAC"))...)
+	}
+	if s.EntWAF != nil {
+		allErrs = append(allErrs, s.EntWAF.validate(fldPath.Child("entWAF"))...)
+	}
+	return allErrs
+}
+
+// Validate validates the rate limit configuration.
+func (r *EntRateLimit) Validate() field.ErrorList {
+	return r.validate(nil)
+}
+
+func (r *EntRateLimit) validate(fldPath *field.Path) field.ErrorList {
+	return r.Global.validate(fldPath.Child("global"))
+}
+
+// Validate validates the global rate limit configuration.
+func (g *GlobalRateLimit) Validate() field.ErrorList {
+	return g.validate(nil)
+}
+
+func (g *GlobalRateLimit) validate(fldPath *field.Path) field.ErrorList {
+	refsPath := fldPath.Child("rateLimitConfigRefs")
+	if g.RateLimitConfigRefs == nil {
+		return field.ErrorList{field.Required(refsPath, "")}
+	}
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateMinItems(refsPath, g.RateLimitConfigRefs, 1)...)
+	allErrs = append(allErrs, validateMaxItems(refsPath, len(g.RateLimitConfigRefs), maxRateLimitConfigRefs)...)
+	return allErrs
+}
+
+// Validate validates the external authorization configuration.
+func (e *EntExtAuth) Validate() field.ErrorList {
+	return e.validate(nil)
+}
+
+func (e *EntExtAuth) validate(fldPath *field.Path) field.ErrorList {
+	return exactlyOneOf(fldPath,
+		oneOfField{"authConfigRef", e.AuthConfigRef != nil},
+		oneOfField{"disable", e.Disable != nil},
+	)
+}
+
+// Validate validates the WAF configuration.
+func (w *EntWAF) Validate() field.ErrorList {
+	return w.validate(nil)
+}
+
+func (w *EntWAF) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, exactlyOneOf(fldPath,
+		oneOfField{"wafPolicyRef", w.WAFPolicyRef != nil},
+		oneOfField{"disable", w.Disable != nil},
+	)...)
+	allErrs = append(allErrs, atMostOneOf(fldPath,
+		oneOfField{"wafServerRef", w.WAFServerRef != nil},
+		oneOfField{"disable", w.Disable != nil},
+	)...)
+	return allErrs
+}
+
+// Validate validates the RBAC configuration.
+func (r *EntRBAC) Validate() field.ErrorList {
+	return r.validate(nil)
+}
+
+func (r *EntRBAC) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, exactlyOneOf(fldPath,
+		oneOfField{"disable", r.Disable != nil},
+		oneOfField{"policies", len(r.Policies) > 0},
+	)...)
+	policiesPath := fldPath.Child("policies")
+	for _, name := range slices.Sorted(maps.Keys(r.Policies)) {
+		policy := r.Policies[name]
+		allErrs = append(allErrs, policy.validate(policiesPath.Key(name))...)
+	}
+	return allErrs
+}
+
+// Validate validates the RBAC policy.
+func (p *RBACPolicy) Validate() field.ErrorList {
+	return p.validate(nil)
+}
+
+func (p *RBACPolicy) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	principalsPath := fldPath.Child("principals")
+	if p.Principals == nil {
+		allErrs = append(allErrs, field.Required(principalsPath, ""))
+	} else {
+		allErrs = append(allErrs, validateMinItems(principalsPath, p.Principals, 1)...)
+	}
+	for i := range p.Principals {
+		allErrs = append(allErrs, p.Principals[i].validate(principalsPath.Index(i))...)
+	}
+	if p.Permissions != nil {
+		allErrs = append(allErrs, p.Permissions.validate(fldPath.Child("permissions"))...)
+	}
+	return allErrs
+}
+
+// Validate validates the RBAC principal.
+func (p *RBACPrincipal) Validate() field.ErrorList {
+	return p.validate(nil)
+}
+
+func (p *RBACPrincipal) validate(fldPath *field.Path) field.ErrorList {
+	return p.JWTPrincipal.validate(fldPath.Child("jwtPrincipal"))
+}
+
+// Validate validates the JWT principal.
+func (p *RBACJWTPrincipal) Validate() field.ErrorList {
+	return p.validate(nil)
+}
+
+func (p *RBACJWTPrincipal) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if p.Provider != nil {
+		allErrs = append(allErrs, validateMinLength(fldPath.Child("provider"), *p.Provider, 1)...)
+	}
+	if p.Matcher != nil {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("matcher"), string(*p.Matcher), supportedClaimMatchers)...)
+	}
+	return allErrs
+}
+
+// Validate validates the RBAC permissions.
+func (p *RBACPermissions) Validate() field.ErrorList {
+	return p.validate(nil)
+}
+
+func (p *RBACPermissions) validate(fldPath *field.Path) field.ErrorList {
+	if p.PathPrefix == nil {
+		return nil
+	}
+	return validateMinLength(fldPath.Child("pathPrefix"), *p.PathPrefix, 1)
+}
+
+// Validate validates the staged JWT configuration.
+func (s *StagedJWT) Validate() field.ErrorList {
+	return s.validate(nil)
+}
+
+func (s *StagedJWT) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if s.AfterExtAuth != nil {
+		allErrs = append(allErrs, s.AfterExtAuth.validate(fldPath.Child("afterExtAuth"))...)
+	}
+	if s.BeforeExtAuth != nil {
+		allErrs = append(allErrs, s.BeforeExtAuth.validate(fldPath.Child("beforeExtAuth"))...)
+	}
+	return allErrs
+}
+
+// Validate validates the JWT configuration.
+func (j *EntJWT) Validate() field.ErrorList {
+	return j.validate(nil)
+}
+
+func (j *EntJWT) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	providersPath := fldPath.Child("providers")
+	allErrs = append(allErrs, validateMaxProperties(providersPath, len(j.Providers), maxJWTProviders)...)
+	for _, name := range slices.Sorted(maps.Keys(j.Providers)) {
+		provider := j.Providers[name]
+		allErrs = append(allErrs, provider.validate(providersPath.Key(name))...)
+	}
+	if j.ValidationPolicy != nil {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("validationPolicy"), string(*j.ValidationPolicy), supportedJwtValidationPolicies)...)
+	}
+	return allErrs
+}
+
+// Validate validates the JWT provider.
+func (p *JWTProvider) Validate() field.ErrorList {
+	return p.validate(nil)
+}
+
+func (p *JWTProvider) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, p.JWKS.validate(fldPath.Child("jwks"))...)
+	if p.ClockSkewSeconds != nil {
+		allErrs = append(allErrs, validateMinimum(fldPath.Child("clockSkewSeconds"), int64(*p.ClockSkewSeconds), 0)...)
+	}
+	return allErrs
+}
+
+// Validate validates the JWKS source.
+func (j *JWKS) Validate() field.ErrorList {
+	return j.validate(nil)
+}
+
+func (j *JWKS) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, exactlyOneOf(fldPath,
+		oneOfField{"local", j.Local != nil},
+		oneOfField{"remote", j.Remote != nil},
+	)...)
+	if j.Remote != nil {
+		allErrs = append(allErrs, j.Remote.validate(fldPath.Child("remote"))...)
+	}
+	return allErrs
+}
+
+// Validate validates the remote JWKS source.
+func (r *RemoteJWKS) Validate() field.ErrorList {
+	return r.validate(nil)
+}
+
+func (r *RemoteJWKS) validate(fldPath *field.Path) field.ErrorList {
+	if !remoteJWKSURLRegexp.MatchString(r.Url) {
+		urlPath := fldPath.Child("url")
+		return field.ErrorList{field.Invalid(urlPath, r.Url, fmt.Sprintf("%s in body should match '%s'", urlPath, remoteJWKSURLPattern))}
+	}
+	return nil
+}
+
+// Validate validates the transformation configuration.
+func (t *EntTransformation) Validate() field.ErrorList {
+	return t.validate(nil)
+}
+
+func (t *EntTransformation) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if t.Stages != nil {
+		allErrs = append(allErrs, t.Stages.validate(fldPath.Child("stages"))...)
+	}
+	if t.AWSLambda != nil {
+		allErrs = append(allErrs, t.AWSLambda.validate(fldPath.Child("awsLambda"))...)
+	}
+	return allErrs
+}
+
+// Validate validates the AWS Lambda transformation.
+func (a *AWSLambdaTransformation) Validate() field.ErrorList {
+	return a.validate(nil)
+}
+
+func (a *AWSLambdaTransformation) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if a.RequestFormat != nil {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("requestFormat"), string(*a.RequestFormat), supportedAWSLambdaTransformFormats)...)
+	}
+	if a.ResponseFormat != nil {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("responseFormat"), string(*a.ResponseFormat), supportedAWSLambdaTransformFormats)...)
+	}
+	return allErrs
+}
+
+// Validate validates the staged transformations.
+func (s *StagedTransformations) Validate() field.ErrorList {
+	return s.validate(nil)
+}
+
+func (s *StagedTransformations) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if s.Early != nil {
+		allErrs = append(allErrs, s.Early.validate(fldPath.Child("early"))...)
+	}
+	if s.Regular != nil {
+		allErrs = append(allErrs, s.Regular.validate(fldPath.Child("regular"))...)
+	}
+	if s.PostRouting != nil {
+		allErrs = append(allErrs, s.PostRouting.validate(fldPath.Child("postRouting"))...)
+	}
+	if s.EscapeCharacters != nil {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("escapeCharacters"), string(*s.EscapeCharacters), supportedEscapeCharactersBehaviors)...)
+	}
+	return allErrs
+}
+
+// Validate validates the request and response transformations.
+func (r *RequestResponseTransformations) Validate() field.ErrorList {
+	return r.validate(nil)
+}
+
+func (r *RequestResponseTransformations) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	requestsPath := fldPath.Child("requests")
+	allErrs = append(allErrs, validateMaxItems(requestsPath, len(r.Requests), maxTransformationItems)...)
+	for i := range r.Requests {
+		allErrs = append(allErrs, r.Requests[i].validate(requestsPath.Index(i))...)
+	}
+	responsesPath := fldPath.Child("responses")
+	allErrs = append(allErrs, validateMaxItems(responsesPath, len(r.Responses), maxTransformationItems)...)
+	for i := range r.Responses {
+		allErrs = append(allErrs, r.Responses[i].validate(responsesPath.Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate validates the request transformation.
+func (r *RequestMatcher) Validate() field.ErrorList {
+	return r.validate(nil)
+}
+
+func (r *RequestMatcher) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Matcher != nil {
+		allErrs = append(allErrs, r.Matcher.validate(fldPath.Child("matcher"))...)
+	}
+	allErrs = append(allErrs, r.Transformation.validate(fldPath.Child("transformation"))...)
+	return allErrs
+}
+
+// Validate validates the response transformation.
+func (r *ResponseMatcher) Validate() field.ErrorList {
+	return r.validate(nil)
+}
+
+func (r *ResponseMatcher) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateMaxItems(fldPath.Child("matchers"), len(r.Headers), maxTransformationItems)...)
+	for i := range r.Headers {
+		allErrs = append(allErrs, r.Headers[i].validate(fldPath.Child("matchers").Index(i))...)
+	}
+	allErrs = append(allErrs, r.Transformation.validate(fldPath.Child("transformation"))...)
+	return allErrs
+}
+
+// Validate validates the request matcher.
+func (m *TransformationRequestMatcher) Validate() field.ErrorList {
+	return m.validate(nil)
+}
+
+func (m *TransformationRequestMatcher) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, atMostOneOf(fldPath,
+		oneOfField{"prefix", m.Prefix != nil},
+		oneOfField{"path", m.Path != nil},
+		oneOfField{"regex", m.Regex != nil},
+		oneOfField{"connect", m.Connect != nil},
+	)...)
+	if m.Prefix != nil {
+		allErrs = append(allErrs, validateLength(fldPath.Child("prefix"), *m.Prefix, 1, maxPathMatchLength)...)
+	}
+	if m.Path != nil {
+		allErrs = append(allErrs, validateLength(fldPath.Child("path"), *m.Path, 1, maxPathMatchLength)...)
+	}
+	if m.Regex != nil {
+		allErrs = append(allErrs, validateRegex(fldPath.Child("regex", "regex"), m.Regex.Regex)...)
+	}
+	allErrs = append(allErrs, validateMaxItems(fldPath.Child("headers"), len(m.Headers), maxTransformationItems)...)
+	for i := range m.Headers {
+		allErrs = append(allErrs, m.Headers[i].validate(fldPath.Child("headers").Index(i))...)
+	}
+	allErrs = append(allErrs, validateMaxItems(fldPath.Child("queryParameters"), len(m.QueryParameters), maxTransformationItems)...)
+	for i, q := range m.QueryParameters {
+		if q.Value != nil && q.Regex != nil && *q.Regex {
+			allErrs = append(allErrs, validateRegex(fldPath.Child("queryParameters").Index(i).Child("value"), *q.Value)...)
+		}
+	}
+	allErrs = append(allErrs, validateMaxItems(fldPath.Child("methods"), len(m.Methods), maxTransformationItems)...)
+	return allErrs
+}
+
+func (m *TransformationHeaderMatcher) validate(fldPath *field.Path) field.ErrorList {
+	if m.Value != nil && m.Regex != nil && *m.Regex {
+		return validateRegex(fldPath.Child("value"), *m.Value)
+	}
+	return nil
+}
+
+// Validate validates the transformation.
+func (t *Transformation) Validate() field.ErrorList {
+	return t.validate(nil)
+}
+
+func (t *Transformation) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, exactlyOneOf(fldPath,
+		oneOfField{"template", t.Template != nil},
+		oneOfField{"headerBody", t.HeaderBody != nil},
+	)...)
+	if t.Template != nil {
+		allErrs = append(allErrs, t.Template.validate(fldPath.Child("template"))...)
+	}
+	return allErrs
+}
+
+// Validate validates the transformation template.
+func (t *TransformationTemplate) Validate() field.ErrorList {
+	return t.validate(nil)
+}
+
+func (t *TransformationTemplate) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	extractorsPath := fldPath.Child("extractors")
+	allErrs = append(allErrs, validateMaxProperties(extractorsPath, len(t.Extractors), maxTransformationItems)...)
+	for _, name := range slices.Sorted(maps.Keys(t.Extractors)) {
+		extraction := t.Extractors[name]
+		allErrs = append(allErrs, extraction.validate(extractorsPath.Key(name))...)
+	}
+	allErrs = append(allErrs, validateMaxProperties(fldPath.Child("headers"), len(t.Headers), maxTransformationItems)...)
+	headersToAppendPath := fldPath.Child("headersToAppend")
+	allErrs = append(allErrs, validateMaxItems(headersToAppendPath, len(t.HeadersToAppend), maxTransformationItems)...)
+	for i := range t.HeadersToAppend {
+		allErrs = append(allErrs, t.HeadersToAppend[i].validate(headersToAppendPath.Index(i))...)
+	}
+	allErrs = append(allErrs, validateMaxItems(fldPath.Child("headersToRemove"), len(t.HeadersToRemove), maxTransformationItems)...)
+	if t.BodyTransformation != nil {
+		allErrs = append(allErrs, t.BodyTransformation.validate(fldPath.Child("bodyTransformation"))...)
+	}
+	if t.ParseBodyBehavior != nil {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("parseBodyBehavior"), string(*t.ParseBodyBehavior), supportedRequestBodyParses)...)
+	}
+	dynamicMetadataValuesPath := fldPath.Child("dynamicMetadataValues")
+	allErrs = append(allErrs, validateMaxItems(dynamicMetadataValuesPath, len(t.DynamicMetadataValues), maxTransformationItems)...)
+	for i := range t.DynamicMetadataValues {
+		allErrs = append(allErrs, t.DynamicMetadataValues[i].validate(dynamicMetadataValuesPath.Index(i))...)
+	}
+	if t.EscapeCharacters != nil {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("escapeCharacters"), string(*t.EscapeCharacters), supportedEscapeCharactersBehaviors)...)
+	}
+	return allErrs
+}
+
+// Validate validates the extraction.
+func (e *Extraction) Validate() field.ErrorList {
+	return e.validate(nil)
+}
+
+func (e *Extraction) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, atMostOneOf(fldPath,
+		oneOfField{"body", e.ExtractionBody != nil},
+		oneOfField{"header", e.ExtractionHeader != nil},
+	)...)
+	if e.ExtractionHeader != nil {
+		allErrs = append(allErrs, validateLength(fldPath.Child("header"), *e.ExtractionHeader, 1, maxTransformationStringLength)...)
+	}
+	allErrs = append(allErrs, validateLength(fldPath.Child("regex"), e.Regex, 1, maxTransformationStringLength)...)
+	if e.Subgroup != nil {
+		allErrs = append(allErrs, validateMinimum(fldPath.Child("subgroup"), int64(*e.Subgroup), 0)...)
+	}
+	if e.Mode != nil {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("mode"), string(*e.Mode), supportedExtractModes)...)
+	}
+	return allErrs
+}
+
+// Validate validates the header to append.
+func (h *HeaderToAppend) Validate() field.ErrorList {
+	return h.validate(nil)
+}
+
+func (h *HeaderToAppend) validate(fldPath *field.Path) field.ErrorList {
+	return validateLength(fldPath.Child("key"), h.Key, 1, maxTransformationStringLength)
+}
+
+// Validate validates the dynamic metadata value.
+func (d *DynamicMetadataValue) Validate() field.ErrorList {
+	return d.validate(nil)
+}
+
+func (d *DynamicMetadataValue) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if d.MetadataNamespace != nil {
+		allErrs = append(allErrs, validateLength(fldPath.Child("metadataNamespace"), *d.MetadataNamespace, 1, maxTransformationStringLength)...)
+	}
+	allErrs = append(allErrs, validateLength(fldPath.Child("key"), d.Key, 1, maxTransformationStringLength)...)
+	return allErrs
+}
+
+// Validate validates the body transformation.
+func (b *BodyTransformation) Validate() field.ErrorList {
+	return b.validate(nil)
+}
+
+func (b *BodyTransformation) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateEnum(fldPath.Child("type"), string(b.Type), supportedBodyTransformationTypes)...)
+	allErrs = append(allErrs, atMostOneOf(fldPath,
+		oneOfField{"body", b.Body != nil},
+		oneOfField{"mergeJsonKeys", len(b.MergeJsonKeys) > 0},
+	)...)
+	return allErrs
+}
+
+// oneOfField is a member of a oneOf group, named by its JSON field name.
+type oneOfField struct {
+	name string
+	set  bool
+}
+
+func countSet(fields []oneOfField) (int, []string) {
+	count := 0
+	names := make([]string, 0, len(fields))
+	for _, f := range fields {
+		names = append(names, f.name)
+		if f.set {
+			count++
+		}
+	}
+	return count, names
+}
+
+// exactlyOneOf mirrors the +kubebuilder:validation:ExactlyOneOf marker.
+func exactlyOneOf(fldPath *field.Path, fields ...oneOfField) field.ErrorList {
+	count, names := countSet(fields)
+	if count == 1 {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, "object", fmt.Sprintf("exactly one of the fields in [%s] must be set", strings.Join(names, " ")))}
+}
+
+// atMostOneOf mirrors the +kubebuilder:validation:AtMostOneOf marker.
+func atMostOneOf(fldPath *field.Path, fields ...oneOfField) field.ErrorList {
+	count, names := countSet(fields)
+	if count <= 1 {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, "object", fmt.Sprintf("at most one of the fields in [%s] may be set", strings.Join(names, " ")))}
+}
+
+func validateMinItems[T any](fldPath *field.Path, items []T, minItems int) field.ErrorList {
+	if len(items) < minItems {
+		return field.ErrorList{field.Invalid(fldPath, items, fmt.Sprintf("%s in body should have at least %d items", fldPath, minItems))}
+	}
+	return nil
+}
+
+func validateMaxItems(fldPath *field.Path, length, maxItems int) field.ErrorList {
+	if length > maxItems {
+		return field.ErrorList{field.TooMany(fldPath, length, maxItems)}
+	}
+	return nil
+}
+
+func validateMaxProperties(fldPath *field.Path, length, maxProperties int) field.ErrorList {
+	if length > maxProperties {
+		return field.ErrorList{field.TooMany(fldPath, length, maxProperties)}
+	}
+	return nil
+}
+
+func validateMinimum(fldPath *field.Path, value, minimum int64) field.ErrorList {
+	if value < minimum {
+		return field.ErrorList{field.Invalid(fldPath, value, fmt.Sprintf("%s in body should be greater than or equal to %d", fldPath, minimum))}
+	}
+	return nil
+}
+
+func validateMinLength(fldPath *field.Path, value string, minLength int) field.ErrorList {
+	if utf8.RuneCountInString(value) < minLength {
+		return field.ErrorList{field.Invalid(fldPath, value, fmt.Sprintf("%s in body should be at least %d chars long", fldPath, minLength))}
+	}
+	return nil
+}
+
+func validateLength(fldPath *field.Path, value string, minLength, maxLength int) field.ErrorList {
+	if errs := validateMinLength(fldPath, value, minLength); len(errs) > 0 {
+		return errs
+	}
+	if utf8.RuneCountInString(value) > maxLength {
+		return field.ErrorList{field.TooLong(fldPath, "", maxLength)}
+	}
+	return nil
+}
+
+func validateEnum(fldPath *field.Path, value string, supported []string) field.ErrorList {
+	for _, s := range supported {
+		if value == s {
+			return nil
+		}
+	}
+	return field.ErrorList{field.NotSupported(fldPath, value, supported)}
+}
+
+// validateRegex checks that value is a valid RE2 regex.
+func validateRegex(fldPath *field.Path, value string) field.ErrorList {
+	if _, err := regexp.Compile(value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, value, fmt.Sprintf("must be a valid RE2 regex: %v", err))}
+	}
+	return nil
+}
@@ -0,0 +1,542 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by defaulter-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// RegisterDefaults adds defaulters functions to the given scheme.
+// Public to allow building arbitrary schemes.
+// All generated defaulters are covering - they call all nested defaulters.
+func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&EnterpriseKgatewayParameters{}, func(obj interface{}) {
+		SetObjectDefaults_EnterpriseKgatewayParameters(obj.(*EnterpriseKgatewayParameters))
+	})
+	scheme.AddTypeDefaultingFunc(&EnterpriseKgatewayParametersList{}, func(obj interface{}) {
+		SetObjectDefaults_EnterpriseKgatewayParametersList(obj.(*EnterpriseKgatewayParametersList))
+	})
+	scheme.AddTypeDefaultingFunc(&EnterpriseKgatewayTrafficPolicy{}, func(obj interface{}) {
+		SetObjectDefaults_EnterpriseKgatewayTrafficPolicy(obj.(*EnterpriseKgatewayTrafficPolicy))
+	})
+	scheme.AddTypeDefaultingFunc(&EnterpriseKgatewayTrafficPolicyList{}, func(obj interface{}) {
+		SetObjectDefaults_EnterpriseKgatewayTrafficPolicyList(obj.(*EnterpriseKgatewayTrafficPolicyList))
+	})
+	return nil
+}
+
+func SetObjectDefaults_EnterpriseKgatewayParameters(in *EnterpriseKgatewayParameters) {
+	if in.Spec.Kube != nil {
+		if in.Spec.Kube.EnvoyContainer != nil {
+			for i := range in.Spec.Kube.EnvoyContainer.Env {
+				a := &in.Spec.Kube.EnvoyContainer.Env[i]
+				if a.ValueFrom != nil {
+					if a.ValueFrom.FileKeyRef != nil {
+						if a.ValueFrom.FileKeyRef.Optional == nil {
+							var ptrVar1 bool = false
+							a.ValueFrom.FileKeyRef.Optional = &ptrVar1
+						}
+					}
+				}
+			}
+		}
+		if in.Spec.Kube.PodTemplate != nil {
+			if in.Spec.Kube.PodTemplate.StartupProbe != nil {
+				if in.Spec.Kube.PodTemplate.StartupProbe.ProbeHandler.GRPC != nil {
+					if in.Spec.Kube.PodTemplate.StartupProbe.ProbeHandler.GRPC.Service == nil {
+						var ptrVar1 string = ""
+						in.Spec.Kube.PodTemplate.StartupProbe.ProbeHandler.GRPC.Service = &ptrVar1
+					}
+				}
+			}
+			if in.Spec.Kube.PodTemplate.ReadinessProbe != nil {
+				if in.Spec.Kube.PodTemplate.ReadinessProbe.ProbeHandler.GRPC != nil {
+					if in.Spec.Kube.PodTemplate.ReadinessProbe.ProbeHandler.GRPC.Service == nil {
+						var ptrVar1 string = ""
+						in.Spec.Kube.PodTemplate.ReadinessProbe.ProbeHandler.GRPC.Service = &ptrVar1
+					}
+				}
+			}
+			if in.Spec.Kube.PodTemplate.LivenessProbe != nil {
+				if in.Spec.Kube.PodTemplate.LivenessProbe.ProbeHandler.GRPC != nil {
+					if in.Spec.Kube.PodTemplate.LivenessProbe.ProbeHandler.GRPC.Service == nil {
+						var ptrVar1 string = ""
+						in.Spec.Kube.PodTemplate.LivenessProbe.ProbeHandler.GRPC.Service = &ptrVar1
+					}
+				}
+			}
+			for i := range in.Spec.Kube.PodTemplate.ExtraVolumes {
+				a := &in.Spec.Kube.PodTemplate.ExtraVolumes[i]
+				if a.VolumeSource.ISCSI != nil {
+					if a.VolumeSource.ISCSI.ISCSIInterface == "" {
+						a.VolumeSource.ISCSI.ISCSIInterface = "default"
+					}
+				}
+				if a.VolumeSource.RBD != nil {
+					if a.VolumeSource.RBD.RBDPool == "" {
+						a.VolumeSource.RBD.RBDPool = "rbd"
+					}
+					if a.VolumeSource.RBD.RadosUser == "" {
+						a.VolumeSource.RBD.RadosUser = "admin"
+					}
+					if a.VolumeSource.RBD.Keyring == "" {
+						a.VolumeSource.RBD.Keyring = "/etc/ceph/keyring"
+					}
+				}
+				if a.VolumeSource.AzureDisk != nil {
+					if a.VolumeSource.AzureDisk.CachingMode == nil {
+						ptrVar1 := v1.AzureDataDiskCachingMode(v1.AzureDataDiskCachingReadWrite)
+						a.VolumeSource.AzureDisk.CachingMode = &ptrVar1
+					}
+					if a.VolumeSource.AzureDisk.FSType == nil {
+						var ptrVar1 string = "ext4"
+						a.VolumeSource.AzureDisk.FSType = &ptrVar1
+					}
+					if a.VolumeSource.AzureDisk.ReadOnly == nil {
+						var ptrVar1 bool = false
+						a.VolumeSource.AzureDisk.ReadOnly = &ptrVar1
+					}
+					if a.VolumeSource.AzureDisk.Kind == nil {
+						ptrVar1 := v1.AzureDataDiskKind(v1.AzureSharedBlobDisk)
+						a.VolumeSource.AzureDisk.Kind = &ptrVar1
+					}
+				}
+				if a.VolumeSource.ScaleIO != nil {
+					if a.VolumeSource.ScaleIO.StorageMode == "" {
+						a.VolumeSource.ScaleIO.StorageMode = "ThinProvisioned"
+					}
+					if a.VolumeSource.ScaleIO.FSType == "" {
+						a.VolumeSource.ScaleIO.FSType = "xfs"
+					}
+				}
+			}
+		}
+		if in.Spec.Kube.Istio != nil {
+			for i := range in.Spec.Kube.Istio.CustomSidecars {
+				a := &in.Spec.Kube.Istio.CustomSidecars[i]
+				for j := range a.Ports {
+					b := &a.Ports[j]
+					if b.Protocol == "" {
+						b.Protocol = "TCP"
+					}
+				}
+				for j := range a.Env {
+					b := &a.Env[j]
+					if b.ValueFrom != nil {
+						if b.ValueFrom.FileKeyRef != nil {
+							if b.ValueFrom.FileKeyRef.Optional == nil {
+								var ptrVar1 bool = false
+								b.ValueFrom.FileKeyRef.Optional = &ptrVar1
+							}
+						}
+					}
+				}
+				if a.LivenessProbe != nil {
+					if a.LivenessProbe.ProbeHandler.GRPC != nil {
+						if a.LivenessProbe.ProbeHandler.GRPC.Service == nil {
+							var ptrVar1 string = ""
+							a.LivenessProbe.ProbeHandler.GRPC.Service = &ptrVar1
+						}
+					}
+				}
+				if a.ReadinessProbe != nil {
+					if a.ReadinessProbe.ProbeHandler.GRPC != nil {
+						if a.ReadinessProbe.ProbeHandler.GRPC.Service == nil {
+							var ptrVar1 string = ""
+							a.ReadinessProbe.ProbeHandler.GRPC.Service = &ptrVar1
+						}
+					}
+				}
+				if a.StartupProbe != nil {
+					if a.StartupProbe.ProbeHandler.GRPC != nil {
+						if a.StartupProbe.ProbeHandler.GRPC.Service == nil {
+							var ptrVar1 string = ""
+							a.StartupProbe.ProbeHandler.GRPC.Service = &ptrVar1
+						}
+					}
+				}
+			}
+		}
+		if in.Spec.Kube.SharedExtensions != nil {
+			if in.Spec.Kube.SharedExtensions.ExtAuth != nil {
+				if in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate != nil {
+					if in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.StartupProbe != nil {
+						if in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.StartupProbe.ProbeHandler.GRPC != nil {
+							if in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.StartupProbe.ProbeHandler.GRPC.Service == nil {
+								var ptrVar1 string = ""
+								in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.StartupProbe.ProbeHandler.GRPC.Service = &ptrVar1
+							}
+						}
+					}
+					if in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.ReadinessProbe != nil {
+						if in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.ReadinessProbe.ProbeHandler.GRPC != nil {
+							if in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.ReadinessProbe.ProbeHandler.GRPC.Service == nil {
+								var ptrVar1 string = ""
+								in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.ReadinessProbe.ProbeHandler.GRPC.Service = &ptrVar1
+							}
+						}
+					}
+					if in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.LivenessProbe != nil {
+						if in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.LivenessProbe.ProbeHandler.GRPC != nil {
+							if in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.LivenessProbe.ProbeHandler.GRPC.Service == nil {
+								var ptrVar1 string = ""
+								in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.LivenessProbe.ProbeHandler.GRPC.Service = &ptrVar1
+							}
+						}
+					}
+					for i := range in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.ExtraVolumes {
+						a := &in.Spec.Kube.SharedExtensions.ExtAuth.DeploymentConfiguration.PodTemplate.ExtraVolumes[i]
+						if a.VolumeSource.ISCSI != nil {
+							if a.VolumeSource.ISCSI.ISCSIInterface == "" {
+								a.VolumeSource.ISCSI.ISCSIInterface = "default"
+							}
+						}
+						if a.VolumeSource.RBD != nil {
+							if a.VolumeSource.RBD.RBDPool == "" {
+								a.VolumeSource.RBD.RBDPool = "rbd"
+							}
+							if a.VolumeSource.RBD.RadosUser == "" {
+								a.VolumeSource.RBD.RadosUser = "admin"
+							}
+							if a.VolumeSource.RBD.Keyring == "" {
+								a.VolumeSource.RBD.Keyring = "/etc/ceph/keyring"
+							}
+						}
+						if a.VolumeSource.AzureDisk != nil {
+							if a.VolumeSource.AzureDisk.CachingMode == nil {
+								ptrVar1 := v1.AzureDataDiskCachingMode(v1.AzureDataDiskCachingReadWrite)
+								a.VolumeSource.AzureDisk.CachingMode = &ptrVar1
+							}
+							if a.VolumeSource.AzureDisk.FSType == nil {
+								var ptrVar1 string = "ext4"
+								a.VolumeSource.AzureDisk.FSType = &ptrVar1
+							}
+							if a.VolumeSource.AzureDisk.ReadOnly == nil {
+								var ptrVar1 bool = false
+								a.VolumeSource.AzureDisk.ReadOnly = &ptrVar1
+							}
+							if a.VolumeSource.AzureDisk.Kind == nil {
+								ptrVar1 := v1.AzureDataDiskKind(v1.AzureSharedBlobDisk)
+								a.VolumeSource.AzureDisk.Kind = &ptrVar1
+							}
+						}
+						if a.VolumeSource.ScaleIO != nil {
+							if a.VolumeSource.ScaleIO.StorageMode == "" {
+								a.VolumeSource.ScaleIO.StorageMode = "ThinProvisioned"
+							}
+							if a.VolumeSource.ScaleIO.FSType == "" {
+								a.VolumeSource.ScaleIO.FSType = "xfs"
+							}
+						}
+					}
+				}
+			}
+			if in.Spec.Kube.SharedExtensions.RateLimiter != nil {
+				if in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate != nil {
+					if in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.StartupProbe != nil {
+						if in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.StartupProbe.ProbeHandler.GRPC != nil {
+							if in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.StartupProbe.ProbeHandler.GRPC.Service == nil {
+								var ptrVar1 string = ""
+								in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.StartupProbe.ProbeHandler.GRPC.Service = &ptrVar1
+							}
+						}
+					}
+					if in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.ReadinessProbe != nil {
+						if in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.ReadinessProbe.ProbeHandler.GRPC != nil {
+							if in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.ReadinessProbe.ProbeHandler.GRPC.Service == nil {
+								var ptrVar1 string = ""
+								in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.ReadinessProbe.ProbeHandler.GRPC.Service = &ptrVar1
+							}
+						}
+					}
+					if in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.LivenessProbe != nil {
+						if in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.LivenessProbe.ProbeHandler.GRPC != nil {
+							if in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.LivenessProbe.ProbeHandler.GRPC.Service == nil {
+								var ptrVar1 string = ""
+								in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.LivenessProbe.ProbeHandler.GRPC.Service = &ptrVar1
+							}
+						}
+					}
+					for i := range in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.ExtraVolumes {
+						a := &in.Spec.Kube.SharedExtensions.RateLimiter.DeploymentConfiguration.PodTemplate.ExtraVolumes[i]
+						if a.VolumeSource.ISCSI != nil {
+							if a.VolumeSource.ISCSI.ISCSIInterface == "" {
+								a.VolumeSource.ISCSI.ISCSIInterface = "default"
+							}
+						}
+						if a.VolumeSource.RBD != nil {
+							if a.VolumeSource.RBD.RBDPool == "" {
+								a.VolumeSource.RBD.RBDPool = "rbd"
+							}
+							if a.VolumeSource.RBD.RadosUser == "" {
+								a.VolumeSource.RBD.RadosUser = "admin"
+							}
+							if a.VolumeSource.RBD.Keyring == "" {
+								a.VolumeSource.RBD.Keyring = "/etc/ceph/keyring"
+							}
+						}
+						if a.VolumeSource.AzureDisk != nil {
+							if a.VolumeSource.AzureDisk.CachingMode == nil {
+								ptrVar1 := v1.AzureDataDiskCachingMode(v1.AzureDataDiskCachingReadWrite)
+								a.VolumeSource.AzureDisk.CachingMode = &ptrVar1
+							}
+							if a.VolumeSource.AzureDisk.FSType == nil {
+								var ptrVar1 string = "ext4"
+								a.VolumeSource.AzureDisk.FSType = &ptrVar1
+							}
+							if a.VolumeSource.AzureDisk.ReadOnly == nil {
+								var ptrVar1 bool = false
+								a.VolumeSource.AzureDisk.ReadOnly = &ptrVar1
+							}
+							if a.VolumeSource.AzureDisk.Kind == nil {
+								ptrVar1 := v1.AzureDataDiskKind(v1.AzureSharedBlobDisk)
+								a.VolumeSource.AzureDisk.Kind = &ptrVar1
+							}
+						}
+						if a.VolumeSource.ScaleIO != nil {
+							if a.VolumeSource.ScaleIO.StorageMode == "" {
+								a.VolumeSource.ScaleIO.StorageMode = "ThinProvisioned"
+							}
+							if a.VolumeSource.ScaleIO.FSType == "" {
+								a.VolumeSource.ScaleIO.FSType = "xfs"
+							}
+						}
+					}
+				}
+			}
+			if in.Spec.Kube.SharedExtensions.ExtCache != nil {
+				if in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate != nil {
+					if in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.StartupProbe != nil {
+						if in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.StartupProbe.ProbeHandler.GRPC != nil {
+							if in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.StartupProbe.ProbeHandler.GRPC.Service == nil {
+								var ptrVar1 string = ""
+								in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.StartupProbe.ProbeHandler.GRPC.Service = &ptrVar1
+							}
+						}
+					}
+					if in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.ReadinessProbe != nil {
+						if in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.ReadinessProbe.ProbeHandler.GRPC != nil {
+							if in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.ReadinessProbe.ProbeHandler.GRPC.Service == nil {
+								var ptrVar1 string = ""
+								in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.ReadinessProbe.ProbeHandler.GRPC.Service = &ptrVar1
+							}
+						}
+					}
+					if in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.LivenessProbe != nil {
+						if in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.LivenessProbe.ProbeHandler.GRPC != nil {
+							if in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.LivenessProbe.ProbeHandler.GRPC.Service == nil {
+								var ptrVar1 string = ""
+								in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.LivenessProbe.ProbeHandler.GRPC.Service = &ptrVar1
+							}
+						}
+					}
+					for i := range in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.ExtraVolumes {
+						a := &in.Spec.Kube.SharedExtensions.ExtCache.PodTemplate.ExtraVolumes[i]
+						if a.VolumeSource.ISCSI != nil {
+							if a.VolumeSource.ISCSI.ISCSIInterface == "" {
+								a.VolumeSource.ISCSI.ISCSIInterface = "default"
+							}
+						}
+						if a.VolumeSource.RBD != nil {
+							if a.VolumeSource.RBD.RBDPool == "" {
+								a.VolumeSource.RBD.RBDPool = "rbd"
+							}
+							if a.VolumeSource.RBD.RadosUser == "" {
+								a.VolumeSource.RBD.RadosUser = "admin"
+							}
+							if a.VolumeSource.RBD.Keyring == "" {
+								a.VolumeSource.RBD.Keyring = "/etc/ceph/keyring"
+							}
+						}
+						if a.VolumeSource.AzureDisk != nil {
+							if a.VolumeSource.AzureDisk.CachingMode == nil {
+								ptrVar1 := v1.AzureDataDiskCachingMode(v1.AzureDataDiskCachingReadWrite)
+								a.VolumeSource.AzureDisk.CachingMode = &ptrVar1
+							}
+							if a.VolumeSource.AzureDisk.FSType == nil {
+								var ptrVar1 string = "ext4"
+								a.VolumeSource.AzureDisk.FSType = &ptrVar1
+							}
+							if a.VolumeSource.AzureDisk.ReadOnly == nil {
+								var ptrVar1 bool = false
+								a.VolumeSource.AzureDisk.ReadOnly = &ptrVar1
+							}
+							if a.VolumeSource.AzureDisk.Kind == nil {
+								ptrVar1 := v1.AzureDataDiskKind(v1.AzureSharedBlobDisk)
+								a.VolumeSource.AzureDisk.Kind = &ptrVar1
+							}
+						}
+						if a.VolumeSource.ScaleIO != nil {
+							if a.VolumeSource.ScaleIO.StorageMode == "" {
+								a.VolumeSource.ScaleIO.StorageMode = "ThinProvisioned"
+							}
+							if a.VolumeSource.ScaleIO.FSType == "" {
+								a.VolumeSource.ScaleIO.FSType = "xfs"
+							}
+						}
+					}
+				}
+			}
+			if in.Spec.Kube.SharedExtensions.WAF != nil {
+				if in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate != nil {
+					if in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.StartupProbe != nil {
+						if in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.StartupProbe.ProbeHandler.GRPC != nil {
+							if in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.StartupProbe.ProbeHandler.GRPC.Service == nil {
+								var ptrVar1 string = ""
+								in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.StartupProbe.ProbeHandler.GRPC.Service = &ptrVar1
+							}
+						}
+					}
+					if in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.ReadinessProbe != nil {
+						if in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.ReadinessProbe.ProbeHandler.GRPC != nil {
+							if in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.ReadinessProbe.ProbeHandler.GRPC.Service == nil {
+								var ptrVar1 string = ""
+								in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.ReadinessProbe.ProbeHandler.GRPC.Service = &ptrVar1
+							}
+						}
+					}
+					if in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.LivenessProbe != nil {
+						if in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.LivenessProbe.ProbeHandler.GRPC != nil {
+							if in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.LivenessProbe.ProbeHandler.GRPC.Service == nil {
+								var ptrVar1 string = ""
+								in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.LivenessProbe.ProbeHandler.GRPC.Service = &ptrVar1
+							}
+						}
+					}
+					for i := range in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.ExtraVolumes {
+						a := &in.Spec.Kube.SharedExtensions.WAF.DeploymentConfiguration.PodTemplate.ExtraVolumes[i]
+						if a.VolumeSource.ISCSI != nil {
+							if a.VolumeSource.ISCSI.ISCSIInterface == "" {
+								a.VolumeSource.ISCSI.ISCSIInterface = "default"
+							}
+						}
+						if a.VolumeSource.RBD != nil {
+							if a.VolumeSource.RBD.RBDPool == "" {
+								a.VolumeSource.RBD.RBDPool = "rbd"
+							}
+							if a.VolumeSource.RBD.RadosUser == "" {
+								a.VolumeSource.RBD.RadosUser = "admin"
+							}
+							if a.VolumeSource.RBD.Keyring == "" {
+								a.VolumeSource.RBD.Keyring = "/etc/ceph/keyring"
+							}
+						}
+						if a.VolumeSource.AzureDisk != nil {
+							if a.VolumeSource.AzureDisk.CachingMode == nil {
+								ptrVar1 := v1.AzureDataDiskCachingMode(v1.AzureDataDiskCachingReadWrite)
+								a.VolumeSource.AzureDisk.CachingMode = &ptrVar1
+							}
+							if a.VolumeSource.AzureDisk.FSType == nil {
+								var ptrVar1 string = "ext4"
+								a.VolumeSource.AzureDisk.FSType = &ptrVar1
+							}
+							if a.VolumeSource.AzureDisk.ReadOnly == nil {
+								var ptrVar1 bool = false
+								a.VolumeSource.AzureDisk.ReadOnly = &ptrVar1
+							}
+							if a.VolumeSource.AzureDisk.Kind == nil {
+								ptrVar1 := v1.AzureDataDiskKind(v1.AzureSharedBlobDisk)
+								a.VolumeSource.AzureDisk.Kind = &ptrVar1
+							}
+						}
+						if a.VolumeSource.ScaleIO != nil {
+							if a.VolumeSource.ScaleIO.StorageMode == "" {
+								a.VolumeSource.ScaleIO.StorageMode = "ThinProvisioned"
+							}
+							if a.VolumeSource.ScaleIO.FSType == "" {
+								a.VolumeSource.ScaleIO.FSType = "xfs"
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func SetObjectDefaults_EnterpriseKgatewayParametersList(in *EnterpriseKgatewayParametersList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_EnterpriseKgatewayParameters(a)
+	}
+}
+
+func SetObjectDefaults_EnterpriseKgatewayTrafficPolicy(in *EnterpriseKgatewayTrafficPolicy) {
+	if in.Spec.EntTransformation != nil {
+		if in.Spec.EntTransformation.Stages != nil {
+			if in.Spec.EntTransformation.Stages.Early != nil {
+				for i := range in.Spec.EntTransformation.Stages.Early.Requests {
+					a := &in.Spec.EntTransformation.Stages.Early.Requests[i]
+					if a.Matcher != nil {
+						SetDefaults_TransformationRequestMatcher(a.Matcher)
+					}
+					if a.Transformation.Template != nil {
+						SetDefaults_TransformationTemplate(a.Transformation.Template)
+					}
+				}
+				for i := range in.Spec.EntTransformation.Stages.Early.Responses {
+					a := &in.Spec.EntTransformation.Stages.Early.Responses[i]
+					if a.Transformation.Template != nil {
+						SetDefaults_TransformationTemplate(a.Transformation.Template)
+					}
+				}
+			}
+			if in.Spec.EntTransformation.Stages.Regular != nil {
+				for i := range in.Spec.EntTransformation.Stages.Regular.Requests {
+					a := &in.Spec.EntTransformation.Stages.Regular.Requests[i]
+					if a.Matcher != nil {
+						SetDefaults_TransformationRequestMatcher(a.Matcher)
+					}
+					if a.Transformation.Template != nil {
+						SetDefaults_TransformationTemplate(a.Transformation.Template)
+					}
+				}
+				for i := range in.Spec.EntTransformation.Stages.Regular.Responses {
+					a := &in.Spec.EntTransformation.Stages.Regular.Responses[i]
+					if a.Transformation.Template != nil {
+						SetDefaults_TransformationTemplate(a.Transformation.Template)
+					}
+				}
+			}
+			if in.Spec.EntTransformation.Stages.PostRouting != nil {
+				for i := range in.Spec.EntTransformation.Stages.PostRouting.Requests {
+					a := &in.Spec.EntTransformation.Stages.PostRouting.Requests[i]
+					if a.Matcher != nil {
+						SetDefaults_TransformationRequestMatcher(a.Matcher)
+					}
+					if a.Transformation.Template != nil {
+						SetDefaults_TransformationTemplate(a.Transformation.Template)
+					}
+				}
+				for i := range in.Spec.EntTransformation.Stages.PostRouting.Responses {
+					a := &in.Spec.EntTransformation.Stages.PostRouting.Responses[i]
+					if a.Transformation.Template != nil {
+						SetDefaults_TransformationTemplate(a.Transformation.Template)
+					}
+				}
+			}
+		}
+	}
+	if in.Spec.EntJWT != nil {
+		if in.Spec.EntJWT.AfterExtAuth != nil {
+			SetDefaults_EntJWT(in.Spec.EntJWT.AfterExtAuth)
+		}
+		if in.Spec.EntJWT.BeforeExtAuth != nil {
+			SetDefaults_EntJWT(in.Spec.EntJWT.BeforeExtAuth)
+		}
+	}
+	if in.Spec.EntRBAC != nil {
+		SetDefaults_EntRBAC(in.Spec.EntRBAC)
+	}
+}
+
+func SetObjectDefaults_EnterpriseKgatewayTrafficPolicyList(in *EnterpriseKgatewayTrafficPolicyList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_EnterpriseKgatewayTrafficPolicy(a)
+	}
+}
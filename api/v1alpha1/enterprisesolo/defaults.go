@@ -0,0 +1,36 @@
+package enterprisesolo
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func init() {
+	localSchemeBuilder.Register(addDefaultingFuncs)
+}
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+func SetDefaults_ParentGatewayReference(obj *ParentGatewayReference) {
+	if obj.Group == nil {
+		obj.Group = ptr.To(Group(gwv1.GroupName))
+	}
+	if obj.Kind == nil {
+		obj.Kind = ptr.To(Kind("Gateway"))
+	}
+}
+
+func SetDefaults_EnterpriseListenerEntry(obj *EnterpriseListenerEntry) {
+	if obj.AllowedRoutes == nil {
+		obj.AllowedRoutes = &AllowedRoutes{}
+	}
+}
+
+func SetDefaults_AllowedRoutes(obj *AllowedRoutes) {
+	if obj.Namespaces == nil {
+		obj.Namespaces = &RouteNamespaces{From: ptr.To(gwv1.NamespacesFromSame)}
+	}
+}
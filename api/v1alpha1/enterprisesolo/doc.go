@@ -2,5 +2,6 @@ package enterprisesolo
 
 // +k8s:openapi-gen=true
 // +kubebuilder:object:generate=true
+// +k8s:defaulter-gen=TypeMeta
 // +groupName=enterprise.solo.io
 // +versionName=v1alpha1
@@ -0,0 +1,93 @@
+package enterprisesolo
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// The Validate methods in this file mirror the OpenAPI markers on the
+// EnterpriseListenerSetSpec type tree, including the ones of the Gateway API types it
+// reuses, so that manifests can be checked without an API server. The XValidation rules
+// are left to the celvalidation package.
+
+const (
+	// maxListeners is the MaxItems bound on EnterpriseListenerSetSpec.Listeners.
+	maxListeners = 64
+	// maxRouteKinds is the MaxItems bound on AllowedRoutes.Kinds.
+	maxRouteKinds = 8
+	// maxNameLength is the MaxLength bound on ObjectName, SectionName and Hostname.
+	maxNameLength = 253
+)
+
+const (
+	// sectionNamePattern mirrors the Pattern marker on SectionName.
+	sectionNamePattern = `^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// hostnamePattern mirrors the Pattern marker on Hostname.
+	hostnamePattern = `^(\*\.)?[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+)
+
+var (
+	sectionNameRegexp = regexp.MustCompile(sectionNamePattern)
+	hostnameRegexp    = regexp.MustCompile(hostnamePattern)
+)
+
+// Validate validates the spec against the constraints declared on its fields.
+func (s *EnterpriseListenerSetSpec) Validate() field.ErrorList {
+	return s.validate(field.NewPath("spec"))
+}
+
+func (s *EnterpriseListenerSetSpec) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateName(fldPath.Child("parentRef", "name"), string(s.ParentRef.Name), nil, "")...)
+	p := fldPath.Child("listeners")
+	switch {
+	case s.Listeners == nil:
+		allErrs = append(allErrs, field.Required(p, ""))
+	case len(s.Listeners) < 1:
+		allErrs = append(allErrs, field.Invalid(p, s.Listeners, fmt.Sprintf("%s in body should have at least 1 items", p)))
+	case len(s.Listeners) > maxListeners:
+		allErrs = append(allErrs, field.TooMany(p, len(s.Listeners), maxListeners))
+	}
+	for i := range s.Listeners {
+		allErrs = append(allErrs, s.Listeners[i].validate(p.Index(i))...)
+	}
+	return allErrs
+}
+
+func (l *EnterpriseListenerEntry) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateName(fldPath.Child("name"), string(l.Name), sectionNameRegexp, sectionNamePattern)...)
+	if l.Hostname != nil {
+		allErrs = append(allErrs, validateName(fldPath.Child("hostname"), string(*l.Hostname), hostnameRegexp, hostnamePattern)...)
+	}
+	if p := fldPath.Child("port"); l.Port < 0 {
+		allErrs = append(allErrs, field.Invalid(p, l.Port, fmt.Sprintf("%s in body should be greater than or equal to 0", p)))
+	} else if l.Port > 65535 {
+		allErrs = append(allErrs, field.Invalid(p, l.Port, fmt.Sprintf("%s in body should be less than or equal to 65535", p)))
+	}
+	if l.Protocol == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("protocol"), ""))
+	}
+	if l.AllowedRoutes != nil && len(l.AllowedRoutes.Kinds) > maxRouteKinds {
+		allErrs = append(allErrs, field.TooMany(fldPath.Child("allowedRoutes", "kinds"), len(l.AllowedRoutes.Kinds), maxRouteKinds))
+	}
+	return allErrs
+}
+
+// validateName checks the MinLength=1 and MaxLength=253 markers of value and, if re is
+// set, its Pattern marker.
+func validateName(fldPath *field.Path, value string, re *regexp.Regexp, pattern string) field.ErrorList {
+	switch n := utf8.RuneCountInString(value); {
+	case n == 0:
+		return field.ErrorList{field.Required(fldPath, "")}
+	case n > maxNameLength:
+		return field.ErrorList{field.TooLong(fldPath, "", maxNameLength)}
+	}
+	if re != nil && !re.MatchString(value) {
+		return field.ErrorList{field.Invalid(fldPath, value, fmt.Sprintf("%s in body should match '%s'", fldPath, pattern))}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by defaulter-gen. DO NOT EDIT.
+
+package enterprisesolo
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// RegisterDefaults adds defaulters functions to the given scheme.
+// Public to allow building arbitrary schemes.
+// All generated defaulters are covering - they call all nested defaulters.
+func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&EnterpriseListenerSet{}, func(obj interface{}) { SetObjectDefaults_EnterpriseListenerSet(obj.(*EnterpriseListenerSet)) })
+	scheme.AddTypeDefaultingFunc(&EnterpriseListenerSetList{}, func(obj interface{}) { SetObjectDefaults_EnterpriseListenerSetList(obj.(*EnterpriseListenerSetList)) })
+	return nil
+}
+
+func SetObjectDefaults_EnterpriseListenerSet(in *EnterpriseListenerSet) {
+	SetDefaults_ParentGatewayReference(&in.Spec.ParentRef)
+	for i := range in.Spec.Listeners {
+		a := &in.Spec.Listeners[i]
+		SetDefaults_EnterpriseListenerEntry(a)
+		if a.AllowedRoutes != nil {
+			SetDefaults_AllowedRoutes(a.AllowedRoutes)
+		}
+	}
+}
+
+func SetObjectDefaults_EnterpriseListenerSetList(in *EnterpriseListenerSetList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_EnterpriseListenerSet(a)
+	}
+}
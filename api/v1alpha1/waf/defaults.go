@@ -0,0 +1,35 @@
+package waf
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func init() {
+	localSchemeBuilder.Register(addDefaultingFuncs)
+}
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+func SetDefaults_ProcessingConfig(obj *ProcessingConfig) {
+	if obj.Request == nil {
+		obj.Request = &RequestProcessingConfig{}
+	}
+	if obj.Response == nil {
+		obj.Response = &ResponseProcessingConfig{}
+	}
+}
+
+func SetDefaults_RequestProcessingConfig(obj *RequestProcessingConfig) {
+	if obj.Mode == nil {
+		obj.Mode = ptr.To(RequestProcessingModeHeaders)
+	}
+}
+
+func SetDefaults_ResponseProcessingConfig(obj *ResponseProcessingConfig) {
+	if obj.Mode == nil {
+		obj.Mode = ptr.To(ResponseProcessingModeHeaders)
+	}
+}
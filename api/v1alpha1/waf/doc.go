@@ -2,5 +2,6 @@ package waf
 
 // +k8s:openapi-gen=true
 // +kubebuilder:object:generate=true
+// +k8s:defaulter-gen=TypeMeta
 // +groupName=waf.solo.io
 // +versionName=v1alpha1
@@ -0,0 +1,125 @@
+package waf
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// The Validate methods in this file mirror the OpenAPI and oneOf markers on the
+// WAFPolicySpec type tree so that manifests can be checked without an API server, with
+// the messages of the apiextensions-apiserver.
+
+const (
+	// maxCustomDirectives is the MaxItems bound on WAFPolicySpec.CustomDirectives.
+	maxCustomDirectives = 16
+	// maxInterventionHeaders is the MaxItems bound on CustomInterventionResponseHeaders.SetHeaders.
+	maxInterventionHeaders = 32
+)
+
+var (
+	supportedRequestProcessingModes = []string{
+		string(RequestProcessingModeHeaders),
+		string(RequestProcessingModeHeadersAndBody),
+	}
+	supportedResponseProcessingModes = []string{
+		string(ResponseProcessingModeNone),
+		string(ResponseProcessingModeHeaders),
+		string(ResponseProcessingModeHeadersAndBody),
+	}
+)
+
+// Validate validates the spec against the constraints declared on its fields.
+func (s *WAFPolicySpec) Validate() field.ErrorList {
+	return s.validate(field.NewPath("spec"))
+}
+
+func (s *WAFPolicySpec) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if s.CoreRuleSet != nil {
+		allErrs = append(allErrs, s.CoreRuleSet.Settings.validate(fldPath.Child("coreRuleSet", "settings"))...)
+	}
+	allErrs = append(allErrs, s.RuleEngineSettings.validate(fldPath.Child("ruleEngineSettings"))...)
+	if c := s.ProcessingConfig; c != nil {
+		p := fldPath.Child("processingConfig")
+		if c.Request != nil && c.Request.Mode != nil && !slices.Contains(supportedRequestProcessingModes, string(*c.Request.Mode)) {
+			allErrs = append(allErrs, field.NotSupported(p.Child("request", "mode"), *c.Request.Mode, supportedRequestProcessingModes))
+		}
+		if c.Response != nil && c.Response.Mode != nil && !slices.Contains(supportedResponseProcessingModes, string(*c.Response.Mode)) {
+			allErrs = append(allErrs, field.NotSupported(p.Child("response", "mode"), *c.Response.Mode, supportedResponseProcessingModes))
+		}
+	}
+	if s.CustomDirectives != nil {
+		p := fldPath.Child("customDirectives")
+		allErrs = append(allErrs, validateItems(p, len(s.CustomDirectives), 1, maxCustomDirectives)...)
+		for i := range s.CustomDirectives {
+			allErrs = append(allErrs, s.CustomDirectives[i].validate(p.Index(i))...)
+		}
+	}
+	if s.CustomInterventionResponse != nil {
+		allErrs = append(allErrs, s.CustomInterventionResponse.validate(fldPath.Child("customInterventionResponse"))...)
+	}
+	return allErrs
+}
+
+func (d *DirectiveSource) validate(fldPath *field.Path) field.ErrorList {
+	set := 0
+	if d.Inline != nil {
+		set++
+	}
+	if d.ConfigMap != nil {
+		set++
+	}
+	if set != 1 {
+		return field.ErrorList{field.Invalid(fldPath, "object", "exactly one of the fields in [inline configMap] must be set")}
+	}
+	var allErrs field.ErrorList
+	if cm := d.ConfigMap; cm != nil {
+		p := fldPath.Child("configMap")
+		if cm.Name == "" {
+			allErrs = append(allErrs, field.Required(p.Child("name"), ""))
+		}
+		if cm.Namespace == "" {
+			allErrs = append(allErrs, field.Required(p.Child("namespace"), ""))
+		}
+		if cm.Keys != nil {
+			allErrs = append(allErrs, validateItems(p.Child("keys"), len(cm.Keys), 1, -1)...)
+		}
+	}
+	return allErrs
+}
+
+func (r *CustomInterventionResponse) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if r.StatusCode != nil {
+		p := fldPath.Child("statusCode")
+		if code := *r.StatusCode; code < 100 {
+			allErrs = append(allErrs, field.Invalid(p, code, fmt.Sprintf("%s in body should be greater than or equal to 100", p)))
+		} else if code > 599 {
+			allErrs = append(allErrs, field.Invalid(p, code, fmt.Sprintf("%s in body should be less than or equal to 599", p)))
+		}
+	}
+	if r.Headers != nil {
+		p := fldPath.Child("headers", "setHeaders")
+		allErrs = append(allErrs, validateItems(p, len(r.Headers.SetHeaders), 0, maxInterventionHeaders)...)
+		for i, h := range r.Headers.SetHeaders {
+			if h.Name == "" {
+				allErrs = append(allErrs, field.Required(p.Index(i).Child("name"), ""))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateItems mirrors the MinItems and MaxItems markers. A negative maxItems is not
+// checked.
+func validateItems(fldPath *field.Path, length, minItems, maxItems int) field.ErrorList {
+	if length < minItems {
+		return field.ErrorList{field.Invalid(fldPath, length, fmt.Sprintf("%s in body should have at least %d items", fldPath, minItems))}
+	}
+	if maxItems >= 0 && length > maxItems {
+		return field.ErrorList{field.TooMany(fldPath, length, maxItems)}
+	}
+	return nil
+}
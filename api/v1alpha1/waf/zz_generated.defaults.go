@@ -0,0 +1,38 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by defaulter-gen. DO NOT EDIT.
+
+package waf
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// RegisterDefaults adds defaulters functions to the given scheme.
+// Public to allow building arbitrary schemes.
+// All generated defaulters are covering - they call all nested defaulters.
+func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&WAFPolicy{}, func(obj interface{}) { SetObjectDefaults_WAFPolicy(obj.(*WAFPolicy)) })
+	scheme.AddTypeDefaultingFunc(&WAFPolicyList{}, func(obj interface{}) { SetObjectDefaults_WAFPolicyList(obj.(*WAFPolicyList)) })
+	return nil
+}
+
+func SetObjectDefaults_WAFPolicy(in *WAFPolicy) {
+	if in.Spec.ProcessingConfig != nil {
+		SetDefaults_ProcessingConfig(in.Spec.ProcessingConfig)
+		if in.Spec.ProcessingConfig.Request != nil {
+			SetDefaults_RequestProcessingConfig(in.Spec.ProcessingConfig.Request)
+		}
+		if in.Spec.ProcessingConfig.Response != nil {
+			SetDefaults_ResponseProcessingConfig(in.Spec.ProcessingConfig.Response)
+		}
+	}
+}
+
+func SetObjectDefaults_WAFPolicyList(in *WAFPolicyList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_WAFPolicy(a)
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	shared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	v1 "sigs.k8s.io/gateway-api/applyconfiguration/apis/v1"
+)
+
+// APIKeyAuthApplyConfiguration represents a declarative configuration of the APIKeyAuth type for use
+// with apply.
+type APIKeyAuthApplyConfiguration struct {
+	// keySources specifies the list of key sources to extract the API key from.
+	// Key sources are processed in array order and the first one that successfully
+	// extracts a key is used. Within each key source, if multiple types (header, query, cookie) are
+	// specified, precedence is: header > query parameter > cookie.
+	//
+	// If empty, defaults to a single key source with header "api-key".
+	//
+	// Example:
+	// keySources:
+	// - header: "X-API-KEY"
+	// - query: "api_key"
+	// - header: "Authorization"
+	// query: "token"
+	// cookie: "auth_token"
+	//
+	// In this example, the system will:
+	// 1. First try header "X-API-KEY"
+	// 2. If not found, try query parameter "api_key"
+	// 3. If not found, try header "Authorization" (then query "token", then cookie "auth_token" within that key source)
+	//
+	KeySources []APIKeySourceApplyConfiguration `json:"keySources,omitempty"`
+	// forwardCredential controls whether the API key is included in the request sent to the upstream.
+	// If false (default), the API key is removed from the request before sending to upstream.
+	// If true, the API key is included in the request sent to upstream.
+	// This applies to all configured key sources (header, query parameter, or cookie).
+	ForwardCredential *bool `json:"forwardCredential,omitempty"`
+	// clientIdHeader specifies the header name to forward the authenticated client identifier.
+	// If not specified, the client identifier will not be forwarded in any header.
+	// Example: "x-client-id"
+	ClientIdHeader *string `json:"clientIdHeader,omitempty"`
+	// secretRef references a Kubernetes secret storing a set of API Keys. If there are many keys, 'secretSelector' can be
+	// used instead.
+	//
+	// Each entry in the Secret represents one API Key. The key is an arbitrary identifier.
+	// The value is a string, representing the API Key.
+	//
+	// Example:
+	//
+	// apiVersion: v1
+	// kind: Secret
+	// metadata:
+	// name: api-key
+	// stringData:
+	// client1: "k-123"
+	// client2: "k-456"
+	//
+	SecretRef *v1.SecretObjectReferenceApplyConfiguration `json:"secretRef,omitempty"`
+	// secretSelector selects multiple secrets containing API Keys. If the same key is defined in multiple secrets, the
+	// behavior is undefined.
+	//
+	// Each entry in the Secret represents one API Key. The key is an arbitrary identifier.
+	// The value is a string, representing the API Key.
+	//
+	// Example:
+	//
+	// apiVersion: v1
+	// kind: Secret
+	// metadata:
+	// name: api-key
+	// stringData:
+	// client1: "k-123"
+	// client2: "k-456"
+	//
+	SecretSelector *LabelSelectorApplyConfiguration `json:"secretSelector,omitempty"`
+	// Disable the API key authentication filter.
+	// Can be used to disable API key authentication policies applied at a higher level in the config hierarchy.
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// APIKeyAuthApplyConfiguration constructs a declarative configuration of the APIKeyAuth type for use with
+// apply.
+func APIKeyAuth() *APIKeyAuthApplyConfiguration {
+	return &APIKeyAuthApplyConfiguration{}
+}
+
+// WithKeySources adds the given value to the KeySources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the KeySources field.
+func (b *APIKeyAuthApplyConfiguration) WithKeySources(values ...*APIKeySourceApplyConfiguration) *APIKeyAuthApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithKeySources")
+		}
+		b.KeySources = append(b.KeySources, *values[i])
+	}
+	return b
+}
+
+// WithForwardCredential sets the ForwardCredential field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ForwardCredential field is set to the value of the last call.
+func (b *APIKeyAuthApplyConfiguration) WithForwardCredential(value bool) *APIKeyAuthApplyConfiguration {
+	b.ForwardCredential = &value
+	return b
+}
+
+// WithClientIdHeader sets the ClientIdHeader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientIdHeader field is set to the value of the last call.
+func (b *APIKeyAuthApplyConfiguration) WithClientIdHeader(value string) *APIKeyAuthApplyConfiguration {
+	b.ClientIdHeader = &value
+	return b
+}
+
+// WithSecretRef sets the SecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretRef field is set to the value of the last call.
+func (b *APIKeyAuthApplyConfiguration) WithSecretRef(value *v1.SecretObjectReferenceApplyConfiguration) *APIKeyAuthApplyConfiguration {
+	b.SecretRef = value
+	return b
+}
+
+// WithSecretSelector sets the SecretSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretSelector field is set to the value of the last call.
+func (b *APIKeyAuthApplyConfiguration) WithSecretSelector(value *LabelSelectorApplyConfiguration) *APIKeyAuthApplyConfiguration {
+	b.SecretSelector = value
+	return b
+}
+
+// WithDisable sets the Disable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disable field is set to the value of the last call.
+func (b *APIKeyAuthApplyConfiguration) WithDisable(value shared.PolicyDisable) *APIKeyAuthApplyConfiguration {
+	b.Disable = &value
+	return b
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+// APIKeySourceApplyConfiguration represents a declarative configuration of the APIKeySource type for use
+// with apply.
+//
+// APIKeySource defines where to extract the API key from within a single key source.
+// Within a single key source, if multiple types are specified, precedence is:
+// header > query parameter > cookie. The header is checked first, and only falls back
+// to query parameter if the header is not present, then to cookie if both header and query
+// are not present.
+type APIKeySourceApplyConfiguration struct {
+	// header specifies the name of the header that contains the API key.
+	Header *string `json:"header,omitempty"`
+	// query specifies the name of the query parameter that contains the API key.
+	Query *string `json:"query,omitempty"`
+	// cookie specifies the name of the cookie that contains the API key.
+	Cookie *string `json:"cookie,omitempty"`
+}
+
+// APIKeySourceApplyConfiguration constructs a declarative configuration of the APIKeySource type for use with
+// apply.
+func APIKeySource() *APIKeySourceApplyConfiguration {
+	return &APIKeySourceApplyConfiguration{}
+}
+
+// WithHeader sets the Header field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Header field is set to the value of the last call.
+func (b *APIKeySourceApplyConfiguration) WithHeader(value string) *APIKeySourceApplyConfiguration {
+	b.Header = &value
+	return b
+}
+
+// WithQuery sets the Query field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Query field is set to the value of the last call.
+func (b *APIKeySourceApplyConfiguration) WithQuery(value string) *APIKeySourceApplyConfiguration {
+	b.Query = &value
+	return b
+}
+
+// WithCookie sets the Cookie field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cookie field is set to the value of the last call.
+func (b *APIKeySourceApplyConfiguration) WithCookie(value string) *APIKeySourceApplyConfiguration {
+	b.Cookie = &value
+	return b
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	shared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// BasicAuthPolicyApplyConfiguration represents a declarative configuration of the BasicAuthPolicy type for use
+// with apply.
+//
+// BasicAuthPolicy configures HTTP basic authentication using the Authorization header.
+// Basic authentication validates requests against username/password pairs provided either inline or via a Kubernetes secret.
+// The credentials must be in htpasswd SHA-1 format.
+type BasicAuthPolicyApplyConfiguration struct {
+	// Users provides an inline list of username/password pairs in htpasswd format.
+	// Each entry should be formatted as "username:hashed_password".
+	// The only supported hash format is SHA-1
+	//
+	// Example entries:
+	// - "user1:{SHA}d95o2uzYI7q7tY7bHI4U1xBug7s="
+	//
+	Users []string `json:"users,omitempty"`
+	// SecretRef references a Kubernetes secret containing htpasswd data.
+	// The secret must contain username/password pairs in htpasswd format.
+	SecretRef *SecretReferenceApplyConfiguration `json:"secretRef,omitempty"`
+	// Disable basic auth.
+	// Can be used to disable basic auth policies applied at a higher level in the config hierarchy.
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// BasicAuthPolicyApplyConfiguration constructs a declarative configuration of the BasicAuthPolicy type for use with
+// apply.
+func BasicAuthPolicy() *BasicAuthPolicyApplyConfiguration {
+	return &BasicAuthPolicyApplyConfiguration{}
+}
+
+// WithUsers adds the given value to the Users field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Users field.
+func (b *BasicAuthPolicyApplyConfiguration) WithUsers(values ...string) *BasicAuthPolicyApplyConfiguration {
+	for i := range values {
+		b.Users = append(b.Users, values[i])
+	}
+	return b
+}
+
+// WithSecretRef sets the SecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretRef field is set to the value of the last call.
+func (b *BasicAuthPolicyApplyConfiguration) WithSecretRef(value *SecretReferenceApplyConfiguration) *BasicAuthPolicyApplyConfiguration {
+	b.SecretRef = value
+	return b
+}
+
+// WithDisable sets the Disable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disable field is set to the value of the last call.
+func (b *BasicAuthPolicyApplyConfiguration) WithDisable(value shared.PolicyDisable) *BasicAuthPolicyApplyConfiguration {
+	b.Disable = &value
+	return b
+}
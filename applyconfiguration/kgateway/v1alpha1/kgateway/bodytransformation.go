@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	v1alpha1kgateway "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+// BodyTransformationApplyConfiguration represents a declarative configuration of the BodyTransformation type for use
+// with apply.
+//
+// BodyTransformation controls how the body should be parsed and transformed.
+type BodyTransformationApplyConfiguration struct {
+	// ParseAs defines what auto formatting should be applied to the body.
+	// This can make interacting with keys within a json body much easier if AsJson is selected.
+	ParseAs *v1alpha1kgateway.BodyParseBehavior `json:"parseAs,omitempty"`
+	// Value is the template to apply to generate the output value for the body.
+	// Only Inja templates are supported.
+	Value *v1alpha1kgateway.InjaTemplate `json:"value,omitempty"`
+}
+
+// BodyTransformationApplyConfiguration constructs a declarative configuration of the BodyTransformation type for use with
+// apply.
+func BodyTransformation() *BodyTransformationApplyConfiguration {
+	return &BodyTransformationApplyConfiguration{}
+}
+
+// WithParseAs sets the ParseAs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParseAs field is set to the value of the last call.
+func (b *BodyTransformationApplyConfiguration) WithParseAs(value v1alpha1kgateway.BodyParseBehavior) *BodyTransformationApplyConfiguration {
+	b.ParseAs = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *BodyTransformationApplyConfiguration) WithValue(value v1alpha1kgateway.InjaTemplate) *BodyTransformationApplyConfiguration {
+	b.Value = &value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	shared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// BufferApplyConfiguration represents a declarative configuration of the Buffer type for use
+// with apply.
+type BufferApplyConfiguration struct {
+	// MaxRequestSize sets the maximum size in bytes of a message body to buffer.
+	// Requests exceeding this size will receive HTTP 413.
+	// Example format: "1Mi", "512Ki", "1Gi"
+	MaxRequestSize *resource.Quantity `json:"maxRequestSize,omitempty"`
+	// Disable the buffer filter.
+	// Can be used to disable buffer policies applied at a higher level in the config hierarchy.
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// BufferApplyConfiguration constructs a declarative configuration of the Buffer type for use with
+// apply.
+func Buffer() *BufferApplyConfiguration {
+	return &BufferApplyConfiguration{}
+}
+
+// WithMaxRequestSize sets the MaxRequestSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRequestSize field is set to the value of the last call.
+func (b *BufferApplyConfiguration) WithMaxRequestSize(value resource.Quantity) *BufferApplyConfiguration {
+	b.MaxRequestSize = &value
+	return b
+}
+
+// WithDisable sets the Disable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disable field is set to the value of the last call.
+func (b *BufferApplyConfiguration) WithDisable(value shared.PolicyDisable) *BufferApplyConfiguration {
+	b.Disable = &value
+	return b
+}
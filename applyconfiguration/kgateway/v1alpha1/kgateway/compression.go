@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+// CompressionApplyConfiguration represents a declarative configuration of the Compression type for use
+// with apply.
+//
+// Compression configures HTTP gzip compression and decompression behavior.
+type CompressionApplyConfiguration struct {
+	// ResponseCompression controls response compression to the downstream.
+	// If set, responses with the appropriate `Accept-Encoding` header with certain textual content types will be compressed using gzip.
+	// The content-types that will be compressed are:
+	// - `application/javascript`
+	// - `application/json`
+	// - `application/xhtml+xml`
+	// - `image/svg+xml`
+	// - `text/css`
+	// - `text/html`
+	// - `text/plain`
+	// - `text/xml`
+	ResponseCompression *ResponseCompressionApplyConfiguration `json:"responseCompression,omitempty"`
+	// RequestDecompression controls request decompression.
+	// If set, gzip requests will be decompressed.
+	RequestDecompression *RequestDecompressionApplyConfiguration `json:"requestDecompression,omitempty"`
+}
+
+// CompressionApplyConfiguration constructs a declarative configuration of the Compression type for use with
+// apply.
+func Compression() *CompressionApplyConfiguration {
+	return &CompressionApplyConfiguration{}
+}
+
+// WithResponseCompression sets the ResponseCompression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResponseCompression field is set to the value of the last call.
+func (b *CompressionApplyConfiguration) WithResponseCompression(value *ResponseCompressionApplyConfiguration) *CompressionApplyConfiguration {
+	b.ResponseCompression = value
+	return b
+}
+
+// WithRequestDecompression sets the RequestDecompression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestDecompression field is set to the value of the last call.
+func (b *CompressionApplyConfiguration) WithRequestDecompression(value *RequestDecompressionApplyConfiguration) *CompressionApplyConfiguration {
+	b.RequestDecompression = value
+	return b
+}
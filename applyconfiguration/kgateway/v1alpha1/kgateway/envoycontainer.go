@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// EnvoyContainerApplyConfiguration represents a declarative configuration of the EnvoyContainer type for use
+// with apply.
+//
+// EnvoyContainer configures the container running Envoy.
+type EnvoyContainerApplyConfiguration struct {
+	// Initial envoy configuration.
+	//
+	Bootstrap *EnvoyBootstrapApplyConfiguration `json:"bootstrap,omitempty"`
+	// The envoy container image. See
+	// https://kubernetes.io/docs/concepts/containers/images
+	// for details.
+	//
+	// Default values, which may be overridden individually:
+	//
+	// registry: quay.io/solo-io
+	// repository: envoy-wrapper
+	// tag: <kgateway version>
+	// pullPolicy: IfNotPresent
+	//
+	Image *ImageApplyConfiguration `json:"image,omitempty"`
+	// The security context for this container. See
+	// https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.26/#securitycontext-v1-core
+	// for details.
+	//
+	SecurityContext *v1.SecurityContextApplyConfiguration `json:"securityContext,omitempty"`
+	// The compute resources required by this container. See
+	// https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	// for details.
+	//
+	Resources *v1.ResourceRequirementsApplyConfiguration `json:"resources,omitempty"`
+	// The container environment variables.
+	//
+	Env []v1.EnvVarApplyConfiguration `json:"env,omitempty"`
+	// Additional volume mounts to add to the container. See
+	// https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.26/#volumemount-v1-core
+	// for details.
+	//
+	ExtraVolumeMounts []v1.VolumeMountApplyConfiguration `json:"extraVolumeMounts,omitempty"`
+}
+
+// EnvoyContainerApplyConfiguration constructs a declarative configuration of the EnvoyContainer type for use with
+// apply.
+func EnvoyContainer() *EnvoyContainerApplyConfiguration {
+	return &EnvoyContainerApplyConfiguration{}
+}
+
+// WithBootstrap sets the Bootstrap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bootstrap field is set to the value of the last call.
+func (b *EnvoyContainerApplyConfiguration) WithBootstrap(value *EnvoyBootstrapApplyConfiguration) *EnvoyContainerApplyConfiguration {
+	b.Bootstrap = value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *EnvoyContainerApplyConfiguration) WithImage(value *ImageApplyConfiguration) *EnvoyContainerApplyConfiguration {
+	b.Image = value
+	return b
+}
+
+// WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityContext field is set to the value of the last call.
+func (b *EnvoyContainerApplyConfiguration) WithSecurityContext(value *v1.SecurityContextApplyConfiguration) *EnvoyContainerApplyConfiguration {
+	b.SecurityContext = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *EnvoyContainerApplyConfiguration) WithResources(value *v1.ResourceRequirementsApplyConfiguration) *EnvoyContainerApplyConfiguration {
+	b.Resources = value
+	return b
+}
+
+// WithEnv adds the given value to the Env field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Env field.
+func (b *EnvoyContainerApplyConfiguration) WithEnv(values ...*v1.EnvVarApplyConfiguration) *EnvoyContainerApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEnv")
+		}
+		b.Env = append(b.Env, *values[i])
+	}
+	return b
+}
+
+// WithExtraVolumeMounts adds the given value to the ExtraVolumeMounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExtraVolumeMounts field.
+func (b *EnvoyContainerApplyConfiguration) WithExtraVolumeMounts(values ...*v1.VolumeMountApplyConfiguration) *EnvoyContainerApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithExtraVolumeMounts")
+		}
+		b.ExtraVolumeMounts = append(b.ExtraVolumeMounts, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+// ExtAuthBufferSettingsApplyConfiguration represents a declarative configuration of the ExtAuthBufferSettings type for use
+// with apply.
+//
+// ExtAuthBufferSettings configures how the request body should be buffered.
+type ExtAuthBufferSettingsApplyConfiguration struct {
+	// MaxRequestBytes sets the maximum size of a message body to buffer.
+	// Requests exceeding this size will receive HTTP 413 and not be sent to the auth service.
+	MaxRequestBytes *int32 `json:"maxRequestBytes,omitempty"`
+	// AllowPartialMessage determines if partial messages should be allowed.
+	// When true, requests will be sent to the auth service even if they exceed maxRequestBytes.
+	// The default behavior is false.
+	AllowPartialMessage *bool `json:"allowPartialMessage,omitempty"`
+	// PackAsBytes determines if the body should be sent as raw bytes.
+	// When true, the body is sent as raw bytes in the raw_body field.
+	// When false, the body is sent as UTF-8 string in the body field.
+	// The default behavior is false.
+	PackAsBytes *bool `json:"packAsBytes,omitempty"`
+}
+
+// ExtAuthBufferSettingsApplyConfiguration constructs a declarative configuration of the ExtAuthBufferSettings type for use with
+// apply.
+func ExtAuthBufferSettings() *ExtAuthBufferSettingsApplyConfiguration {
+	return &ExtAuthBufferSettingsApplyConfiguration{}
+}
+
+// WithMaxRequestBytes sets the MaxRequestBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRequestBytes field is set to the value of the last call.
+func (b *ExtAuthBufferSettingsApplyConfiguration) WithMaxRequestBytes(value int32) *ExtAuthBufferSettingsApplyConfiguration {
+	b.MaxRequestBytes = &value
+	return b
+}
+
+// WithAllowPartialMessage sets the AllowPartialMessage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AllowPartialMessage field is set to the value of the last call.
+func (b *ExtAuthBufferSettingsApplyConfiguration) WithAllowPartialMessage(value bool) *ExtAuthBufferSettingsApplyConfiguration {
+	b.AllowPartialMessage = &value
+	return b
+}
+
+// WithPackAsBytes sets the PackAsBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PackAsBytes field is set to the value of the last call.
+func (b *ExtAuthBufferSettingsApplyConfiguration) WithPackAsBytes(value bool) *ExtAuthBufferSettingsApplyConfiguration {
+	b.PackAsBytes = &value
+	return b
+}
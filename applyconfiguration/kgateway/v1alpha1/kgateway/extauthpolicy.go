@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	v1alpha1shared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+)
+
+// ExtAuthPolicyApplyConfiguration represents a declarative configuration of the ExtAuthPolicy type for use
+// with apply.
+//
+// ExtAuthPolicy configures external authentication/authorization for a route.
+// This policy will determine the ext auth server to use and how to talk to it.
+// Note that most of these fields are passed along as is to Envoy.
+// For more details on particular fields please see the Envoy ExtAuth documentation.
+// https://raw.githubusercontent.com/envoyproxy/envoy/f910f4abea24904aff04ec33a00147184ea7cffa/api/envoy/extensions/filters/http/ext_authz/v3/ext_authz.proto
+type ExtAuthPolicyApplyConfiguration struct {
+	// ExtensionRef references the GatewayExtension that should be used for auth.
+	ExtensionRef *shared.NamespacedObjectReferenceApplyConfiguration `json:"extensionRef,omitempty"`
+	// WithRequestBody allows the request body to be buffered and sent to the auth service.
+	// Warning buffering has implications for streaming and therefore performance.
+	WithRequestBody *ExtAuthBufferSettingsApplyConfiguration `json:"withRequestBody,omitempty"`
+	// Additional context for the auth service.
+	ContextExtensions map[string]string `json:"contextExtensions,omitempty"`
+	// Disable all external auth filters.
+	// Can be used to disable external auth policies applied at a higher level in the config hierarchy.
+	Disable *v1alpha1shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// ExtAuthPolicyApplyConfiguration constructs a declarative configuration of the ExtAuthPolicy type for use with
+// apply.
+func ExtAuthPolicy() *ExtAuthPolicyApplyConfiguration {
+	return &ExtAuthPolicyApplyConfiguration{}
+}
+
+// WithExtensionRef sets the ExtensionRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtensionRef field is set to the value of the last call.
+func (b *ExtAuthPolicyApplyConfiguration) WithExtensionRef(value *shared.NamespacedObjectReferenceApplyConfiguration) *ExtAuthPolicyApplyConfiguration {
+	b.ExtensionRef = value
+	return b
+}
+
+// WithWithRequestBody sets the WithRequestBody field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WithRequestBody field is set to the value of the last call.
+func (b *ExtAuthPolicyApplyConfiguration) WithWithRequestBody(value *ExtAuthBufferSettingsApplyConfiguration) *ExtAuthPolicyApplyConfiguration {
+	b.WithRequestBody = value
+	return b
+}
+
+// WithContextExtensions puts the entries into the ContextExtensions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ContextExtensions field,
+// overwriting an existing map entries in ContextExtensions field with the same key.
+func (b *ExtAuthPolicyApplyConfiguration) WithContextExtensions(entries map[string]string) *ExtAuthPolicyApplyConfiguration {
+	if b.ContextExtensions == nil && len(entries) > 0 {
+		b.ContextExtensions = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ContextExtensions[k] = v
+	}
+	return b
+}
+
+// WithDisable sets the Disable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disable field is set to the value of the last call.
+func (b *ExtAuthPolicyApplyConfiguration) WithDisable(value v1alpha1shared.PolicyDisable) *ExtAuthPolicyApplyConfiguration {
+	b.Disable = &value
+	return b
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	v1alpha1shared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+)
+
+// ExtProcPolicyApplyConfiguration represents a declarative configuration of the ExtProcPolicy type for use
+// with apply.
+//
+// ExtProcPolicy defines the configuration for the Envoy External Processing filter.
+type ExtProcPolicyApplyConfiguration struct {
+	// ExtensionRef references the GatewayExtension that should be used for external processing.
+	ExtensionRef *shared.NamespacedObjectReferenceApplyConfiguration `json:"extensionRef,omitempty"`
+	// ProcessingMode defines how the filter should interact with the request/response streams
+	ProcessingMode *ProcessingModeApplyConfiguration `json:"processingMode,omitempty"`
+	// Disable all external processing filters.
+	// Can be used to disable external processing policies applied at a higher level in the config hierarchy.
+	Disable *v1alpha1shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// ExtProcPolicyApplyConfiguration constructs a declarative configuration of the ExtProcPolicy type for use with
+// apply.
+func ExtProcPolicy() *ExtProcPolicyApplyConfiguration {
+	return &ExtProcPolicyApplyConfiguration{}
+}
+
+// WithExtensionRef sets the ExtensionRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtensionRef field is set to the value of the last call.
+func (b *ExtProcPolicyApplyConfiguration) WithExtensionRef(value *shared.NamespacedObjectReferenceApplyConfiguration) *ExtProcPolicyApplyConfiguration {
+	b.ExtensionRef = value
+	return b
+}
+
+// WithProcessingMode sets the ProcessingMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProcessingMode field is set to the value of the last call.
+func (b *ExtProcPolicyApplyConfiguration) WithProcessingMode(value *ProcessingModeApplyConfiguration) *ExtProcPolicyApplyConfiguration {
+	b.ProcessingMode = value
+	return b
+}
+
+// WithDisable sets the Disable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disable field is set to the value of the last call.
+func (b *ExtProcPolicyApplyConfiguration) WithDisable(value v1alpha1shared.PolicyDisable) *ExtProcPolicyApplyConfiguration {
+	b.Disable = &value
+	return b
+}
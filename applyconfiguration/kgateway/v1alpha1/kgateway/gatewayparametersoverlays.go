@@ -0,0 +1,87 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+)
+
+// GatewayParametersOverlaysApplyConfiguration represents a declarative configuration of the GatewayParametersOverlays type for use
+// with apply.
+type GatewayParametersOverlaysApplyConfiguration struct {
+	DeploymentOverlay       *shared.KubernetesResourceOverlayApplyConfiguration `json:"deploymentOverlay,omitempty"`
+	ServiceOverlay          *shared.KubernetesResourceOverlayApplyConfiguration `json:"serviceOverlay,omitempty"`
+	ServiceAccountOverlay   *shared.KubernetesResourceOverlayApplyConfiguration `json:"serviceAccountOverlay,omitempty"`
+	PodDisruptionBudget     *shared.KubernetesResourceOverlayApplyConfiguration `json:"podDisruptionBudget,omitempty"`
+	HorizontalPodAutoscaler *shared.KubernetesResourceOverlayApplyConfiguration `json:"horizontalPodAutoscaler,omitempty"`
+	VerticalPodAutoscaler   *shared.KubernetesResourceOverlayApplyConfiguration `json:"verticalPodAutoscaler,omitempty"`
+}
+
+// GatewayParametersOverlaysApplyConfiguration constructs a declarative configuration of the GatewayParametersOverlays type for use with
+// apply.
+func GatewayParametersOverlays() *GatewayParametersOverlaysApplyConfiguration {
+	return &GatewayParametersOverlaysApplyConfiguration{}
+}
+
+// WithDeploymentOverlay sets the DeploymentOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeploymentOverlay field is set to the value of the last call.
+func (b *GatewayParametersOverlaysApplyConfiguration) WithDeploymentOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *GatewayParametersOverlaysApplyConfiguration {
+	b.DeploymentOverlay = value
+	return b
+}
+
+// WithServiceOverlay sets the ServiceOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceOverlay field is set to the value of the last call.
+func (b *GatewayParametersOverlaysApplyConfiguration) WithServiceOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *GatewayParametersOverlaysApplyConfiguration {
+	b.ServiceOverlay = value
+	return b
+}
+
+// WithServiceAccountOverlay sets the ServiceAccountOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountOverlay field is set to the value of the last call.
+func (b *GatewayParametersOverlaysApplyConfiguration) WithServiceAccountOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *GatewayParametersOverlaysApplyConfiguration {
+	b.ServiceAccountOverlay = value
+	return b
+}
+
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *GatewayParametersOverlaysApplyConfiguration) WithPodDisruptionBudget(value *shared.KubernetesResourceOverlayApplyConfiguration) *GatewayParametersOverlaysApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
+// WithHorizontalPodAutoscaler sets the HorizontalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HorizontalPodAutoscaler field is set to the value of the last call.
+func (b *GatewayParametersOverlaysApplyConfiguration) WithHorizontalPodAutoscaler(value *shared.KubernetesResourceOverlayApplyConfiguration) *GatewayParametersOverlaysApplyConfiguration {
+	b.HorizontalPodAutoscaler = value
+	return b
+}
+
+// WithVerticalPodAutoscaler sets the VerticalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerticalPodAutoscaler field is set to the value of the last call.
+func (b *GatewayParametersOverlaysApplyConfiguration) WithVerticalPodAutoscaler(value *shared.KubernetesResourceOverlayApplyConfiguration) *GatewayParametersOverlaysApplyConfiguration {
+	b.VerticalPodAutoscaler = value
+	return b
+}
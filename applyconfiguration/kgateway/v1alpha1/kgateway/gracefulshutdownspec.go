@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+// GracefulShutdownSpecApplyConfiguration represents a declarative configuration of the GracefulShutdownSpec type for use
+// with apply.
+type GracefulShutdownSpecApplyConfiguration struct {
+	// Enable grace period before shutdown to finish current requests while Envoy health checks fail to e.g. notify external load balancers. *NOTE:* This will not have any effect if you have not defined health checks via the health check filter
+	//
+	Enabled *bool `json:"enabled,omitempty"`
+	// Time (in seconds) for the preStop hook to wait before allowing Envoy to terminate
+	//
+	SleepTimeSeconds *int64 `json:"sleepTimeSeconds,omitempty"`
+}
+
+// GracefulShutdownSpecApplyConfiguration constructs a declarative configuration of the GracefulShutdownSpec type for use with
+// apply.
+func GracefulShutdownSpec() *GracefulShutdownSpecApplyConfiguration {
+	return &GracefulShutdownSpecApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *GracefulShutdownSpecApplyConfiguration) WithEnabled(value bool) *GracefulShutdownSpecApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithSleepTimeSeconds sets the SleepTimeSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SleepTimeSeconds field is set to the value of the last call.
+func (b *GracefulShutdownSpecApplyConfiguration) WithSleepTimeSeconds(value int64) *GracefulShutdownSpecApplyConfiguration {
+	b.SleepTimeSeconds = &value
+	return b
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	v1alpha1kgateway "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+// HeaderTransformationApplyConfiguration represents a declarative configuration of the HeaderTransformation type for use
+// with apply.
+type HeaderTransformationApplyConfiguration struct {
+	// Name is the name of the header to interact with.
+	Name *v1alpha1kgateway.HeaderName `json:"name,omitempty"`
+	// Value is the Inja template to apply to generate the output value for the header.
+	Value *v1alpha1kgateway.InjaTemplate `json:"value,omitempty"`
+}
+
+// HeaderTransformationApplyConfiguration constructs a declarative configuration of the HeaderTransformation type for use with
+// apply.
+func HeaderTransformation() *HeaderTransformationApplyConfiguration {
+	return &HeaderTransformationApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *HeaderTransformationApplyConfiguration) WithName(value v1alpha1kgateway.HeaderName) *HeaderTransformationApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *HeaderTransformationApplyConfiguration) WithValue(value v1alpha1kgateway.InjaTemplate) *HeaderTransformationApplyConfiguration {
+	b.Value = &value
+	return b
+}
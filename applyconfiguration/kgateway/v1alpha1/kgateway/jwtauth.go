@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	v1alpha1shared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+)
+
+// JWTAuthApplyConfiguration represents a declarative configuration of the JWTAuth type for use
+// with apply.
+//
+// JWTAuth defines the providers used to configure JWT authentication
+type JWTAuthApplyConfiguration struct {
+	// ExtensionRef references a GatewayExtension that provides the jwt providers
+	ExtensionRef *shared.NamespacedObjectReferenceApplyConfiguration `json:"extensionRef,omitempty"`
+	// Disable all JWT filters.
+	// Can be used to disable JWT policies applied at a higher level in the config hierarchy.
+	Disable *v1alpha1shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// JWTAuthApplyConfiguration constructs a declarative configuration of the JWTAuth type for use with
+// apply.
+func JWTAuth() *JWTAuthApplyConfiguration {
+	return &JWTAuthApplyConfiguration{}
+}
+
+// WithExtensionRef sets the ExtensionRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtensionRef field is set to the value of the last call.
+func (b *JWTAuthApplyConfiguration) WithExtensionRef(value *shared.NamespacedObjectReferenceApplyConfiguration) *JWTAuthApplyConfiguration {
+	b.ExtensionRef = value
+	return b
+}
+
+// WithDisable sets the Disable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disable field is set to the value of the last call.
+func (b *JWTAuthApplyConfiguration) WithDisable(value v1alpha1shared.PolicyDisable) *JWTAuthApplyConfiguration {
+	b.Disable = &value
+	return b
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+)
+
+// OAuth2PolicyApplyConfiguration represents a declarative configuration of the OAuth2Policy type for use
+// with apply.
+//
+// OAuth2Policy specifies the OAuth2 policy to apply to requests.
+type OAuth2PolicyApplyConfiguration struct {
+	// ExtensionRef specifies the GatewayExtension that should be used for OAuth2.
+	ExtensionRef *shared.NamespacedObjectReferenceApplyConfiguration `json:"extensionRef,omitempty"`
+}
+
+// OAuth2PolicyApplyConfiguration constructs a declarative configuration of the OAuth2Policy type for use with
+// apply.
+func OAuth2Policy() *OAuth2PolicyApplyConfiguration {
+	return &OAuth2PolicyApplyConfiguration{}
+}
+
+// WithExtensionRef sets the ExtensionRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtensionRef field is set to the value of the last call.
+func (b *OAuth2PolicyApplyConfiguration) WithExtensionRef(value *shared.NamespacedObjectReferenceApplyConfiguration) *OAuth2PolicyApplyConfiguration {
+	b.ExtensionRef = value
+	return b
+}
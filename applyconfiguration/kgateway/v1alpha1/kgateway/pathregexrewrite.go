@@ -0,0 +1,56 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+// PathRegexRewriteApplyConfiguration represents a declarative configuration of the PathRegexRewrite type for use
+// with apply.
+//
+// PathRegexRewrite specifies how to rewrite the URL path.
+type PathRegexRewriteApplyConfiguration struct {
+	// Pattern is the regex pattern that matches the URL path.
+	// The pattern must be a valid RE2 regular expression.
+	// If the HTTPRoute uses a RegularExpression path match, this field can use capture groups
+	// from that match.
+	Pattern *string `json:"pattern,omitempty"`
+	// Substitution is the replacement string for the matched pattern.
+	// It can include backreferences to captured groups from the pattern (e.g., \1, \2)
+	// or named groups (e.g., \g<name>).
+	Substitution *string `json:"substitution,omitempty"`
+}
+
+// PathRegexRewriteApplyConfiguration constructs a declarative configuration of the PathRegexRewrite type for use with
+// apply.
+func PathRegexRewrite() *PathRegexRewriteApplyConfiguration {
+	return &PathRegexRewriteApplyConfiguration{}
+}
+
+// WithPattern sets the Pattern field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Pattern field is set to the value of the last call.
+func (b *PathRegexRewriteApplyConfiguration) WithPattern(value string) *PathRegexRewriteApplyConfiguration {
+	b.Pattern = &value
+	return b
+}
+
+// WithSubstitution sets the Substitution field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Substitution field is set to the value of the last call.
+func (b *PathRegexRewriteApplyConfiguration) WithSubstitution(value string) *PathRegexRewriteApplyConfiguration {
+	b.Substitution = &value
+	return b
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+// ProcessingModeApplyConfiguration represents a declarative configuration of the ProcessingMode type for use
+// with apply.
+//
+// ProcessingMode defines how the filter should interact with the request/response streams
+type ProcessingModeApplyConfiguration struct {
+	// RequestHeaderMode determines how to handle the request headers
+	RequestHeaderMode *string `json:"requestHeaderMode,omitempty"`
+	// ResponseHeaderMode determines how to handle the response headers
+	ResponseHeaderMode *string `json:"responseHeaderMode,omitempty"`
+	// RequestBodyMode determines how to handle the request body
+	RequestBodyMode *string `json:"requestBodyMode,omitempty"`
+	// ResponseBodyMode determines how to handle the response body
+	ResponseBodyMode *string `json:"responseBodyMode,omitempty"`
+	// RequestTrailerMode determines how to handle the request trailers
+	RequestTrailerMode *string `json:"requestTrailerMode,omitempty"`
+	// ResponseTrailerMode determines how to handle the response trailers
+	ResponseTrailerMode *string `json:"responseTrailerMode,omitempty"`
+}
+
+// ProcessingModeApplyConfiguration constructs a declarative configuration of the ProcessingMode type for use with
+// apply.
+func ProcessingMode() *ProcessingModeApplyConfiguration {
+	return &ProcessingModeApplyConfiguration{}
+}
+
+// WithRequestHeaderMode sets the RequestHeaderMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestHeaderMode field is set to the value of the last call.
+func (b *ProcessingModeApplyConfiguration) WithRequestHeaderMode(value string) *ProcessingModeApplyConfiguration {
+	b.RequestHeaderMode = &value
+	return b
+}
+
+// WithResponseHeaderMode sets the ResponseHeaderMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResponseHeaderMode field is set to the value of the last call.
+func (b *ProcessingModeApplyConfiguration) WithResponseHeaderMode(value string) *ProcessingModeApplyConfiguration {
+	b.ResponseHeaderMode = &value
+	return b
+}
+
+// WithRequestBodyMode sets the RequestBodyMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestBodyMode field is set to the value of the last call.
+func (b *ProcessingModeApplyConfiguration) WithRequestBodyMode(value string) *ProcessingModeApplyConfiguration {
+	b.RequestBodyMode = &value
+	return b
+}
+
+// WithResponseBodyMode sets the ResponseBodyMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResponseBodyMode field is set to the value of the last call.
+func (b *ProcessingModeApplyConfiguration) WithResponseBodyMode(value string) *ProcessingModeApplyConfiguration {
+	b.ResponseBodyMode = &value
+	return b
+}
+
+// WithRequestTrailerMode sets the RequestTrailerMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestTrailerMode field is set to the value of the last call.
+func (b *ProcessingModeApplyConfiguration) WithRequestTrailerMode(value string) *ProcessingModeApplyConfiguration {
+	b.RequestTrailerMode = &value
+	return b
+}
+
+// WithResponseTrailerMode sets the ResponseTrailerMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResponseTrailerMode field is set to the value of the last call.
+func (b *ProcessingModeApplyConfiguration) WithResponseTrailerMode(value string) *ProcessingModeApplyConfiguration {
+	b.ResponseTrailerMode = &value
+	return b
+}
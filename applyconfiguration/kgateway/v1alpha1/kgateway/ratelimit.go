@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+// RateLimitApplyConfiguration represents a declarative configuration of the RateLimit type for use
+// with apply.
+//
+// RateLimit defines a rate limiting policy.
+type RateLimitApplyConfiguration struct {
+	// Local defines a local rate limiting policy.
+	Local *LocalRateLimitPolicyApplyConfiguration `json:"local,omitempty"`
+	// Global defines a global rate limiting policy using an external service.
+	Global *RateLimitPolicyApplyConfiguration `json:"global,omitempty"`
+}
+
+// RateLimitApplyConfiguration constructs a declarative configuration of the RateLimit type for use with
+// apply.
+func RateLimit() *RateLimitApplyConfiguration {
+	return &RateLimitApplyConfiguration{}
+}
+
+// WithLocal sets the Local field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Local field is set to the value of the last call.
+func (b *RateLimitApplyConfiguration) WithLocal(value *LocalRateLimitPolicyApplyConfiguration) *RateLimitApplyConfiguration {
+	b.Local = value
+	return b
+}
+
+// WithGlobal sets the Global field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Global field is set to the value of the last call.
+func (b *RateLimitApplyConfiguration) WithGlobal(value *RateLimitPolicyApplyConfiguration) *RateLimitApplyConfiguration {
+	b.Global = value
+	return b
+}
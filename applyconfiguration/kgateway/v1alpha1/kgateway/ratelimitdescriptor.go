@@ -0,0 +1,48 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+// RateLimitDescriptorApplyConfiguration represents a declarative configuration of the RateLimitDescriptor type for use
+// with apply.
+//
+// RateLimitDescriptor defines a descriptor for rate limiting.
+// A descriptor is a group of entries that form a single rate limit rule.
+type RateLimitDescriptorApplyConfiguration struct {
+	// Entries are the individual components that make up this descriptor.
+	// When translated to Envoy, these entries combine to form a single descriptor.
+	Entries []RateLimitDescriptorEntryApplyConfiguration `json:"entries,omitempty"`
+}
+
+// RateLimitDescriptorApplyConfiguration constructs a declarative configuration of the RateLimitDescriptor type for use with
+// apply.
+func RateLimitDescriptor() *RateLimitDescriptorApplyConfiguration {
+	return &RateLimitDescriptorApplyConfiguration{}
+}
+
+// WithEntries adds the given value to the Entries field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Entries field.
+func (b *RateLimitDescriptorApplyConfiguration) WithEntries(values ...*RateLimitDescriptorEntryApplyConfiguration) *RateLimitDescriptorApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEntries")
+		}
+		b.Entries = append(b.Entries, *values[i])
+	}
+	return b
+}
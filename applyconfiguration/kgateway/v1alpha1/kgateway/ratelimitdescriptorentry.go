@@ -0,0 +1,68 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	v1alpha1kgateway "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+// RateLimitDescriptorEntryApplyConfiguration represents a declarative configuration of the RateLimitDescriptorEntry type for use
+// with apply.
+//
+// RateLimitDescriptorEntry defines a single entry in a rate limit descriptor.
+// Only one entry type may be specified.
+type RateLimitDescriptorEntryApplyConfiguration struct {
+	// Type specifies what kind of rate limit descriptor entry this is.
+	Type *v1alpha1kgateway.RateLimitDescriptorEntryType `json:"type,omitempty"`
+	// Generic contains the configuration for a generic key-value descriptor entry.
+	// This field must be specified when Type is Generic.
+	Generic *RateLimitDescriptorEntryGenericApplyConfiguration `json:"generic,omitempty"`
+	// Header specifies a request header to extract the descriptor value from.
+	// This field must be specified when Type is Header.
+	Header *string `json:"header,omitempty"`
+}
+
+// RateLimitDescriptorEntryApplyConfiguration constructs a declarative configuration of the RateLimitDescriptorEntry type for use with
+// apply.
+func RateLimitDescriptorEntry() *RateLimitDescriptorEntryApplyConfiguration {
+	return &RateLimitDescriptorEntryApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *RateLimitDescriptorEntryApplyConfiguration) WithType(value v1alpha1kgateway.RateLimitDescriptorEntryType) *RateLimitDescriptorEntryApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithGeneric sets the Generic field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generic field is set to the value of the last call.
+func (b *RateLimitDescriptorEntryApplyConfiguration) WithGeneric(value *RateLimitDescriptorEntryGenericApplyConfiguration) *RateLimitDescriptorEntryApplyConfiguration {
+	b.Generic = value
+	return b
+}
+
+// WithHeader sets the Header field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Header field is set to the value of the last call.
+func (b *RateLimitDescriptorEntryApplyConfiguration) WithHeader(value string) *RateLimitDescriptorEntryApplyConfiguration {
+	b.Header = &value
+	return b
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+// RateLimitDescriptorEntryGenericApplyConfiguration represents a declarative configuration of the RateLimitDescriptorEntryGeneric type for use
+// with apply.
+//
+// RateLimitDescriptorEntryGeneric defines a generic key-value descriptor entry.
+type RateLimitDescriptorEntryGenericApplyConfiguration struct {
+	// Key is the name of this descriptor entry.
+	Key *string `json:"key,omitempty"`
+	// Value is the static value for this descriptor entry.
+	Value *string `json:"value,omitempty"`
+}
+
+// RateLimitDescriptorEntryGenericApplyConfiguration constructs a declarative configuration of the RateLimitDescriptorEntryGeneric type for use with
+// apply.
+func RateLimitDescriptorEntryGeneric() *RateLimitDescriptorEntryGenericApplyConfiguration {
+	return &RateLimitDescriptorEntryGenericApplyConfiguration{}
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *RateLimitDescriptorEntryGenericApplyConfiguration) WithKey(value string) *RateLimitDescriptorEntryGenericApplyConfiguration {
+	b.Key = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *RateLimitDescriptorEntryGenericApplyConfiguration) WithValue(value string) *RateLimitDescriptorEntryGenericApplyConfiguration {
+	b.Value = &value
+	return b
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	shared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// ResponseCompressionApplyConfiguration represents a declarative configuration of the ResponseCompression type for use
+// with apply.
+//
+// ResponseCompression configures response compression.
+type ResponseCompressionApplyConfiguration struct {
+	// Disables compression.
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// ResponseCompressionApplyConfiguration constructs a declarative configuration of the ResponseCompression type for use with
+// apply.
+func ResponseCompression() *ResponseCompressionApplyConfiguration {
+	return &ResponseCompressionApplyConfiguration{}
+}
+
+// WithDisable sets the Disable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disable field is set to the value of the last call.
+func (b *ResponseCompressionApplyConfiguration) WithDisable(value shared.PolicyDisable) *ResponseCompressionApplyConfiguration {
+	b.Disable = &value
+	return b
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+// SdsBootstrapApplyConfiguration represents a declarative configuration of the SdsBootstrap type for use
+// with apply.
+//
+// SdsBootstrap configures the SDS instance that is provisioned from a Kubernetes Gateway.
+type SdsBootstrapApplyConfiguration struct {
+	// Log level for SDS. Options include "info", "debug", "warn", "error", "panic" and "fatal".
+	// Default level is "info".
+	//
+	LogLevel *string `json:"logLevel,omitempty"`
+}
+
+// SdsBootstrapApplyConfiguration constructs a declarative configuration of the SdsBootstrap type for use with
+// apply.
+func SdsBootstrap() *SdsBootstrapApplyConfiguration {
+	return &SdsBootstrapApplyConfiguration{}
+}
+
+// WithLogLevel sets the LogLevel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogLevel field is set to the value of the last call.
+func (b *SdsBootstrapApplyConfiguration) WithLogLevel(value string) *SdsBootstrapApplyConfiguration {
+	b.LogLevel = &value
+	return b
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// SecretReferenceApplyConfiguration represents a declarative configuration of the SecretReference type for use
+// with apply.
+//
+// SecretReference identifies a Kubernetes secret containing authentication data.
+type SecretReferenceApplyConfiguration struct {
+	// Name of the secret containing htpasswd data.
+	Name *v1.ObjectName `json:"name,omitempty"`
+	// Namespace of the secret. If not specified, defaults to the namespace of the TrafficPolicy.
+	// Note that a secret in a different namespace requires a ReferenceGrant to be accessible.
+	Namespace *v1.Namespace `json:"namespace,omitempty"`
+	// Key in the secret that contains the htpasswd data.
+	// Defaults to ".htpasswd" if not specified.
+	Key *string `json:"key,omitempty"`
+}
+
+// SecretReferenceApplyConfiguration constructs a declarative configuration of the SecretReference type for use with
+// apply.
+func SecretReference() *SecretReferenceApplyConfiguration {
+	return &SecretReferenceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SecretReferenceApplyConfiguration) WithName(value v1.ObjectName) *SecretReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *SecretReferenceApplyConfiguration) WithNamespace(value v1.Namespace) *SecretReferenceApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *SecretReferenceApplyConfiguration) WithKey(value string) *SecretReferenceApplyConfiguration {
+	b.Key = &value
+	return b
+}
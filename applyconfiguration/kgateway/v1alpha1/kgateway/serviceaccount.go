@@ -0,0 +1,64 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+// ServiceAccountApplyConfiguration represents a declarative configuration of the ServiceAccount type for use
+// with apply.
+type ServiceAccountApplyConfiguration struct {
+	// Additional labels to add to the ServiceAccount object metadata.
+	//
+	ExtraLabels map[string]string `json:"extraLabels,omitempty"`
+	// Additional annotations to add to the ServiceAccount object metadata.
+	// If the same annotation is present on `Gateway.spec.infrastructure.annotations`, the `Gateway` takes precedence.
+	//
+	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty"`
+}
+
+// ServiceAccountApplyConfiguration constructs a declarative configuration of the ServiceAccount type for use with
+// apply.
+func ServiceAccount() *ServiceAccountApplyConfiguration {
+	return &ServiceAccountApplyConfiguration{}
+}
+
+// WithExtraLabels puts the entries into the ExtraLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ExtraLabels field,
+// overwriting an existing map entries in ExtraLabels field with the same key.
+func (b *ServiceAccountApplyConfiguration) WithExtraLabels(entries map[string]string) *ServiceAccountApplyConfiguration {
+	if b.ExtraLabels == nil && len(entries) > 0 {
+		b.ExtraLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ExtraLabels[k] = v
+	}
+	return b
+}
+
+// WithExtraAnnotations puts the entries into the ExtraAnnotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ExtraAnnotations field,
+// overwriting an existing map entries in ExtraAnnotations field with the same key.
+func (b *ServiceAccountApplyConfiguration) WithExtraAnnotations(entries map[string]string) *ServiceAccountApplyConfiguration {
+	if b.ExtraAnnotations == nil && len(entries) > 0 {
+		b.ExtraAnnotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ExtraAnnotations[k] = v
+	}
+	return b
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+import (
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+)
+
+// StatsMatcherApplyConfiguration represents a declarative configuration of the StatsMatcher type for use
+// with apply.
+//
+// StatsMatcher specifies either an inclusion or exclusion list for Envoy stats.
+// See Envoy's envoy.config.metrics.v3.StatsMatcher for details.
+type StatsMatcherApplyConfiguration struct {
+	// inclusionList specifies which stats to include, using string matchers.
+	InclusionList []shared.StringMatcherApplyConfiguration `json:"inclusionList,omitempty"`
+	// exclusionList specifies which stats to exclude, using string matchers.
+	ExclusionList []shared.StringMatcherApplyConfiguration `json:"exclusionList,omitempty"`
+}
+
+// StatsMatcherApplyConfiguration constructs a declarative configuration of the StatsMatcher type for use with
+// apply.
+func StatsMatcher() *StatsMatcherApplyConfiguration {
+	return &StatsMatcherApplyConfiguration{}
+}
+
+// WithInclusionList adds the given value to the InclusionList field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the InclusionList field.
+func (b *StatsMatcherApplyConfiguration) WithInclusionList(values ...*shared.StringMatcherApplyConfiguration) *StatsMatcherApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithInclusionList")
+		}
+		b.InclusionList = append(b.InclusionList, *values[i])
+	}
+	return b
+}
+
+// WithExclusionList adds the given value to the ExclusionList field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExclusionList field.
+func (b *StatsMatcherApplyConfiguration) WithExclusionList(values ...*shared.StringMatcherApplyConfiguration) *StatsMatcherApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithExclusionList")
+		}
+		b.ExclusionList = append(b.ExclusionList, *values[i])
+	}
+	return b
+}
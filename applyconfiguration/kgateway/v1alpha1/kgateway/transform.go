@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+// TransformApplyConfiguration represents a declarative configuration of the Transform type for use
+// with apply.
+//
+// Transform defines the operations to be performed by the transformation.
+// These operations may include changing the actual request/response but may also cause side effects.
+// Side effects may include setting info that can be used in future steps (e.g. dynamic metadata) and can cause envoy to buffer.
+type TransformApplyConfiguration struct {
+	// Set is a list of headers and the value they should be set to.
+	Set []HeaderTransformationApplyConfiguration `json:"set,omitempty"`
+	// Add is a list of headers to add to the request and what that value should be set to.
+	// If there is already a header with these values then append the value as an extra entry.
+	// Add is not supported on arm64 build, see https://github.com/kgateway-dev/kgateway/blob/v2.2.x/docs/guides/transformation.md for details
+	Add []HeaderTransformationApplyConfiguration `json:"add,omitempty"`
+	// Remove is a list of header names to remove from the request/response.
+	Remove []string `json:"remove,omitempty"`
+	// Body controls both how to parse the body and if needed how to set.
+	// If empty, body will not be buffered.
+	Body *BodyTransformationApplyConfiguration `json:"body,omitempty"`
+}
+
+// TransformApplyConfiguration constructs a declarative configuration of the Transform type for use with
+// apply.
+func Transform() *TransformApplyConfiguration {
+	return &TransformApplyConfiguration{}
+}
+
+// WithSet adds the given value to the Set field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Set field.
+func (b *TransformApplyConfiguration) WithSet(values ...*HeaderTransformationApplyConfiguration) *TransformApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSet")
+		}
+		b.Set = append(b.Set, *values[i])
+	}
+	return b
+}
+
+// WithAdd adds the given value to the Add field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Add field.
+func (b *TransformApplyConfiguration) WithAdd(values ...*HeaderTransformationApplyConfiguration) *TransformApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAdd")
+		}
+		b.Add = append(b.Add, *values[i])
+	}
+	return b
+}
+
+// WithRemove adds the given value to the Remove field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Remove field.
+func (b *TransformApplyConfiguration) WithRemove(values ...string) *TransformApplyConfiguration {
+	for i := range values {
+		b.Remove = append(b.Remove, values[i])
+	}
+	return b
+}
+
+// WithBody sets the Body field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Body field is set to the value of the last call.
+func (b *TransformApplyConfiguration) WithBody(value *BodyTransformationApplyConfiguration) *TransformApplyConfiguration {
+	b.Body = value
+	return b
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package kgateway
+
+// URLRewriteApplyConfiguration represents a declarative configuration of the URLRewrite type for use
+// with apply.
+//
+// URLRewrite specifies URL rewrite rules using regular expressions.
+// This allows more flexible and advanced path rewriting based on regex patterns.
+type URLRewriteApplyConfiguration struct {
+	// Path specifies the path rewrite configuration.
+	PathRegex *PathRegexRewriteApplyConfiguration `json:"pathRegex,omitempty"`
+}
+
+// URLRewriteApplyConfiguration constructs a declarative configuration of the URLRewrite type for use with
+// apply.
+func URLRewrite() *URLRewriteApplyConfiguration {
+	return &URLRewriteApplyConfiguration{}
+}
+
+// WithPathRegex sets the PathRegex field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PathRegex field is set to the value of the last call.
+func (b *URLRewriteApplyConfiguration) WithPathRegex(value *PathRegexRewriteApplyConfiguration) *URLRewriteApplyConfiguration {
+	b.PathRegex = value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package shared
+
+import (
+	v1alpha1shared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// AuthorizationApplyConfiguration represents a declarative configuration of the Authorization type for use
+// with apply.
+//
+// Authorization defines the configuration for role-based access control.
+type AuthorizationApplyConfiguration struct {
+	// Policy specifies the Authorization rule to evaluate.
+	// A policy matches when **any** of the conditions evaluates to true.
+	Policy *AuthorizationPolicyApplyConfiguration `json:"policy,omitempty"`
+	// Action defines whether the rule allows or denies the request if matched.
+	// If unspecified, the default is "Allow".
+	Action *v1alpha1shared.AuthorizationPolicyAction `json:"action,omitempty"`
+}
+
+// AuthorizationApplyConfiguration constructs a declarative configuration of the Authorization type for use with
+// apply.
+func Authorization() *AuthorizationApplyConfiguration {
+	return &AuthorizationApplyConfiguration{}
+}
+
+// WithPolicy sets the Policy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Policy field is set to the value of the last call.
+func (b *AuthorizationApplyConfiguration) WithPolicy(value *AuthorizationPolicyApplyConfiguration) *AuthorizationApplyConfiguration {
+	b.Policy = value
+	return b
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *AuthorizationApplyConfiguration) WithAction(value v1alpha1shared.AuthorizationPolicyAction) *AuthorizationApplyConfiguration {
+	b.Action = &value
+	return b
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package shared
+
+import (
+	v1alpha1shared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// AuthorizationPolicyApplyConfiguration represents a declarative configuration of the AuthorizationPolicy type for use
+// with apply.
+//
+// AuthorizationPolicy defines a single Authorization rule.
+type AuthorizationPolicyApplyConfiguration struct {
+	// MatchExpressions defines a set of conditions that must be satisfied for the rule to match.
+	// These expression should be in the form of a Common Expression Language (CEL) expression.
+	//
+	MatchExpressions []v1alpha1shared.CELExpression `json:"matchExpressions,omitempty"`
+}
+
+// AuthorizationPolicyApplyConfiguration constructs a declarative configuration of the AuthorizationPolicy type for use with
+// apply.
+func AuthorizationPolicy() *AuthorizationPolicyApplyConfiguration {
+	return &AuthorizationPolicyApplyConfiguration{}
+}
+
+// WithMatchExpressions adds the given value to the MatchExpressions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MatchExpressions field.
+func (b *AuthorizationPolicyApplyConfiguration) WithMatchExpressions(values ...v1alpha1shared.CELExpression) *AuthorizationPolicyApplyConfiguration {
+	for i := range values {
+		b.MatchExpressions = append(b.MatchExpressions, values[i])
+	}
+	return b
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package shared
+
+import (
+	v1 "sigs.k8s.io/gateway-api/applyconfiguration/apis/v1"
+)
+
+// HeaderModifiersApplyConfiguration represents a declarative configuration of the HeaderModifiers type for use
+// with apply.
+//
+// HeaderModifiers can be used to define the policy to modify request and response headers.
+type HeaderModifiersApplyConfiguration struct {
+	// Request modifies request headers.
+	Request *v1.HTTPHeaderFilterApplyConfiguration `json:"request,omitempty"`
+	// Response modifies response headers.
+	Response *v1.HTTPHeaderFilterApplyConfiguration `json:"response,omitempty"`
+}
+
+// HeaderModifiersApplyConfiguration constructs a declarative configuration of the HeaderModifiers type for use with
+// apply.
+func HeaderModifiers() *HeaderModifiersApplyConfiguration {
+	return &HeaderModifiersApplyConfiguration{}
+}
+
+// WithRequest sets the Request field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Request field is set to the value of the last call.
+func (b *HeaderModifiersApplyConfiguration) WithRequest(value *v1.HTTPHeaderFilterApplyConfiguration) *HeaderModifiersApplyConfiguration {
+	b.Request = value
+	return b
+}
+
+// WithResponse sets the Response field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Response field is set to the value of the last call.
+func (b *HeaderModifiersApplyConfiguration) WithResponse(value *v1.HTTPHeaderFilterApplyConfiguration) *HeaderModifiersApplyConfiguration {
+	b.Response = value
+	return b
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package shared
+
+import (
+	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// KubernetesResourceOverlayApplyConfiguration represents a declarative configuration of the KubernetesResourceOverlay type for use
+// with apply.
+type KubernetesResourceOverlayApplyConfiguration struct {
+	Metadata *ObjectMetadataApplyConfiguration `json:"metadata,omitempty"`
+	Spec     *v1.JSON                          `json:"spec,omitempty"`
+}
+
+// KubernetesResourceOverlayApplyConfiguration constructs a declarative configuration of the KubernetesResourceOverlay type for use with
+// apply.
+func KubernetesResourceOverlay() *KubernetesResourceOverlayApplyConfiguration {
+	return &KubernetesResourceOverlayApplyConfiguration{}
+}
+
+// WithMetadata sets the Metadata field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Metadata field is set to the value of the last call.
+func (b *KubernetesResourceOverlayApplyConfiguration) WithMetadata(value *ObjectMetadataApplyConfiguration) *KubernetesResourceOverlayApplyConfiguration {
+	b.Metadata = value
+	return b
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *KubernetesResourceOverlayApplyConfiguration) WithSpec(value v1.JSON) *KubernetesResourceOverlayApplyConfiguration {
+	b.Spec = &value
+	return b
+}
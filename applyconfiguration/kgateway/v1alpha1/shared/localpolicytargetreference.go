@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package shared
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// LocalPolicyTargetReferenceApplyConfiguration represents a declarative configuration of the LocalPolicyTargetReference type for use
+// with apply.
+//
+// Select the object to attach the policy by Group, Kind, and Name.
+// The object must be in the same namespace as the policy.
+// You can target only one object at a time.
+type LocalPolicyTargetReferenceApplyConfiguration struct {
+	// The API group of the target resource.
+	// For Kubernetes Gateway API resources, the group is `gateway.networking.k8s.io`.
+	Group *v1.Group `json:"group,omitempty"`
+	// The API kind of the target resource,
+	// such as Gateway or HTTPRoute.
+	Kind *v1.Kind `json:"kind,omitempty"`
+	// The name of the target resource.
+	Name *v1.ObjectName `json:"name,omitempty"`
+}
+
+// LocalPolicyTargetReferenceApplyConfiguration constructs a declarative configuration of the LocalPolicyTargetReference type for use with
+// apply.
+func LocalPolicyTargetReference() *LocalPolicyTargetReferenceApplyConfiguration {
+	return &LocalPolicyTargetReferenceApplyConfiguration{}
+}
+
+// WithGroup sets the Group field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Group field is set to the value of the last call.
+func (b *LocalPolicyTargetReferenceApplyConfiguration) WithGroup(value v1.Group) *LocalPolicyTargetReferenceApplyConfiguration {
+	b.Group = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *LocalPolicyTargetReferenceApplyConfiguration) WithKind(value v1.Kind) *LocalPolicyTargetReferenceApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *LocalPolicyTargetReferenceApplyConfiguration) WithName(value v1.ObjectName) *LocalPolicyTargetReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
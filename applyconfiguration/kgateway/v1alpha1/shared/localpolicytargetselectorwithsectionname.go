@@ -0,0 +1,81 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package shared
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// LocalPolicyTargetSelectorWithSectionNameApplyConfiguration represents a declarative configuration of the LocalPolicyTargetSelectorWithSectionName type for use
+// with apply.
+//
+// LocalPolicyTargetSelectorWithSectionName the object to attach the policy by Group, Kind, MatchLabels, and optionally SectionName.
+// The object must be in the same namespace as the policy and match the
+// specified labels.
+// Do not use targetSelectors when reconciliation times are critical, especially if you
+// have a large number of policies that target the same resource.
+// Instead, use targetRefs to attach the policy.
+type LocalPolicyTargetSelectorWithSectionNameApplyConfiguration struct {
+	LocalPolicyTargetSelectorApplyConfiguration `json:",inline"`
+	// The section name of the target resource.
+	SectionName *v1.SectionName `json:"sectionName,omitempty"`
+}
+
+// LocalPolicyTargetSelectorWithSectionNameApplyConfiguration constructs a declarative configuration of the LocalPolicyTargetSelectorWithSectionName type for use with
+// apply.
+func LocalPolicyTargetSelectorWithSectionName() *LocalPolicyTargetSelectorWithSectionNameApplyConfiguration {
+	return &LocalPolicyTargetSelectorWithSectionNameApplyConfiguration{}
+}
+
+// WithGroup sets the Group field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Group field is set to the value of the last call.
+func (b *LocalPolicyTargetSelectorWithSectionNameApplyConfiguration) WithGroup(value v1.Group) *LocalPolicyTargetSelectorWithSectionNameApplyConfiguration {
+	b.LocalPolicyTargetSelectorApplyConfiguration.Group = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *LocalPolicyTargetSelectorWithSectionNameApplyConfiguration) WithKind(value v1.Kind) *LocalPolicyTargetSelectorWithSectionNameApplyConfiguration {
+	b.LocalPolicyTargetSelectorApplyConfiguration.Kind = &value
+	return b
+}
+
+// WithMatchLabels puts the entries into the MatchLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the MatchLabels field,
+// overwriting an existing map entries in MatchLabels field with the same key.
+func (b *LocalPolicyTargetSelectorWithSectionNameApplyConfiguration) WithMatchLabels(entries map[string]string) *LocalPolicyTargetSelectorWithSectionNameApplyConfiguration {
+	if b.LocalPolicyTargetSelectorApplyConfiguration.MatchLabels == nil && len(entries) > 0 {
+		b.LocalPolicyTargetSelectorApplyConfiguration.MatchLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.LocalPolicyTargetSelectorApplyConfiguration.MatchLabels[k] = v
+	}
+	return b
+}
+
+// WithSectionName sets the SectionName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SectionName field is set to the value of the last call.
+func (b *LocalPolicyTargetSelectorWithSectionNameApplyConfiguration) WithSectionName(value v1.SectionName) *LocalPolicyTargetSelectorWithSectionNameApplyConfiguration {
+	b.SectionName = &value
+	return b
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package shared
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// NamespacedObjectReferenceApplyConfiguration represents a declarative configuration of the NamespacedObjectReference type for use
+// with apply.
+//
+// Control-plane Authorization rules not specific to policies:
+// Select the object by Name and Namespace.
+// You can target only one object at a time.
+type NamespacedObjectReferenceApplyConfiguration struct {
+	// The name of the target resource.
+	Name *v1.ObjectName `json:"name,omitempty"`
+	// The namespace of the target resource.
+	// If not set, defaults to the namespace of the parent object.
+	Namespace *v1.Namespace `json:"namespace,omitempty"`
+}
+
+// NamespacedObjectReferenceApplyConfiguration constructs a declarative configuration of the NamespacedObjectReference type for use with
+// apply.
+func NamespacedObjectReference() *NamespacedObjectReferenceApplyConfiguration {
+	return &NamespacedObjectReferenceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *NamespacedObjectReferenceApplyConfiguration) WithName(value v1.ObjectName) *NamespacedObjectReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *NamespacedObjectReferenceApplyConfiguration) WithNamespace(value v1.Namespace) *NamespacedObjectReferenceApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
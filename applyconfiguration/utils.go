@@ -0,0 +1,313 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfiguration
+
+import (
+	kgateway "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	enterprisesolo "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+	waf "github.com/solo-io/kgateway-client/v2/api/v1alpha1/waf"
+	extauthsoloiov1 "github.com/solo-io/kgateway-client/v2/applyconfiguration/extauth.solo.io/v1"
+	internal "github.com/solo-io/kgateway-client/v2/applyconfiguration/internal"
+	v1alpha1kgateway "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/kgateway"
+	ratelimitsoloiov1alpha1 "github.com/solo-io/kgateway-client/v2/applyconfiguration/ratelimit.solo.io/v1alpha1"
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/enterprisekgateway"
+	v1alpha1enterprisesolo "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/enterprisesolo"
+	v1alpha1waf "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/waf"
+	v1 "github.com/solo-io/kgateway-client/v2/external/extauth.solo.io/v1"
+	v1alpha1 "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	managedfields "k8s.io/apimachinery/pkg/util/managedfields"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=enterprise.solo.io, Version=enterprisesolo
+	case enterprisesolo.SchemeGroupVersion.WithKind("AllowedRoutes"):
+		return &v1alpha1enterprisesolo.AllowedRoutesApplyConfiguration{}
+	case enterprisesolo.SchemeGroupVersion.WithKind("EnterpriseListenerEntry"):
+		return &v1alpha1enterprisesolo.EnterpriseListenerEntryApplyConfiguration{}
+	case enterprisesolo.SchemeGroupVersion.WithKind("EnterpriseListenerEntryStatus"):
+		return &v1alpha1enterprisesolo.EnterpriseListenerEntryStatusApplyConfiguration{}
+	case enterprisesolo.SchemeGroupVersion.WithKind("EnterpriseListenerSet"):
+		return &v1alpha1enterprisesolo.EnterpriseListenerSetApplyConfiguration{}
+	case enterprisesolo.SchemeGroupVersion.WithKind("EnterpriseListenerSetSpec"):
+		return &v1alpha1enterprisesolo.EnterpriseListenerSetSpecApplyConfiguration{}
+	case enterprisesolo.SchemeGroupVersion.WithKind("EnterpriseListenerSetStatus"):
+		return &v1alpha1enterprisesolo.EnterpriseListenerSetStatusApplyConfiguration{}
+	case enterprisesolo.SchemeGroupVersion.WithKind("ParentGatewayReference"):
+		return &v1alpha1enterprisesolo.ParentGatewayReferenceApplyConfiguration{}
+
+		// Group=enterprisekgateway.solo.io, Version=enterprisekgateway
+	case enterprisekgateway.SchemeGroupVersion.WithKind("AWSLambdaTransformation"):
+		return &v1alpha1enterprisekgateway.AWSLambdaTransformationApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("BodyTransformation"):
+		return &v1alpha1enterprisekgateway.BodyTransformationApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("ClaimToHeader"):
+		return &v1alpha1enterprisekgateway.ClaimToHeaderApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("ContainerConfiguration"):
+		return &v1alpha1enterprisekgateway.ContainerConfigurationApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("DeploymentConfiguration"):
+		return &v1alpha1enterprisekgateway.DeploymentConfigurationApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("DynamicMetadataValue"):
+		return &v1alpha1enterprisekgateway.DynamicMetadataValueApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("EnterpriseKgatewayKubernetesProxyConfig"):
+		return &v1alpha1enterprisekgateway.EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("EnterpriseKgatewayParameters"):
+		return &v1alpha1enterprisekgateway.EnterpriseKgatewayParametersApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("EnterpriseKgatewayParametersSpec"):
+		return &v1alpha1enterprisekgateway.EnterpriseKgatewayParametersSpecApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("EnterpriseKgatewayTrafficPolicy"):
+		return &v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("EnterpriseKgatewayTrafficPolicySpec"):
+		return &v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicySpecApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("EntExtAuth"):
+		return &v1alpha1enterprisekgateway.EntExtAuthApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("EntJWT"):
+		return &v1alpha1enterprisekgateway.EntJWTApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("EntRateLimit"):
+		return &v1alpha1enterprisekgateway.EntRateLimitApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("EntRBAC"):
+		return &v1alpha1enterprisekgateway.EntRBACApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("EntTransformation"):
+		return &v1alpha1enterprisekgateway.EntTransformationApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("EntWAF"):
+		return &v1alpha1enterprisekgateway.EntWAFApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("ExtAuthConfiguration"):
+		return &v1alpha1enterprisekgateway.ExtAuthConfigurationApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("Extensions"):
+		return &v1alpha1enterprisekgateway.ExtensionsApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("Extraction"):
+		return &v1alpha1enterprisekgateway.ExtractionApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("GlobalRateLimit"):
+		return &v1alpha1enterprisekgateway.GlobalRateLimitApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("HeaderBodyTransform"):
+		return &v1alpha1enterprisekgateway.HeaderBodyTransformApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("HeaderToAppend"):
+		return &v1alpha1enterprisekgateway.HeaderToAppendApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("JWKS"):
+		return &v1alpha1enterprisekgateway.JWKSApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("JwksAsyncFetch"):
+		return &v1alpha1enterprisekgateway.JwksAsyncFetchApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("JWTProvider"):
+		return &v1alpha1enterprisekgateway.JWTProviderApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("LocalJWKS"):
+		return &v1alpha1enterprisekgateway.LocalJWKSApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("OverridableTemplate"):
+		return &v1alpha1enterprisekgateway.OverridableTemplateApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("QueryParameterMatcher"):
+		return &v1alpha1enterprisekgateway.QueryParameterMatcherApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RateLimiterConfiguration"):
+		return &v1alpha1enterprisekgateway.RateLimiterConfigurationApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RBACJWTPrincipal"):
+		return &v1alpha1enterprisekgateway.RBACJWTPrincipalApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RBACPermissions"):
+		return &v1alpha1enterprisekgateway.RBACPermissionsApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RBACPolicy"):
+		return &v1alpha1enterprisekgateway.RBACPolicyApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RBACPrincipal"):
+		return &v1alpha1enterprisekgateway.RBACPrincipalApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RedisAuth"):
+		return &v1alpha1enterprisekgateway.RedisAuthApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RedisAWSAuth"):
+		return &v1alpha1enterprisekgateway.RedisAWSAuthApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RedisCerts"):
+		return &v1alpha1enterprisekgateway.RedisCertsApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RedisClientConfig"):
+		return &v1alpha1enterprisekgateway.RedisClientConfigApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RedisConnectionConfig"):
+		return &v1alpha1enterprisekgateway.RedisConnectionConfigApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RedisSecretAuth"):
+		return &v1alpha1enterprisekgateway.RedisSecretAuthApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RegexMatcher"):
+		return &v1alpha1enterprisekgateway.RegexMatcherApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RemoteJWKS"):
+		return &v1alpha1enterprisekgateway.RemoteJWKSApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RequestMatcher"):
+		return &v1alpha1enterprisekgateway.RequestMatcherApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("RequestResponseTransformations"):
+		return &v1alpha1enterprisekgateway.RequestResponseTransformationsApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("ResponseMatcher"):
+		return &v1alpha1enterprisekgateway.ResponseMatcherApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("SpanTransformer"):
+		return &v1alpha1enterprisekgateway.SpanTransformerApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("StagedJWT"):
+		return &v1alpha1enterprisekgateway.StagedJWTApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("StagedTransformations"):
+		return &v1alpha1enterprisekgateway.StagedTransformationsApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("TlsContextMatchOptions"):
+		return &v1alpha1enterprisekgateway.TlsContextMatchOptionsApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("TokenSource"):
+		return &v1alpha1enterprisekgateway.TokenSourceApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("TokenSourceHeaderSource"):
+		return &v1alpha1enterprisekgateway.TokenSourceHeaderSourceApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("Transformation"):
+		return &v1alpha1enterprisekgateway.TransformationApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("TransformationHeaderMatcher"):
+		return &v1alpha1enterprisekgateway.TransformationHeaderMatcherApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("TransformationRequestMatcher"):
+		return &v1alpha1enterprisekgateway.TransformationRequestMatcherApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("TransformationTemplate"):
+		return &v1alpha1enterprisekgateway.TransformationTemplateApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("WAFAdminConfiguration"):
+		return &v1alpha1enterprisekgateway.WAFAdminConfigurationApplyConfiguration{}
+	case enterprisekgateway.SchemeGroupVersion.WithKind("WAFConfiguration"):
+		return &v1alpha1enterprisekgateway.WAFConfigurationApplyConfiguration{}
+
+		// Group=extauth.solo.io, Version=v1
+	case v1.SchemeGroupVersion.WithKind("AuthConfig"):
+		return &extauthsoloiov1.AuthConfigApplyConfiguration{}
+
+		// Group=gateway.kgateway.dev, Version=kgateway
+	case kgateway.SchemeGroupVersion.WithKind("APIKeyAuth"):
+		return &v1alpha1kgateway.APIKeyAuthApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("APIKeySource"):
+		return &v1alpha1kgateway.APIKeySourceApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("BasicAuthPolicy"):
+		return &v1alpha1kgateway.BasicAuthPolicyApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("BodyTransformation"):
+		return &v1alpha1kgateway.BodyTransformationApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("Buffer"):
+		return &v1alpha1kgateway.BufferApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("Compression"):
+		return &v1alpha1kgateway.CompressionApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("CorsPolicy"):
+		return &v1alpha1kgateway.CorsPolicyApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("CSRFPolicy"):
+		return &v1alpha1kgateway.CSRFPolicyApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("EnvoyBootstrap"):
+		return &v1alpha1kgateway.EnvoyBootstrapApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("EnvoyContainer"):
+		return &v1alpha1kgateway.EnvoyContainerApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("ExtAuthBufferSettings"):
+		return &v1alpha1kgateway.ExtAuthBufferSettingsApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("ExtAuthPolicy"):
+		return &v1alpha1kgateway.ExtAuthPolicyApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("ExtProcPolicy"):
+		return &v1alpha1kgateway.ExtProcPolicyApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("GatewayParametersOverlays"):
+		return &v1alpha1kgateway.GatewayParametersOverlaysApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("GracefulShutdownSpec"):
+		return &v1alpha1kgateway.GracefulShutdownSpecApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("HeaderTransformation"):
+		return &v1alpha1kgateway.HeaderTransformationApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("Image"):
+		return &v1alpha1kgateway.ImageApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("IstioContainer"):
+		return &v1alpha1kgateway.IstioContainerApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("IstioIntegration"):
+		return &v1alpha1kgateway.IstioIntegrationApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("JWTAuth"):
+		return &v1alpha1kgateway.JWTAuthApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("LabelSelector"):
+		return &v1alpha1kgateway.LabelSelectorApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("LocalRateLimitPolicy"):
+		return &v1alpha1kgateway.LocalRateLimitPolicyApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("OAuth2Policy"):
+		return &v1alpha1kgateway.OAuth2PolicyApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("PathRegexRewrite"):
+		return &v1alpha1kgateway.PathRegexRewriteApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("Pod"):
+		return &v1alpha1kgateway.PodApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("Port"):
+		return &v1alpha1kgateway.PortApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("ProcessingMode"):
+		return &v1alpha1kgateway.ProcessingModeApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("ProxyDeployment"):
+		return &v1alpha1kgateway.ProxyDeploymentApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("RateLimit"):
+		return &v1alpha1kgateway.RateLimitApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("RateLimitDescriptor"):
+		return &v1alpha1kgateway.RateLimitDescriptorApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("RateLimitDescriptorEntry"):
+		return &v1alpha1kgateway.RateLimitDescriptorEntryApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("RateLimitDescriptorEntryGeneric"):
+		return &v1alpha1kgateway.RateLimitDescriptorEntryGenericApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("RateLimitPolicy"):
+		return &v1alpha1kgateway.RateLimitPolicyApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("RequestDecompression"):
+		return &v1alpha1kgateway.RequestDecompressionApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("ResponseCompression"):
+		return &v1alpha1kgateway.ResponseCompressionApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("Retry"):
+		return &v1alpha1kgateway.RetryApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("SdsBootstrap"):
+		return &v1alpha1kgateway.SdsBootstrapApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("SdsContainer"):
+		return &v1alpha1kgateway.SdsContainerApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("SecretReference"):
+		return &v1alpha1kgateway.SecretReferenceApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("Service"):
+		return &v1alpha1kgateway.ServiceApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("ServiceAccount"):
+		return &v1alpha1kgateway.ServiceAccountApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("StatsConfig"):
+		return &v1alpha1kgateway.StatsConfigApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("StatsMatcher"):
+		return &v1alpha1kgateway.StatsMatcherApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("TokenBucket"):
+		return &v1alpha1kgateway.TokenBucketApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("TrafficPolicySpec"):
+		return &v1alpha1kgateway.TrafficPolicySpecApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("Transform"):
+		return &v1alpha1kgateway.TransformApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("TransformationPolicy"):
+		return &v1alpha1kgateway.TransformationPolicyApplyConfiguration{}
+	case kgateway.SchemeGroupVersion.WithKind("URLRewrite"):
+		return &v1alpha1kgateway.URLRewriteApplyConfiguration{}
+
+		// Group=ratelimit.solo.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("RateLimitConfig"):
+		return &ratelimitsoloiov1alpha1.RateLimitConfigApplyConfiguration{}
+
+		// Group=waf.solo.io, Version=waf
+	case waf.SchemeGroupVersion.WithKind("ConfigMapRef"):
+		return &v1alpha1waf.ConfigMapRefApplyConfiguration{}
+	case waf.SchemeGroupVersion.WithKind("CoreRuleSet"):
+		return &v1alpha1waf.CoreRuleSetApplyConfiguration{}
+	case waf.SchemeGroupVersion.WithKind("CustomInterventionResponse"):
+		return &v1alpha1waf.CustomInterventionResponseApplyConfiguration{}
+	case waf.SchemeGroupVersion.WithKind("CustomInterventionResponseHeader"):
+		return &v1alpha1waf.CustomInterventionResponseHeaderApplyConfiguration{}
+	case waf.SchemeGroupVersion.WithKind("CustomInterventionResponseHeaders"):
+		return &v1alpha1waf.CustomInterventionResponseHeadersApplyConfiguration{}
+	case waf.SchemeGroupVersion.WithKind("DirectiveSource"):
+		return &v1alpha1waf.DirectiveSourceApplyConfiguration{}
+	case waf.SchemeGroupVersion.WithKind("ProcessingConfig"):
+		return &v1alpha1waf.ProcessingConfigApplyConfiguration{}
+	case waf.SchemeGroupVersion.WithKind("RequestProcessingConfig"):
+		return &v1alpha1waf.RequestProcessingConfigApplyConfiguration{}
+	case waf.SchemeGroupVersion.WithKind("ResponseProcessingConfig"):
+		return &v1alpha1waf.ResponseProcessingConfigApplyConfiguration{}
+	case waf.SchemeGroupVersion.WithKind("WAFPolicy"):
+		return &v1alpha1waf.WAFPolicyApplyConfiguration{}
+	case waf.SchemeGroupVersion.WithKind("WAFPolicySpec"):
+		return &v1alpha1waf.WAFPolicySpecApplyConfiguration{}
+	case waf.SchemeGroupVersion.WithKind("WAFPolicyStatus"):
+		return &v1alpha1waf.WAFPolicyStatusApplyConfiguration{}
+
+	}
+	return nil
+}
+
+func NewTypeConverter(scheme *runtime.Scheme) managedfields.TypeConverter {
+	return managedfields.NewSchemeTypeConverter(scheme, internal.Parser())
+}
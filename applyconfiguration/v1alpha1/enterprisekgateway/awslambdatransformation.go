@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// AWSLambdaTransformationApplyConfiguration represents a declarative configuration of the AWSLambdaTransformation type for use
+// with apply.
+//
+// AWSLambdaTransformation defines the AWS Lambda transformation configuration for requests and responses.
+type AWSLambdaTransformationApplyConfiguration struct {
+	// RequestFormat defines the format to transform requests to AWS Lambda functions.
+	RequestFormat *v1alpha1enterprisekgateway.AWSLambdaTransformFormat `json:"requestFormat,omitempty"`
+	// ResponseFormat defines the format to transform responses from AWS Lambda functions.
+	ResponseFormat *v1alpha1enterprisekgateway.AWSLambdaTransformFormat `json:"responseFormat,omitempty"`
+}
+
+// AWSLambdaTransformationApplyConfiguration constructs a declarative configuration of the AWSLambdaTransformation type for use with
+// apply.
+func AWSLambdaTransformation() *AWSLambdaTransformationApplyConfiguration {
+	return &AWSLambdaTransformationApplyConfiguration{}
+}
+
+// WithRequestFormat sets the RequestFormat field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestFormat field is set to the value of the last call.
+func (b *AWSLambdaTransformationApplyConfiguration) WithRequestFormat(value v1alpha1enterprisekgateway.AWSLambdaTransformFormat) *AWSLambdaTransformationApplyConfiguration {
+	b.RequestFormat = &value
+	return b
+}
+
+// WithResponseFormat sets the ResponseFormat field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResponseFormat field is set to the value of the last call.
+func (b *AWSLambdaTransformationApplyConfiguration) WithResponseFormat(value v1alpha1enterprisekgateway.AWSLambdaTransformFormat) *AWSLambdaTransformationApplyConfiguration {
+	b.ResponseFormat = &value
+	return b
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// BodyTransformationApplyConfiguration represents a declarative configuration of the BodyTransformation type for use
+// with apply.
+//
+// BodyTransformation defines how to transform the body.
+type BodyTransformationApplyConfiguration struct {
+	// Type specifies the type of body transformation to apply.
+	Type *v1alpha1enterprisekgateway.BodyTransformationType `json:"type,omitempty"`
+	// Body is the request/response body to be transformed. Only use when Type is Body.
+	Body *v1alpha1enterprisekgateway.InjaTemplate `json:"body,omitempty"`
+	// MergeJsonKeys is a transformation template used to merge json keys. Only use when Type is MergeJsonKeys.
+	// A set of key-value pairs to merge into the JSON body.
+	// Each value will be rendered separately, and then placed into the JSON body at
+	// the specified key.
+	// There are a number of important caveats to using this feature:
+	// * This can only be used when the body is parsed as JSON.
+	// * This option does NOT work with advanced templates currently
+	//
+	// Map of key name -> template to render into the JSON body.
+	// Specified keys which don't exist in the JSON body will be set,
+	// keys which do exist will be override.
+	//
+	// For example, given the following JSON body:
+	// {
+	// "key1": "value1"
+	// }
+	// and the following MergeJsonKeys:
+	// {
+	// "key1": "{{ header("header1") }}",
+	// "key2": "{{ header("header2") }}"
+	// }
+	// The resulting JSON body will be:
+	// {
+	// "key1": "header1_value",
+	// "key2": "header2_value"
+	// }
+	MergeJsonKeys map[string]OverridableTemplateApplyConfiguration `json:"mergeJsonKeys,omitempty"`
+}
+
+// BodyTransformationApplyConfiguration constructs a declarative configuration of the BodyTransformation type for use with
+// apply.
+func BodyTransformation() *BodyTransformationApplyConfiguration {
+	return &BodyTransformationApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *BodyTransformationApplyConfiguration) WithType(value v1alpha1enterprisekgateway.BodyTransformationType) *BodyTransformationApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithBody sets the Body field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Body field is set to the value of the last call.
+func (b *BodyTransformationApplyConfiguration) WithBody(value v1alpha1enterprisekgateway.InjaTemplate) *BodyTransformationApplyConfiguration {
+	b.Body = &value
+	return b
+}
+
+// WithMergeJsonKeys puts the entries into the MergeJsonKeys field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the MergeJsonKeys field,
+// overwriting an existing map entries in MergeJsonKeys field with the same key.
+func (b *BodyTransformationApplyConfiguration) WithMergeJsonKeys(entries map[string]OverridableTemplateApplyConfiguration) *BodyTransformationApplyConfiguration {
+	if b.MergeJsonKeys == nil && len(entries) > 0 {
+		b.MergeJsonKeys = make(map[string]OverridableTemplateApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.MergeJsonKeys[k] = v
+	}
+	return b
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// ClaimToHeaderApplyConfiguration represents a declarative configuration of the ClaimToHeader type for use
+// with apply.
+//
+// Allows copying verified claims to headers sent upstream
+type ClaimToHeaderApplyConfiguration struct {
+	// Claim name. for example, "sub"
+	Claim *string `json:"claim,omitempty"`
+	// The header the claim will be copied to. for example, "x-sub".
+	Header *string `json:"header,omitempty"`
+	// If the header exists, append to it (true), or overwrite it (false).
+	// If omitted, will default to false.
+	Append *bool `json:"append,omitempty"`
+}
+
+// ClaimToHeaderApplyConfiguration constructs a declarative configuration of the ClaimToHeader type for use with
+// apply.
+func ClaimToHeader() *ClaimToHeaderApplyConfiguration {
+	return &ClaimToHeaderApplyConfiguration{}
+}
+
+// WithClaim sets the Claim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Claim field is set to the value of the last call.
+func (b *ClaimToHeaderApplyConfiguration) WithClaim(value string) *ClaimToHeaderApplyConfiguration {
+	b.Claim = &value
+	return b
+}
+
+// WithHeader sets the Header field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Header field is set to the value of the last call.
+func (b *ClaimToHeaderApplyConfiguration) WithHeader(value string) *ClaimToHeaderApplyConfiguration {
+	b.Header = &value
+	return b
+}
+
+// WithAppend sets the Append field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Append field is set to the value of the last call.
+func (b *ClaimToHeaderApplyConfiguration) WithAppend(value bool) *ClaimToHeaderApplyConfiguration {
+	b.Append = &value
+	return b
+}
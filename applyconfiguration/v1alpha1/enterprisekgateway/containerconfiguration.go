@@ -0,0 +1,60 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	kgateway "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/kgateway"
+	v1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// ContainerConfigurationApplyConfiguration represents a declarative configuration of the ContainerConfiguration type for use
+// with apply.
+type ContainerConfigurationApplyConfiguration struct {
+	// The image. See https://kubernetes.io/docs/concepts/containers/images for
+	// details.
+	//
+	Image *kgateway.ImageApplyConfiguration `json:"image,omitempty"`
+	// The security context for this container. Note OmitSecurityContext and
+	// FloatingUserId, two related settings. See
+	// https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.26/#securitycontext-v1-core
+	// for details.
+	//
+	SecurityContext *v1.SecurityContextApplyConfiguration `json:"securityContext,omitempty"`
+}
+
+// ContainerConfigurationApplyConfiguration constructs a declarative configuration of the ContainerConfiguration type for use with
+// apply.
+func ContainerConfiguration() *ContainerConfigurationApplyConfiguration {
+	return &ContainerConfigurationApplyConfiguration{}
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *ContainerConfigurationApplyConfiguration) WithImage(value *kgateway.ImageApplyConfiguration) *ContainerConfigurationApplyConfiguration {
+	b.Image = value
+	return b
+}
+
+// WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityContext field is set to the value of the last call.
+func (b *ContainerConfigurationApplyConfiguration) WithSecurityContext(value *v1.SecurityContextApplyConfiguration) *ContainerConfigurationApplyConfiguration {
+	b.SecurityContext = value
+	return b
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	kgateway "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/kgateway"
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+	appsv1 "k8s.io/client-go/applyconfigurations/apps/v1"
+	v1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// DeploymentConfigurationApplyConfiguration represents a declarative configuration of the DeploymentConfiguration type for use
+// with apply.
+//
+// DeploymentConfiguration configures the Kubernetes Deployment.
+type DeploymentConfigurationApplyConfiguration struct {
+	// Enabled indicates whether the extension is enabled. If not enabled, then no resources for this extension will be deployed.
+	// If the extension was previously enabled and then disabled, the deployed resources will be garbage collected, regardless of
+	// whether any other configuration still depends on it.
+	Enabled     *bool                                      `json:"enabled,omitempty"`
+	Resources   *v1.ResourceRequirementsApplyConfiguration `json:"resources,omitempty"`
+	PodTemplate *kgateway.PodApplyConfiguration            `json:"pod,omitempty"`
+	Container   *ContainerConfigurationApplyConfiguration  `json:"container,omitempty"`
+	// The number of desired pods.
+	// If omitted, behavior will be managed by the K8s control plane, and will default to 1.
+	// If you are using an HPA, make sure to not explicitly define this.
+	// K8s reference: https://kubernetes.io/docs/concepts/workloads/controllers/deployment/#replicas
+	//
+	Replicas *int32 `json:"replicas,omitempty"`
+	// The deployment strategy to use to replace existing pods with new
+	// ones. The Kubernetes default is a RollingUpdate with 25% maxUnavailable,
+	// 25% maxSurge.
+	//
+	// E.g., to recreate pods, minimizing resources for the rollout but causing downtime:
+	// strategy:
+	// type: Recreate
+	// E.g., to roll out as a RollingUpdate but with non-default parameters:
+	// strategy:
+	// type: RollingUpdate
+	// rollingUpdate:
+	// maxSurge: 100%
+	//
+	Strategy *appsv1.DeploymentStrategyApplyConfiguration `json:"strategy,omitempty"`
+	// DeploymentOverlay allows specifying overrides for the generated Deployment resource.
+	// Use this for advanced customization not covered by the typed config fields,
+	// such as adding initContainers, sidecars, or removing security contexts for OpenShift.
+	DeploymentOverlay *shared.KubernetesResourceOverlayApplyConfiguration `json:"deploymentOverlay,omitempty"`
+	// ServiceOverlay allows specifying overrides for the generated Service resource.
+	ServiceOverlay *shared.KubernetesResourceOverlayApplyConfiguration `json:"serviceOverlay,omitempty"`
+	// ServiceAccountOverlay allows specifying overrides for the generated ServiceAccount resource.
+	ServiceAccountOverlay *shared.KubernetesResourceOverlayApplyConfiguration `json:"serviceAccountOverlay,omitempty"`
+	// PodDisruptionBudget allows creating a PodDisruptionBudget for this extension.
+	// If absent, no PDB is created. If present, a PDB is created with its selector
+	// automatically configured to target the extension Deployment.
+	// The metadata and spec fields from this overlay are applied to the generated PDB.
+	PodDisruptionBudget *shared.KubernetesResourceOverlayApplyConfiguration `json:"podDisruptionBudget,omitempty"`
+	// HorizontalPodAutoscaler allows creating a HorizontalPodAutoscaler for this extension.
+	// If absent, no HPA is created. If present, an HPA is created with its scaleTargetRef
+	// automatically configured to target the extension Deployment.
+	// The metadata and spec fields from this overlay are applied to the generated HPA.
+	HorizontalPodAutoscaler *shared.KubernetesResourceOverlayApplyConfiguration `json:"horizontalPodAutoscaler,omitempty"`
+	// VerticalPodAutoscaler allows creating a VerticalPodAutoscaler for this extension.
+	// If absent, no VPA is created. If present, a VPA is created with its targetRef
+	// automatically configured to target the extension Deployment.
+	// The metadata and spec fields from this overlay are applied to the generated VPA.
+	VerticalPodAutoscaler *shared.KubernetesResourceOverlayApplyConfiguration `json:"verticalPodAutoscaler,omitempty"`
+}
+
+// DeploymentConfigurationApplyConfiguration constructs a declarative configuration of the DeploymentConfiguration type for use with
+// apply.
+func DeploymentConfiguration() *DeploymentConfigurationApplyConfiguration {
+	return &DeploymentConfigurationApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *DeploymentConfigurationApplyConfiguration) WithEnabled(value bool) *DeploymentConfigurationApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *DeploymentConfigurationApplyConfiguration) WithResources(value *v1.ResourceRequirementsApplyConfiguration) *DeploymentConfigurationApplyConfiguration {
+	b.Resources = value
+	return b
+}
+
+// WithPodTemplate sets the PodTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodTemplate field is set to the value of the last call.
+func (b *DeploymentConfigurationApplyConfiguration) WithPodTemplate(value *kgateway.PodApplyConfiguration) *DeploymentConfigurationApplyConfiguration {
+	b.PodTemplate = value
+	return b
+}
+
+// WithContainer sets the Container field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Container field is set to the value of the last call.
+func (b *DeploymentConfigurationApplyConfiguration) WithContainer(value *ContainerConfigurationApplyConfiguration) *DeploymentConfigurationApplyConfiguration {
+	b.Container = value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *DeploymentConfigurationApplyConfiguration) WithReplicas(value int32) *DeploymentConfigurationApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *DeploymentConfigurationApplyConfiguration) WithStrategy(value *appsv1.DeploymentStrategyApplyConfiguration) *DeploymentConfigurationApplyConfiguration {
+	b.Strategy = value
+	return b
+}
+
+// WithDeploymentOverlay sets the DeploymentOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeploymentOverlay field is set to the value of the last call.
+func (b *DeploymentConfigurationApplyConfiguration) WithDeploymentOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *DeploymentConfigurationApplyConfiguration {
+	b.DeploymentOverlay = value
+	return b
+}
+
+// WithServiceOverlay sets the ServiceOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceOverlay field is set to the value of the last call.
+func (b *DeploymentConfigurationApplyConfiguration) WithServiceOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *DeploymentConfigurationApplyConfiguration {
+	b.ServiceOverlay = value
+	return b
+}
+
+// WithServiceAccountOverlay sets the ServiceAccountOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountOverlay field is set to the value of the last call.
+func (b *DeploymentConfigurationApplyConfiguration) WithServiceAccountOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *DeploymentConfigurationApplyConfiguration {
+	b.ServiceAccountOverlay = value
+	return b
+}
+
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *DeploymentConfigurationApplyConfiguration) WithPodDisruptionBudget(value *shared.KubernetesResourceOverlayApplyConfiguration) *DeploymentConfigurationApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
+// WithHorizontalPodAutoscaler sets the HorizontalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HorizontalPodAutoscaler field is set to the value of the last call.
+func (b *DeploymentConfigurationApplyConfiguration) WithHorizontalPodAutoscaler(value *shared.KubernetesResourceOverlayApplyConfiguration) *DeploymentConfigurationApplyConfiguration {
+	b.HorizontalPodAutoscaler = value
+	return b
+}
+
+// WithVerticalPodAutoscaler sets the VerticalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerticalPodAutoscaler field is set to the value of the last call.
+func (b *DeploymentConfigurationApplyConfiguration) WithVerticalPodAutoscaler(value *shared.KubernetesResourceOverlayApplyConfiguration) *DeploymentConfigurationApplyConfiguration {
+	b.VerticalPodAutoscaler = value
+	return b
+}
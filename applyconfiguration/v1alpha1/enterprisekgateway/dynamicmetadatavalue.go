@@ -0,0 +1,77 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// DynamicMetadataValueApplyConfiguration represents a declarative configuration of the DynamicMetadataValue type for use
+// with apply.
+//
+// DynamicMetadataValue defines an [Envoy Dynamic
+// Metadata](https://www.envoyproxy.io/docs/envoy/latest/configuration/advanced/well_known_dynamic_metadata)
+// entry.
+type DynamicMetadataValueApplyConfiguration struct {
+	// MetadataNamespace specifies the metadata namespace. Defaults to the filter namespace.
+	MetadataNamespace *string `json:"metadataNamespace,omitempty"`
+	// Key specifies the metadata key.
+	Key *string `json:"key,omitempty"`
+	// Value specifies the template that determines the metadata value.
+	Value *v1alpha1enterprisekgateway.InjaTemplate `json:"value,omitempty"`
+	// JsonToProto determines whether to parse the rendered value as a proto Struct message.
+	JsonToProto *bool `json:"jsonToProto,omitempty"`
+}
+
+// DynamicMetadataValueApplyConfiguration constructs a declarative configuration of the DynamicMetadataValue type for use with
+// apply.
+func DynamicMetadataValue() *DynamicMetadataValueApplyConfiguration {
+	return &DynamicMetadataValueApplyConfiguration{}
+}
+
+// WithMetadataNamespace sets the MetadataNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetadataNamespace field is set to the value of the last call.
+func (b *DynamicMetadataValueApplyConfiguration) WithMetadataNamespace(value string) *DynamicMetadataValueApplyConfiguration {
+	b.MetadataNamespace = &value
+	return b
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *DynamicMetadataValueApplyConfiguration) WithKey(value string) *DynamicMetadataValueApplyConfiguration {
+	b.Key = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *DynamicMetadataValueApplyConfiguration) WithValue(value v1alpha1enterprisekgateway.InjaTemplate) *DynamicMetadataValueApplyConfiguration {
+	b.Value = &value
+	return b
+}
+
+// WithJsonToProto sets the JsonToProto field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JsonToProto field is set to the value of the last call.
+func (b *DynamicMetadataValueApplyConfiguration) WithJsonToProto(value bool) *DynamicMetadataValueApplyConfiguration {
+	b.JsonToProto = &value
+	return b
+}
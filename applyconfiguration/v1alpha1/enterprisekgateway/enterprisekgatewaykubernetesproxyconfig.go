@@ -0,0 +1,206 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	kgateway "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/kgateway"
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+)
+
+// EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration represents a declarative configuration of the EnterpriseKgatewayKubernetesProxyConfig type for use
+// with apply.
+type EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration struct {
+	// Use a Kubernetes deployment as the proxy workload type. Currently, this is the only
+	// supported workload type.
+	//
+	Deployment *kgateway.ProxyDeploymentApplyConfiguration `json:"deployment,omitempty"`
+	// Configuration for the container running Envoy.
+	// If agentgateway is enabled, the EnvoyContainer values will be ignored.
+	//
+	EnvoyContainer *kgateway.EnvoyContainerApplyConfiguration `json:"envoyContainer,omitempty"`
+	// Configuration for the container running the Secret Discovery Service (SDS).
+	//
+	SdsContainer *kgateway.SdsContainerApplyConfiguration `json:"sdsContainer,omitempty"`
+	// Configuration for the pods that will be created.
+	//
+	PodTemplate *kgateway.PodApplyConfiguration `json:"podTemplate,omitempty"`
+	// Configuration for the Kubernetes Service that exposes the Envoy proxy over
+	// the network.
+	//
+	Service *kgateway.ServiceApplyConfiguration `json:"service,omitempty"`
+	// Configuration for the Kubernetes ServiceAccount used by the Envoy pod.
+	//
+	ServiceAccount *kgateway.ServiceAccountApplyConfiguration `json:"serviceAccount,omitempty"`
+	// Configuration for the Istio integration.
+	//
+	Istio *kgateway.IstioIntegrationApplyConfiguration `json:"istio,omitempty"`
+	// Configuration for the stats server.
+	//
+	Stats *kgateway.StatsConfigApplyConfiguration `json:"stats,omitempty"`
+	// OmitDefaultSecurityContext is used to control whether or not
+	// `securityContext` fields should be rendered for the various generated
+	// Deployments/Containers that are dynamically provisioned by the deployer.
+	//
+	// When set to true, no `securityContexts` will be provided and will left
+	// to the user/platform to be provided.
+	//
+	// This should be enabled on platforms such as Red Hat OpenShift where the
+	// `securityContext` will be dynamically added to enforce the appropriate
+	// level of security.
+	//
+	OmitDefaultSecurityContext *bool `json:"omitDefaultSecurityContext,omitempty"`
+	// GatewayParametersOverlays contains overlay fields for proxy deployment resources.
+	// These allow applying strategic merge patches and creating HPA/PDB/VPA resources.
+	kgateway.GatewayParametersOverlaysApplyConfiguration `json:",inline"`
+	// SharedExtensions defines extensions that are shared across all Gateways of the same GatewayClass
+	SharedExtensions *ExtensionsApplyConfiguration `json:"sharedExtensions,omitempty"`
+}
+
+// EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration constructs a declarative configuration of the EnterpriseKgatewayKubernetesProxyConfig type for use with
+// apply.
+func EnterpriseKgatewayKubernetesProxyConfig() *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	return &EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration{}
+}
+
+// WithDeployment sets the Deployment field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Deployment field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithDeployment(value *kgateway.ProxyDeploymentApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.Deployment = value
+	return b
+}
+
+// WithEnvoyContainer sets the EnvoyContainer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EnvoyContainer field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithEnvoyContainer(value *kgateway.EnvoyContainerApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.EnvoyContainer = value
+	return b
+}
+
+// WithSdsContainer sets the SdsContainer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SdsContainer field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithSdsContainer(value *kgateway.SdsContainerApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.SdsContainer = value
+	return b
+}
+
+// WithPodTemplate sets the PodTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodTemplate field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithPodTemplate(value *kgateway.PodApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.PodTemplate = value
+	return b
+}
+
+// WithService sets the Service field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Service field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithService(value *kgateway.ServiceApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.Service = value
+	return b
+}
+
+// WithServiceAccount sets the ServiceAccount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccount field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithServiceAccount(value *kgateway.ServiceAccountApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.ServiceAccount = value
+	return b
+}
+
+// WithIstio sets the Istio field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Istio field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithIstio(value *kgateway.IstioIntegrationApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.Istio = value
+	return b
+}
+
+// WithStats sets the Stats field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Stats field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithStats(value *kgateway.StatsConfigApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.Stats = value
+	return b
+}
+
+// WithOmitDefaultSecurityContext sets the OmitDefaultSecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OmitDefaultSecurityContext field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithOmitDefaultSecurityContext(value bool) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.OmitDefaultSecurityContext = &value
+	return b
+}
+
+// WithDeploymentOverlay sets the DeploymentOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeploymentOverlay field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithDeploymentOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.GatewayParametersOverlaysApplyConfiguration.DeploymentOverlay = value
+	return b
+}
+
+// WithServiceOverlay sets the ServiceOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceOverlay field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithServiceOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.GatewayParametersOverlaysApplyConfiguration.ServiceOverlay = value
+	return b
+}
+
+// WithServiceAccountOverlay sets the ServiceAccountOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountOverlay field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithServiceAccountOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.GatewayParametersOverlaysApplyConfiguration.ServiceAccountOverlay = value
+	return b
+}
+
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithPodDisruptionBudget(value *shared.KubernetesResourceOverlayApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.GatewayParametersOverlaysApplyConfiguration.PodDisruptionBudget = value
+	return b
+}
+
+// WithHorizontalPodAutoscaler sets the HorizontalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HorizontalPodAutoscaler field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithHorizontalPodAutoscaler(value *shared.KubernetesResourceOverlayApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.GatewayParametersOverlaysApplyConfiguration.HorizontalPodAutoscaler = value
+	return b
+}
+
+// WithVerticalPodAutoscaler sets the VerticalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerticalPodAutoscaler field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithVerticalPodAutoscaler(value *shared.KubernetesResourceOverlayApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.GatewayParametersOverlaysApplyConfiguration.VerticalPodAutoscaler = value
+	return b
+}
+
+// WithSharedExtensions sets the SharedExtensions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SharedExtensions field is set to the value of the last call.
+func (b *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) WithSharedExtensions(value *ExtensionsApplyConfiguration) *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration {
+	b.SharedExtensions = value
+	return b
+}
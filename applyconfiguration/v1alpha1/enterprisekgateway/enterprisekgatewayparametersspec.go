@@ -0,0 +1,41 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// EnterpriseKgatewayParametersSpecApplyConfiguration represents a declarative configuration of the EnterpriseKgatewayParametersSpec type for use
+// with apply.
+//
+// EnterpriseKgatewayParametersSpec defines the desired state of EnterpriseKgatewayParameters
+type EnterpriseKgatewayParametersSpecApplyConfiguration struct {
+	// Kubernetes configuration for the proxy.
+	Kube *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration `json:"kube,omitempty"`
+}
+
+// EnterpriseKgatewayParametersSpecApplyConfiguration constructs a declarative configuration of the EnterpriseKgatewayParametersSpec type for use with
+// apply.
+func EnterpriseKgatewayParametersSpec() *EnterpriseKgatewayParametersSpecApplyConfiguration {
+	return &EnterpriseKgatewayParametersSpecApplyConfiguration{}
+}
+
+// WithKube sets the Kube field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kube field is set to the value of the last call.
+func (b *EnterpriseKgatewayParametersSpecApplyConfiguration) WithKube(value *EnterpriseKgatewayKubernetesProxyConfigApplyConfiguration) *EnterpriseKgatewayParametersSpecApplyConfiguration {
+	b.Kube = value
+	return b
+}
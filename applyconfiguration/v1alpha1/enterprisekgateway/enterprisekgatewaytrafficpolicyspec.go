@@ -0,0 +1,267 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	kgateway "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/kgateway"
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+)
+
+// EnterpriseKgatewayTrafficPolicySpecApplyConfiguration represents a declarative configuration of the EnterpriseKgatewayTrafficPolicySpec type for use
+// with apply.
+//
+// EnterpriseKgatewayTrafficPolicySpec defines the desired state of EnterpriseKgatewayTrafficPolicy
+type EnterpriseKgatewayTrafficPolicySpecApplyConfiguration struct {
+	kgateway.TrafficPolicySpecApplyConfiguration `json:",inline"`
+	// EntRateLimit defines the Enterprise rate limit configuration for the traffic policy
+	EntRateLimit *EntRateLimitApplyConfiguration `json:"entRateLimit,omitempty"`
+	// EntExtAuth defines the Enterprise external authorization configuration for the traffic policy
+	EntExtAuth *EntExtAuthApplyConfiguration `json:"entExtAuth,omitempty"`
+	// EntTransformation defines the Enterprise transformation configuration for the traffic policy
+	EntTransformation *EntTransformationApplyConfiguration `json:"entTransformation,omitempty"`
+	// EntJWT allows for configuration of JWT authentication
+	EntJWT *StagedJWTApplyConfiguration `json:"entJWT,omitempty"`
+	// EntRBAC provides config for RBAC rules based on JWT claims resulting from authentication with `entJWT` configs
+	EntRBAC *EntRBACApplyConfiguration `json:"entRBAC,omitempty"`
+	// EntWAF defines the Web Application Firewall configuration
+	EntWAF *EntWAFApplyConfiguration `json:"entWAF,omitempty"`
+}
+
+// EnterpriseKgatewayTrafficPolicySpecApplyConfiguration constructs a declarative configuration of the EnterpriseKgatewayTrafficPolicySpec type for use with
+// apply.
+func EnterpriseKgatewayTrafficPolicySpec() *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	return &EnterpriseKgatewayTrafficPolicySpecApplyConfiguration{}
+}
+
+// WithTargetRefs adds the given value to the TargetRefs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TargetRefs field.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithTargetRefs(values ...*shared.LocalPolicyTargetReferenceWithSectionNameApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTargetRefs")
+		}
+		b.TrafficPolicySpecApplyConfiguration.TargetRefs = append(b.TrafficPolicySpecApplyConfiguration.TargetRefs, *values[i])
+	}
+	return b
+}
+
+// WithTargetSelectors adds the given value to the TargetSelectors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TargetSelectors field.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithTargetSelectors(values ...*shared.LocalPolicyTargetSelectorWithSectionNameApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTargetSelectors")
+		}
+		b.TrafficPolicySpecApplyConfiguration.TargetSelectors = append(b.TrafficPolicySpecApplyConfiguration.TargetSelectors, *values[i])
+	}
+	return b
+}
+
+// WithTransformation sets the Transformation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Transformation field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithTransformation(value *kgateway.TransformationPolicyApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.Transformation = value
+	return b
+}
+
+// WithExtProc sets the ExtProc field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtProc field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithExtProc(value *kgateway.ExtProcPolicyApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.ExtProc = value
+	return b
+}
+
+// WithExtAuth sets the ExtAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtAuth field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithExtAuth(value *kgateway.ExtAuthPolicyApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.ExtAuth = value
+	return b
+}
+
+// WithRateLimit sets the RateLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RateLimit field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithRateLimit(value *kgateway.RateLimitApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.RateLimit = value
+	return b
+}
+
+// WithCors sets the Cors field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cors field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithCors(value *kgateway.CorsPolicyApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.Cors = value
+	return b
+}
+
+// WithCsrf sets the Csrf field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Csrf field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithCsrf(value *kgateway.CSRFPolicyApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.Csrf = value
+	return b
+}
+
+// WithHeaderModifiers sets the HeaderModifiers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HeaderModifiers field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithHeaderModifiers(value *shared.HeaderModifiersApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.HeaderModifiers = value
+	return b
+}
+
+// WithAutoHostRewrite sets the AutoHostRewrite field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoHostRewrite field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithAutoHostRewrite(value bool) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.AutoHostRewrite = &value
+	return b
+}
+
+// WithBuffer sets the Buffer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Buffer field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithBuffer(value *kgateway.BufferApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.Buffer = value
+	return b
+}
+
+// WithTimeouts sets the Timeouts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeouts field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithTimeouts(value *shared.TimeoutsApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.Timeouts = value
+	return b
+}
+
+// WithRetry sets the Retry field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Retry field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithRetry(value *kgateway.RetryApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.Retry = value
+	return b
+}
+
+// WithRBAC sets the RBAC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RBAC field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithRBAC(value *shared.AuthorizationApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.RBAC = value
+	return b
+}
+
+// WithJWTAuth sets the JWTAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JWTAuth field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithJWTAuth(value *kgateway.JWTAuthApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.JWTAuth = value
+	return b
+}
+
+// WithUrlRewrite sets the UrlRewrite field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UrlRewrite field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithUrlRewrite(value *kgateway.URLRewriteApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.UrlRewrite = value
+	return b
+}
+
+// WithCompression sets the Compression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Compression field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithCompression(value *kgateway.CompressionApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.Compression = value
+	return b
+}
+
+// WithBasicAuth sets the BasicAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BasicAuth field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithBasicAuth(value *kgateway.BasicAuthPolicyApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.BasicAuth = value
+	return b
+}
+
+// WithAPIKeyAuth sets the APIKeyAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIKeyAuth field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithAPIKeyAuth(value *kgateway.APIKeyAuthApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.APIKeyAuth = value
+	return b
+}
+
+// WithOAuth2 sets the OAuth2 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OAuth2 field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithOAuth2(value *kgateway.OAuth2PolicyApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.TrafficPolicySpecApplyConfiguration.OAuth2 = value
+	return b
+}
+
+// WithEntRateLimit sets the EntRateLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EntRateLimit field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithEntRateLimit(value *EntRateLimitApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.EntRateLimit = value
+	return b
+}
+
+// WithEntExtAuth sets the EntExtAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EntExtAuth field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithEntExtAuth(value *EntExtAuthApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.EntExtAuth = value
+	return b
+}
+
+// WithEntTransformation sets the EntTransformation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EntTransformation field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithEntTransformation(value *EntTransformationApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.EntTransformation = value
+	return b
+}
+
+// WithEntJWT sets the EntJWT field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EntJWT field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithEntJWT(value *StagedJWTApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.EntJWT = value
+	return b
+}
+
+// WithEntRBAC sets the EntRBAC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EntRBAC field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithEntRBAC(value *EntRBACApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.EntRBAC = value
+	return b
+}
+
+// WithEntWAF sets the EntWAF field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EntWAF field is set to the value of the last call.
+func (b *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration) WithEntWAF(value *EntWAFApplyConfiguration) *EnterpriseKgatewayTrafficPolicySpecApplyConfiguration {
+	b.EntWAF = value
+	return b
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	apiv1alpha1shared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	v1alpha1shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/shared"
+)
+
+// EntExtAuthApplyConfiguration represents a declarative configuration of the EntExtAuth type for use
+// with apply.
+type EntExtAuthApplyConfiguration struct {
+	// AuthConfigRef references the AuthConfig we want the external-auth server will use to make auth
+	// decisions.
+	AuthConfigRef *shared.AuthConfigRefApplyConfiguration `json:"authConfigRef,omitempty"`
+	// ExtensionRef references a GatewayExtension that provides the external authorization service.
+	// If not set, defaults to the provisioned ext-auth-service for the GatewayClass of the parent Gateway
+	// this policy is being used in.
+	// Reference grants are not required for cross-namespace extension references.
+	ExtensionRef *v1alpha1shared.NamespacedObjectReferenceApplyConfiguration `json:"extensionRef,omitempty"`
+	// Disable all external authorization filters.
+	// Can be used to disable external authorization policies applied at a higher level in the config hierarchy.
+	Disable *apiv1alpha1shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// EntExtAuthApplyConfiguration constructs a declarative configuration of the EntExtAuth type for use with
+// apply.
+func EntExtAuth() *EntExtAuthApplyConfiguration {
+	return &EntExtAuthApplyConfiguration{}
+}
+
+// WithAuthConfigRef sets the AuthConfigRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AuthConfigRef field is set to the value of the last call.
+func (b *EntExtAuthApplyConfiguration) WithAuthConfigRef(value *shared.AuthConfigRefApplyConfiguration) *EntExtAuthApplyConfiguration {
+	b.AuthConfigRef = value
+	return b
+}
+
+// WithExtensionRef sets the ExtensionRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtensionRef field is set to the value of the last call.
+func (b *EntExtAuthApplyConfiguration) WithExtensionRef(value *v1alpha1shared.NamespacedObjectReferenceApplyConfiguration) *EntExtAuthApplyConfiguration {
+	b.ExtensionRef = value
+	return b
+}
+
+// WithDisable sets the Disable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disable field is set to the value of the last call.
+func (b *EntExtAuthApplyConfiguration) WithDisable(value apiv1alpha1shared.PolicyDisable) *EntExtAuthApplyConfiguration {
+	b.Disable = &value
+	return b
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	shared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// EntJWTApplyConfiguration represents a declarative configuration of the EntJWT type for use
+// with apply.
+//
+// EntJWT defines a set of providers used for JWT authentication (and an optional validation policy for these providers) or
+// the ability to disable JWT authentication and verification.
+type EntJWTApplyConfiguration struct {
+	// Providers maps a provider name to a JWT provider, configuring a way to authenticate JWTs.
+	// If specified, multiple providers will be `OR`-ed together and will allow validation to any of the providers.
+	Providers map[string]JWTProviderApplyConfiguration `json:"providers,omitempty"`
+	// Configure how JWT validation works, with the flexibility to handle requests with missing or invalid JWTs.
+	// By default, after applying a JWT policy, only requests that have been authenticated with a valid JWT are allowed.
+	ValidationPolicy *v1alpha1enterprisekgateway.JwtValidationPolicy `json:"validationPolicy,omitempty"`
+	// Disable JWT authentication for this policy scope.
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// EntJWTApplyConfiguration constructs a declarative configuration of the EntJWT type for use with
+// apply.
+func EntJWT() *EntJWTApplyConfiguration {
+	return &EntJWTApplyConfiguration{}
+}
+
+// WithProviders puts the entries into the Providers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Providers field,
+// overwriting an existing map entries in Providers field with the same key.
+func (b *EntJWTApplyConfiguration) WithProviders(entries map[string]JWTProviderApplyConfiguration) *EntJWTApplyConfiguration {
+	if b.Providers == nil && len(entries) > 0 {
+		b.Providers = make(map[string]JWTProviderApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.Providers[k] = v
+	}
+	return b
+}
+
+// WithValidationPolicy sets the ValidationPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ValidationPolicy field is set to the value of the last call.
+func (b *EntJWTApplyConfiguration) WithValidationPolicy(value v1alpha1enterprisekgateway.JwtValidationPolicy) *EntJWTApplyConfiguration {
+	b.ValidationPolicy = &value
+	return b
+}
+
+// WithDisable sets the Disable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disable field is set to the value of the last call.
+func (b *EntJWTApplyConfiguration) WithDisable(value shared.PolicyDisable) *EntJWTApplyConfiguration {
+	b.Disable = &value
+	return b
+}
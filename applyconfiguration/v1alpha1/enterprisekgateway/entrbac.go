@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	shared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// EntRBACApplyConfiguration represents a declarative configuration of the EntRBAC type for use
+// with apply.
+//
+// EntRBAC defines RBAC configuration.
+type EntRBACApplyConfiguration struct {
+	// Disable is used to explicitly disable RBAC checks for the scope of this policy.
+	// This is useful to allow access to static resources/login page without RBAC checks.
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+	// Policies maps a policy name to an RBAC policy to apply.
+	Policies map[string]RBACPolicyApplyConfiguration `json:"policies,omitempty"`
+}
+
+// EntRBACApplyConfiguration constructs a declarative configuration of the EntRBAC type for use with
+// apply.
+func EntRBAC() *EntRBACApplyConfiguration {
+	return &EntRBACApplyConfiguration{}
+}
+
+// WithDisable sets the Disable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disable field is set to the value of the last call.
+func (b *EntRBACApplyConfiguration) WithDisable(value shared.PolicyDisable) *EntRBACApplyConfiguration {
+	b.Disable = &value
+	return b
+}
+
+// WithPolicies puts the entries into the Policies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Policies field,
+// overwriting an existing map entries in Policies field with the same key.
+func (b *EntRBACApplyConfiguration) WithPolicies(entries map[string]RBACPolicyApplyConfiguration) *EntRBACApplyConfiguration {
+	if b.Policies == nil && len(entries) > 0 {
+		b.Policies = make(map[string]RBACPolicyApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.Policies[k] = v
+	}
+	return b
+}
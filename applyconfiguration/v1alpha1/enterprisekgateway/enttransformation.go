@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// EntTransformationApplyConfiguration represents a declarative configuration of the EntTransformation type for use
+// with apply.
+//
+// EntTransformation defines the Enterprise transformation configuration.
+type EntTransformationApplyConfiguration struct {
+	// Stages defines the transformations run at different stages of the filter chain.
+	Stages *StagedTransformationsApplyConfiguration `json:"stages,omitempty"`
+	// AWSLambda defines the AWS Lambda transformation configuration.
+	AWSLambda *AWSLambdaTransformationApplyConfiguration `json:"awsLambda,omitempty"`
+}
+
+// EntTransformationApplyConfiguration constructs a declarative configuration of the EntTransformation type for use with
+// apply.
+func EntTransformation() *EntTransformationApplyConfiguration {
+	return &EntTransformationApplyConfiguration{}
+}
+
+// WithStages sets the Stages field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Stages field is set to the value of the last call.
+func (b *EntTransformationApplyConfiguration) WithStages(value *StagedTransformationsApplyConfiguration) *EntTransformationApplyConfiguration {
+	b.Stages = value
+	return b
+}
+
+// WithAWSLambda sets the AWSLambda field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AWSLambda field is set to the value of the last call.
+func (b *EntTransformationApplyConfiguration) WithAWSLambda(value *AWSLambdaTransformationApplyConfiguration) *EntTransformationApplyConfiguration {
+	b.AWSLambda = value
+	return b
+}
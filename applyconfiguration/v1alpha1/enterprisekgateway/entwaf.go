@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1shared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/shared"
+	v1 "sigs.k8s.io/gateway-api/applyconfiguration/apis/v1"
+)
+
+// EntWAFApplyConfiguration represents a declarative configuration of the EntWAF type for use
+// with apply.
+type EntWAFApplyConfiguration struct {
+	// WAFPolicyRef references the WAFPolicy we want to use for the traffic policy
+	WAFPolicyRef *shared.WAFPolicyRefApplyConfiguration `json:"wafPolicyRef,omitempty"`
+	// WAFServerRef is a reference to the external processing gRPC service that will be used to process requests
+	// when WAF is enabled.
+	// If not set, defaults to the extproc service named 'waf-server' in the same namespace as
+	// the Solo Enterprise for kgateway control plane.
+	WAFServerRef *v1.BackendObjectReferenceApplyConfiguration `json:"wafServerRef,omitempty"`
+	// Disable WAF.
+	// Can be used to disable WAF policies applied at a higher level in the config hierarchy.
+	Disable *v1alpha1shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// EntWAFApplyConfiguration constructs a declarative configuration of the EntWAF type for use with
+// apply.
+func EntWAF() *EntWAFApplyConfiguration {
+	return &EntWAFApplyConfiguration{}
+}
+
+// WithWAFPolicyRef sets the WAFPolicyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WAFPolicyRef field is set to the value of the last call.
+func (b *EntWAFApplyConfiguration) WithWAFPolicyRef(value *shared.WAFPolicyRefApplyConfiguration) *EntWAFApplyConfiguration {
+	b.WAFPolicyRef = value
+	return b
+}
+
+// WithWAFServerRef sets the WAFServerRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WAFServerRef field is set to the value of the last call.
+func (b *EntWAFApplyConfiguration) WithWAFServerRef(value *v1.BackendObjectReferenceApplyConfiguration) *EntWAFApplyConfiguration {
+	b.WAFServerRef = value
+	return b
+}
+
+// WithDisable sets the Disable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disable field is set to the value of the last call.
+func (b *EntWAFApplyConfiguration) WithDisable(value v1alpha1shared.PolicyDisable) *EntWAFApplyConfiguration {
+	b.Disable = &value
+	return b
+}
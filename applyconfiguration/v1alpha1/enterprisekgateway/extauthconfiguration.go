@@ -0,0 +1,163 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	kgateway "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/kgateway"
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+	appsv1 "k8s.io/client-go/applyconfigurations/apps/v1"
+	v1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// ExtAuthConfigurationApplyConfiguration represents a declarative configuration of the ExtAuthConfiguration type for use
+// with apply.
+//
+// ExtAuthConfiguration configures the ExtAuth server deployment.
+type ExtAuthConfigurationApplyConfiguration struct {
+	DeploymentConfigurationApplyConfiguration `json:",inline"`
+	// SessionRedis configures the server-level default Redis connection for
+	// ExtAuth session storage (OAuth2/OIDC). When specified, individual
+	// AuthConfig CRs do not need to repeat connection details in their
+	// RedisOptions fields, though per-AuthConfig overrides are still supported.
+	//
+	SessionRedis *RedisClientConfigApplyConfiguration `json:"sessionRedis,omitempty"`
+	// ServiceAccountName sets the serviceAccountName on the generated ExtAuth
+	// Deployment. Use this to attach a ServiceAccount configured for AWS
+	// credentials, such as through IRSA or EKS Pod Identity, for AWS
+	// ElastiCache IAM authentication.
+	//
+	ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+}
+
+// ExtAuthConfigurationApplyConfiguration constructs a declarative configuration of the ExtAuthConfiguration type for use with
+// apply.
+func ExtAuthConfiguration() *ExtAuthConfigurationApplyConfiguration {
+	return &ExtAuthConfigurationApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithEnabled(value bool) *ExtAuthConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Enabled = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithResources(value *v1.ResourceRequirementsApplyConfiguration) *ExtAuthConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Resources = value
+	return b
+}
+
+// WithPodTemplate sets the PodTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodTemplate field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithPodTemplate(value *kgateway.PodApplyConfiguration) *ExtAuthConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.PodTemplate = value
+	return b
+}
+
+// WithContainer sets the Container field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Container field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithContainer(value *ContainerConfigurationApplyConfiguration) *ExtAuthConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Container = value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithReplicas(value int32) *ExtAuthConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Replicas = &value
+	return b
+}
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithStrategy(value *appsv1.DeploymentStrategyApplyConfiguration) *ExtAuthConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Strategy = value
+	return b
+}
+
+// WithDeploymentOverlay sets the DeploymentOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeploymentOverlay field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithDeploymentOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *ExtAuthConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.DeploymentOverlay = value
+	return b
+}
+
+// WithServiceOverlay sets the ServiceOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceOverlay field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithServiceOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *ExtAuthConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.ServiceOverlay = value
+	return b
+}
+
+// WithServiceAccountOverlay sets the ServiceAccountOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountOverlay field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithServiceAccountOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *ExtAuthConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.ServiceAccountOverlay = value
+	return b
+}
+
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithPodDisruptionBudget(value *shared.KubernetesResourceOverlayApplyConfiguration) *ExtAuthConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.PodDisruptionBudget = value
+	return b
+}
+
+// WithHorizontalPodAutoscaler sets the HorizontalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HorizontalPodAutoscaler field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithHorizontalPodAutoscaler(value *shared.KubernetesResourceOverlayApplyConfiguration) *ExtAuthConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.HorizontalPodAutoscaler = value
+	return b
+}
+
+// WithVerticalPodAutoscaler sets the VerticalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerticalPodAutoscaler field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithVerticalPodAutoscaler(value *shared.KubernetesResourceOverlayApplyConfiguration) *ExtAuthConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.VerticalPodAutoscaler = value
+	return b
+}
+
+// WithSessionRedis sets the SessionRedis field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SessionRedis field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithSessionRedis(value *RedisClientConfigApplyConfiguration) *ExtAuthConfigurationApplyConfiguration {
+	b.SessionRedis = value
+	return b
+}
+
+// WithServiceAccountName sets the ServiceAccountName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountName field is set to the value of the last call.
+func (b *ExtAuthConfigurationApplyConfiguration) WithServiceAccountName(value string) *ExtAuthConfigurationApplyConfiguration {
+	b.ServiceAccountName = &value
+	return b
+}
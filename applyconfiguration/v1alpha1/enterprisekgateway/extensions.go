@@ -0,0 +1,66 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// ExtensionsApplyConfiguration represents a declarative configuration of the Extensions type for use
+// with apply.
+type ExtensionsApplyConfiguration struct {
+	ExtAuth     *ExtAuthConfigurationApplyConfiguration     `json:"extauth,omitempty"`
+	RateLimiter *RateLimiterConfigurationApplyConfiguration `json:"ratelimiter,omitempty"`
+	ExtCache    *DeploymentConfigurationApplyConfiguration  `json:"extCache,omitempty"`
+	// WAF configures the WAF server.
+	WAF *WAFConfigurationApplyConfiguration `json:"waf,omitempty"`
+}
+
+// ExtensionsApplyConfiguration constructs a declarative configuration of the Extensions type for use with
+// apply.
+func Extensions() *ExtensionsApplyConfiguration {
+	return &ExtensionsApplyConfiguration{}
+}
+
+// WithExtAuth sets the ExtAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtAuth field is set to the value of the last call.
+func (b *ExtensionsApplyConfiguration) WithExtAuth(value *ExtAuthConfigurationApplyConfiguration) *ExtensionsApplyConfiguration {
+	b.ExtAuth = value
+	return b
+}
+
+// WithRateLimiter sets the RateLimiter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RateLimiter field is set to the value of the last call.
+func (b *ExtensionsApplyConfiguration) WithRateLimiter(value *RateLimiterConfigurationApplyConfiguration) *ExtensionsApplyConfiguration {
+	b.RateLimiter = value
+	return b
+}
+
+// WithExtCache sets the ExtCache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtCache field is set to the value of the last call.
+func (b *ExtensionsApplyConfiguration) WithExtCache(value *DeploymentConfigurationApplyConfiguration) *ExtensionsApplyConfiguration {
+	b.ExtCache = value
+	return b
+}
+
+// WithWAF sets the WAF field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WAF field is set to the value of the last call.
+func (b *ExtensionsApplyConfiguration) WithWAF(value *WAFConfigurationApplyConfiguration) *ExtensionsApplyConfiguration {
+	b.WAF = value
+	return b
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// ExtractionApplyConfiguration represents a declarative configuration of the Extraction type for use
+// with apply.
+//
+// Extraction is used to define extractions to extract information from the request/response.
+// The extracted information can then be referenced in template fields.
+type ExtractionApplyConfiguration struct {
+	// ExtractionBody specifies extracting information from the request/response body.
+	ExtractionBody *bool `json:"body,omitempty"`
+	// ExtractionHeader specifies extracting information from headers.
+	ExtractionHeader *string `json:"header,omitempty"`
+	// Regex specifies the regular expression used for matching against the source content.
+	// - In Extract mode, the entire source must match the regex. `subgroup` selects the n-th capturing group,
+	// which determines the part of the match that you want to extract. If the regex does not match the source,
+	// the result of the extraction will be an empty value.
+	// - In SingleReplace mode, the regex also needs to match the entire source. `subgroup` selects the n-th capturing group
+	// that is replaced with the content of `replacementText`. If the regex does not match the source, the result
+	// of the replacement will be the source itself.
+	// - In ReplaceAll mode, the regex is applied repeatedly to find all occurrences within the source that match.
+	// Each matching occurrence is replaced with the value in `replacementText`. In this mode, the configuration is rejected
+	// if `subgroup` is set. If the regex does not match the source, the result of the replacement will be the source itself.
+	Regex *string `json:"regex,omitempty"`
+	// Subgroup is used to determine the group that you want to select if your regex contains capturing groups. Defaults to 0.
+	// If set in `Extract` and `SingleReplace` modes, the subgroup represents the capturing
+	// group that you want to extract or replace in the source.
+	// The configuration is rejected if you set subgroup to a non-zero value when using the `REPLACE_ALL` mode.
+	Subgroup *int32 `json:"subgroup,omitempty"`
+	// ReplacementText is used to format the substitution for matched sequences in
+	// an input string. This value is only legal in `SingleReplace` and `REPLACE_ALL` modes.
+	// - In `SingleReplace` mode, the `subgroup` selects the n-th capturing group, which represents
+	// the value that you want to replace with the string provided in `replacementText`.
+	// - In `REPLACE_ALL` mode, each sequence that matches the specified regex in the input is
+	// replaced with the value in`replacementText`.
+	//
+	// The `replacementText` can include special syntax, such as $1, $2, etc., to refer to
+	//
+	// capturing groups within the regular expression.
+	//
+	// The value that is specified in `replacementText` is treated as a string, and is passed
+	//
+	// to `std::regex_replace` as the replacement string.
+	//
+	// For more information, see https://en.cppreference.com/w/cpp/regex/regex_replace.
+	ReplacementText *string `json:"replacementText,omitempty"`
+	// Mode defines the mode of operation for the extraction.
+	// Defaults to Extract.
+	Mode *v1alpha1enterprisekgateway.TransformationExtractMode `json:"mode,omitempty"`
+}
+
+// ExtractionApplyConfiguration constructs a declarative configuration of the Extraction type for use with
+// apply.
+func Extraction() *ExtractionApplyConfiguration {
+	return &ExtractionApplyConfiguration{}
+}
+
+// WithExtractionBody sets the ExtractionBody field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtractionBody field is set to the value of the last call.
+func (b *ExtractionApplyConfiguration) WithExtractionBody(value bool) *ExtractionApplyConfiguration {
+	b.ExtractionBody = &value
+	return b
+}
+
+// WithExtractionHeader sets the ExtractionHeader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtractionHeader field is set to the value of the last call.
+func (b *ExtractionApplyConfiguration) WithExtractionHeader(value string) *ExtractionApplyConfiguration {
+	b.ExtractionHeader = &value
+	return b
+}
+
+// WithRegex sets the Regex field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Regex field is set to the value of the last call.
+func (b *ExtractionApplyConfiguration) WithRegex(value string) *ExtractionApplyConfiguration {
+	b.Regex = &value
+	return b
+}
+
+// WithSubgroup sets the Subgroup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Subgroup field is set to the value of the last call.
+func (b *ExtractionApplyConfiguration) WithSubgroup(value int32) *ExtractionApplyConfiguration {
+	b.Subgroup = &value
+	return b
+}
+
+// WithReplacementText sets the ReplacementText field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplacementText field is set to the value of the last call.
+func (b *ExtractionApplyConfiguration) WithReplacementText(value string) *ExtractionApplyConfiguration {
+	b.ReplacementText = &value
+	return b
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *ExtractionApplyConfiguration) WithMode(value v1alpha1enterprisekgateway.TransformationExtractMode) *ExtractionApplyConfiguration {
+	b.Mode = &value
+	return b
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+	v1alpha1shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/shared"
+)
+
+// GlobalRateLimitApplyConfiguration represents a declarative configuration of the GlobalRateLimit type for use
+// with apply.
+type GlobalRateLimitApplyConfiguration struct {
+	// ExtensionRef references a GatewayExtension that provides the global rate limit service.
+	// If not set, defaults to the rate limit service named 'rate-limit' in the same namespace as
+	// the Solo Enterprise for kgateway control plane. In this case no reference grant is required.
+	ExtensionRef *shared.NamespacedObjectReferenceApplyConfiguration `json:"extensionRef,omitempty"`
+	// RateLimitConfigRefs is a list of references to the RateLimitConfig resources containing the
+	// rate limit configurations.
+	RateLimitConfigRefs []v1alpha1shared.RateLimitConfigRefApplyConfiguration `json:"rateLimitConfigRefs,omitempty"`
+}
+
+// GlobalRateLimitApplyConfiguration constructs a declarative configuration of the GlobalRateLimit type for use with
+// apply.
+func GlobalRateLimit() *GlobalRateLimitApplyConfiguration {
+	return &GlobalRateLimitApplyConfiguration{}
+}
+
+// WithExtensionRef sets the ExtensionRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtensionRef field is set to the value of the last call.
+func (b *GlobalRateLimitApplyConfiguration) WithExtensionRef(value *shared.NamespacedObjectReferenceApplyConfiguration) *GlobalRateLimitApplyConfiguration {
+	b.ExtensionRef = value
+	return b
+}
+
+// WithRateLimitConfigRefs adds the given value to the RateLimitConfigRefs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RateLimitConfigRefs field.
+func (b *GlobalRateLimitApplyConfiguration) WithRateLimitConfigRefs(values ...*v1alpha1shared.RateLimitConfigRefApplyConfiguration) *GlobalRateLimitApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRateLimitConfigRefs")
+		}
+		b.RateLimitConfigRefs = append(b.RateLimitConfigRefs, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// HeaderBodyTransformApplyConfiguration represents a declarative configuration of the HeaderBodyTransform type for use
+// with apply.
+//
+// HeaderBodyTransform defines a header and body transformation.
+type HeaderBodyTransformApplyConfiguration struct {
+	// AddRequestMetadata determines whether to add request metadata to the body.
+	// When transforming a request, setting this to true will additionally add "queryString",
+	// "queryStringParameters", "multiValueQueryStringParameters", "httpMethod", "path",
+	// and "multiValueHeaders" to the body.
+	AddRequestMetadata *bool `json:"addRequestMetadata,omitempty"`
+}
+
+// HeaderBodyTransformApplyConfiguration constructs a declarative configuration of the HeaderBodyTransform type for use with
+// apply.
+func HeaderBodyTransform() *HeaderBodyTransformApplyConfiguration {
+	return &HeaderBodyTransformApplyConfiguration{}
+}
+
+// WithAddRequestMetadata sets the AddRequestMetadata field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AddRequestMetadata field is set to the value of the last call.
+func (b *HeaderBodyTransformApplyConfiguration) WithAddRequestMetadata(value bool) *HeaderBodyTransformApplyConfiguration {
+	b.AddRequestMetadata = &value
+	return b
+}
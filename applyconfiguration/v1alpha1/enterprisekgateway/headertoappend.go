@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// HeaderToAppendApplyConfiguration represents a declarative configuration of the HeaderToAppend type for use
+// with apply.
+//
+// HeaderToAppend defines a header-template pair for appending headers.
+type HeaderToAppendApplyConfiguration struct {
+	// Key specifies the header name.
+	Key *string `json:"key,omitempty"`
+	// Value specifies the template to apply to the header value.
+	Value *v1alpha1enterprisekgateway.InjaTemplate `json:"value,omitempty"`
+}
+
+// HeaderToAppendApplyConfiguration constructs a declarative configuration of the HeaderToAppend type for use with
+// apply.
+func HeaderToAppend() *HeaderToAppendApplyConfiguration {
+	return &HeaderToAppendApplyConfiguration{}
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *HeaderToAppendApplyConfiguration) WithKey(value string) *HeaderToAppendApplyConfiguration {
+	b.Key = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *HeaderToAppendApplyConfiguration) WithValue(value v1alpha1enterprisekgateway.InjaTemplate) *HeaderToAppendApplyConfiguration {
+	b.Value = &value
+	return b
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// JWKSApplyConfiguration represents a declarative configuration of the JWKS type for use
+// with apply.
+//
+// JWKS (JSON Web Key Set) configures how to fetch the public key used for JWT verification.
+type JWKSApplyConfiguration struct {
+	// Local is used when JWKS is local to the proxy, such as an inline string definition.
+	Local *LocalJWKSApplyConfiguration `json:"local,omitempty"`
+	// Remote is used when the JWKS should be fetched from a remote host
+	Remote *RemoteJWKSApplyConfiguration `json:"remote,omitempty"`
+}
+
+// JWKSApplyConfiguration constructs a declarative configuration of the JWKS type for use with
+// apply.
+func JWKS() *JWKSApplyConfiguration {
+	return &JWKSApplyConfiguration{}
+}
+
+// WithLocal sets the Local field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Local field is set to the value of the last call.
+func (b *JWKSApplyConfiguration) WithLocal(value *LocalJWKSApplyConfiguration) *JWKSApplyConfiguration {
+	b.Local = value
+	return b
+}
+
+// WithRemote sets the Remote field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Remote field is set to the value of the last call.
+func (b *JWKSApplyConfiguration) WithRemote(value *RemoteJWKSApplyConfiguration) *JWKSApplyConfiguration {
+	b.Remote = value
+	return b
+}
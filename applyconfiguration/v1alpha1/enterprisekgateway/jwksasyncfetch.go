@@ -0,0 +1,47 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// JwksAsyncFetchApplyConfiguration represents a declarative configuration of the JwksAsyncFetch type for use
+// with apply.
+//
+// Fetch Jwks asynchronously in the main thread when the filter config is parsed.
+// The listener is activated only after the Jwks is fetched.
+// When the Jwks is expired in the cache, it is fetched again in the main thread.
+// The fetched Jwks from the main thread can be used by all worker threads.
+type JwksAsyncFetchApplyConfiguration struct {
+	// If false, the listener is activated after the initial fetch is completed.
+	// The initial fetch result can be either successful or failed.
+	// If true, it is activated without waiting for the initial fetch to complete.
+	// Default is false.
+	FastListener *bool `json:"fastListener,omitempty"`
+}
+
+// JwksAsyncFetchApplyConfiguration constructs a declarative configuration of the JwksAsyncFetch type for use with
+// apply.
+func JwksAsyncFetch() *JwksAsyncFetchApplyConfiguration {
+	return &JwksAsyncFetchApplyConfiguration{}
+}
+
+// WithFastListener sets the FastListener field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FastListener field is set to the value of the last call.
+func (b *JwksAsyncFetchApplyConfiguration) WithFastListener(value bool) *JwksAsyncFetchApplyConfiguration {
+	b.FastListener = &value
+	return b
+}
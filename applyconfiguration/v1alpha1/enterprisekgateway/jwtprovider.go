@@ -0,0 +1,123 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// JWTProviderApplyConfiguration represents a declarative configuration of the JWTProvider type for use
+// with apply.
+//
+// JWTProvider defines configuration for how a JWT should be authenticated and verified.
+type JWTProviderApplyConfiguration struct {
+	// The source for the keys to validate JWTs.
+	JWKS *JWKSApplyConfiguration `json:"jwks,omitempty"`
+	// An incoming JWT must have an 'aud' claim and it must be in this list.
+	Audiences []string `json:"audiences,omitempty"`
+	// Issuer of the JWT. the 'iss' claim of the JWT must match this.
+	Issuer *string `json:"issuer,omitempty"`
+	// Where to find the JWT of the current provider.
+	TokenSource *TokenSourceApplyConfiguration `json:"tokenSource,omitempty"`
+	// Should the token forwarded upstream. If false, the header containing the token will be removed.
+	// If omitted, the default behavior is to remove the token and not forward
+	KeepToken *bool `json:"keepToken,omitempty"`
+	// What claims should be copied to upstream headers.
+	ClaimsToHeaders []ClaimToHeaderApplyConfiguration `json:"claimsToHeaders,omitempty"`
+	// Used to verify time constraints, such as `exp` and `npf`. If omitted, defaults to 60s
+	ClockSkewSeconds *int32 `json:"clockSkewSeconds,omitempty"`
+	// When this field is set, the specified value is used as the key in DynamicMetadata to store the JWT failure status code
+	// and message under that key. This field is particularly useful when logging the failure status.
+	// For example, if the value of `attach_failed_status_to_metadata` is 'custom_auth_failure_status' then
+	// the failure status can be accessed in the access log as '%DYNAMIC_METADATA(envoy.filters.http.jwt_authn:custom_auth_failure_status)'
+	// Note: status code and message can be individually accessed as '%DYNAMIC_METADATA(envoy.filters.http.jwt_authn:custom_auth_failure_status.code)' and '%DYNAMIC_METADATA(envoy.filters.http.jwt_authn:custom_auth_failure_status.message)' respectively.
+	AttachFailedStatusToMetadata *string `json:"attachFailedStatusToMetadata,omitempty"`
+}
+
+// JWTProviderApplyConfiguration constructs a declarative configuration of the JWTProvider type for use with
+// apply.
+func JWTProvider() *JWTProviderApplyConfiguration {
+	return &JWTProviderApplyConfiguration{}
+}
+
+// WithJWKS sets the JWKS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JWKS field is set to the value of the last call.
+func (b *JWTProviderApplyConfiguration) WithJWKS(value *JWKSApplyConfiguration) *JWTProviderApplyConfiguration {
+	b.JWKS = value
+	return b
+}
+
+// WithAudiences adds the given value to the Audiences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Audiences field.
+func (b *JWTProviderApplyConfiguration) WithAudiences(values ...string) *JWTProviderApplyConfiguration {
+	for i := range values {
+		b.Audiences = append(b.Audiences, values[i])
+	}
+	return b
+}
+
+// WithIssuer sets the Issuer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Issuer field is set to the value of the last call.
+func (b *JWTProviderApplyConfiguration) WithIssuer(value string) *JWTProviderApplyConfiguration {
+	b.Issuer = &value
+	return b
+}
+
+// WithTokenSource sets the TokenSource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenSource field is set to the value of the last call.
+func (b *JWTProviderApplyConfiguration) WithTokenSource(value *TokenSourceApplyConfiguration) *JWTProviderApplyConfiguration {
+	b.TokenSource = value
+	return b
+}
+
+// WithKeepToken sets the KeepToken field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KeepToken field is set to the value of the last call.
+func (b *JWTProviderApplyConfiguration) WithKeepToken(value bool) *JWTProviderApplyConfiguration {
+	b.KeepToken = &value
+	return b
+}
+
+// WithClaimsToHeaders adds the given value to the ClaimsToHeaders field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ClaimsToHeaders field.
+func (b *JWTProviderApplyConfiguration) WithClaimsToHeaders(values ...*ClaimToHeaderApplyConfiguration) *JWTProviderApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithClaimsToHeaders")
+		}
+		b.ClaimsToHeaders = append(b.ClaimsToHeaders, *values[i])
+	}
+	return b
+}
+
+// WithClockSkewSeconds sets the ClockSkewSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClockSkewSeconds field is set to the value of the last call.
+func (b *JWTProviderApplyConfiguration) WithClockSkewSeconds(value int32) *JWTProviderApplyConfiguration {
+	b.ClockSkewSeconds = &value
+	return b
+}
+
+// WithAttachFailedStatusToMetadata sets the AttachFailedStatusToMetadata field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AttachFailedStatusToMetadata field is set to the value of the last call.
+func (b *JWTProviderApplyConfiguration) WithAttachFailedStatusToMetadata(value string) *JWTProviderApplyConfiguration {
+	b.AttachFailedStatusToMetadata = &value
+	return b
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// LocalJWKSApplyConfiguration represents a declarative configuration of the LocalJWKS type for use
+// with apply.
+//
+// LocalJWKS contains configuration for JWKS that are locally available to the proxy
+type LocalJWKSApplyConfiguration struct {
+	// Inline key. this can be json web key, key-set or PEM format.
+	Key *string `json:"key,omitempty"`
+}
+
+// LocalJWKSApplyConfiguration constructs a declarative configuration of the LocalJWKS type for use with
+// apply.
+func LocalJWKS() *LocalJWKSApplyConfiguration {
+	return &LocalJWKSApplyConfiguration{}
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *LocalJWKSApplyConfiguration) WithKey(value string) *LocalJWKSApplyConfiguration {
+	b.Key = &value
+	return b
+}
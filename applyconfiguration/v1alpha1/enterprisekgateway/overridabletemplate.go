@@ -0,0 +1,53 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// OverridableTemplateApplyConfiguration represents a declarative configuration of the OverridableTemplate type for use
+// with apply.
+type OverridableTemplateApplyConfiguration struct {
+	// Template to render
+	Tmpl *v1alpha1enterprisekgateway.InjaTemplate `json:"tmpl,omitempty"`
+	// If set to true, the template will be set even if the rendered value is empty.
+	OverrideEmpty *bool `json:"overrideEmpty,omitempty"`
+}
+
+// OverridableTemplateApplyConfiguration constructs a declarative configuration of the OverridableTemplate type for use with
+// apply.
+func OverridableTemplate() *OverridableTemplateApplyConfiguration {
+	return &OverridableTemplateApplyConfiguration{}
+}
+
+// WithTmpl sets the Tmpl field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tmpl field is set to the value of the last call.
+func (b *OverridableTemplateApplyConfiguration) WithTmpl(value v1alpha1enterprisekgateway.InjaTemplate) *OverridableTemplateApplyConfiguration {
+	b.Tmpl = &value
+	return b
+}
+
+// WithOverrideEmpty sets the OverrideEmpty field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OverrideEmpty field is set to the value of the last call.
+func (b *OverridableTemplateApplyConfiguration) WithOverrideEmpty(value bool) *OverridableTemplateApplyConfiguration {
+	b.OverrideEmpty = &value
+	return b
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// QueryParameterMatcherApplyConfiguration represents a declarative configuration of the QueryParameterMatcher type for use
+// with apply.
+//
+// QueryParameterMatcher configures the query parameter matching to apply.
+type QueryParameterMatcherApplyConfiguration struct {
+	// Specifies the name of a key that must be present in the requested
+	// *path*'s query string.
+	Name *string `json:"name,omitempty"`
+	// Specifies the value of the key. If the value is absent, a request
+	// that contains the key in its query string will match, whether the
+	// key appears with a value (e.g., "?debug=true") or not (e.g., "?debug")
+	Value *string `json:"value,omitempty"`
+	// Specifies whether the query parameter value is a regular expression.
+	// Defaults to false. The entire query parameter value (i.e., the part to
+	// the right of the equals sign in "key=value") must match the regex.
+	// E.g., the regex "\d+$" will match "123" but not "a123" or "123a".
+	Regex *bool `json:"regex,omitempty"`
+}
+
+// QueryParameterMatcherApplyConfiguration constructs a declarative configuration of the QueryParameterMatcher type for use with
+// apply.
+func QueryParameterMatcher() *QueryParameterMatcherApplyConfiguration {
+	return &QueryParameterMatcherApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *QueryParameterMatcherApplyConfiguration) WithName(value string) *QueryParameterMatcherApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *QueryParameterMatcherApplyConfiguration) WithValue(value string) *QueryParameterMatcherApplyConfiguration {
+	b.Value = &value
+	return b
+}
+
+// WithRegex sets the Regex field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Regex field is set to the value of the last call.
+func (b *QueryParameterMatcherApplyConfiguration) WithRegex(value bool) *QueryParameterMatcherApplyConfiguration {
+	b.Regex = &value
+	return b
+}
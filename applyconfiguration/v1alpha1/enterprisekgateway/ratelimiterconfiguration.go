@@ -0,0 +1,162 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	kgateway "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/kgateway"
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+	appsv1 "k8s.io/client-go/applyconfigurations/apps/v1"
+	v1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// RateLimiterConfigurationApplyConfiguration represents a declarative configuration of the RateLimiterConfiguration type for use
+// with apply.
+//
+// RateLimiterConfiguration configures the RateLimit server deployment.
+type RateLimiterConfigurationApplyConfiguration struct {
+	DeploymentConfigurationApplyConfiguration `json:",inline"`
+	// Redis configures the Redis connection for the RateLimit server.
+	// When specified, the RateLimit server connects to this Redis instance
+	// instead of the managed ext-cache Redis.
+	//
+	Redis *RedisClientConfigApplyConfiguration `json:"redis,omitempty"`
+	// ServiceAccountName sets the serviceAccountName on the generated RateLimit
+	// Deployment. Use this to attach a ServiceAccount configured for AWS
+	// credentials, such as through IRSA or EKS Pod Identity, for AWS
+	// ElastiCache IAM authentication.
+	//
+	ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+}
+
+// RateLimiterConfigurationApplyConfiguration constructs a declarative configuration of the RateLimiterConfiguration type for use with
+// apply.
+func RateLimiterConfiguration() *RateLimiterConfigurationApplyConfiguration {
+	return &RateLimiterConfigurationApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithEnabled(value bool) *RateLimiterConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Enabled = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithResources(value *v1.ResourceRequirementsApplyConfiguration) *RateLimiterConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Resources = value
+	return b
+}
+
+// WithPodTemplate sets the PodTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodTemplate field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithPodTemplate(value *kgateway.PodApplyConfiguration) *RateLimiterConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.PodTemplate = value
+	return b
+}
+
+// WithContainer sets the Container field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Container field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithContainer(value *ContainerConfigurationApplyConfiguration) *RateLimiterConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Container = value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithReplicas(value int32) *RateLimiterConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Replicas = &value
+	return b
+}
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithStrategy(value *appsv1.DeploymentStrategyApplyConfiguration) *RateLimiterConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Strategy = value
+	return b
+}
+
+// WithDeploymentOverlay sets the DeploymentOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeploymentOverlay field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithDeploymentOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *RateLimiterConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.DeploymentOverlay = value
+	return b
+}
+
+// WithServiceOverlay sets the ServiceOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceOverlay field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithServiceOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *RateLimiterConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.ServiceOverlay = value
+	return b
+}
+
+// WithServiceAccountOverlay sets the ServiceAccountOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountOverlay field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithServiceAccountOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *RateLimiterConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.ServiceAccountOverlay = value
+	return b
+}
+
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithPodDisruptionBudget(value *shared.KubernetesResourceOverlayApplyConfiguration) *RateLimiterConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.PodDisruptionBudget = value
+	return b
+}
+
+// WithHorizontalPodAutoscaler sets the HorizontalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HorizontalPodAutoscaler field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithHorizontalPodAutoscaler(value *shared.KubernetesResourceOverlayApplyConfiguration) *RateLimiterConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.HorizontalPodAutoscaler = value
+	return b
+}
+
+// WithVerticalPodAutoscaler sets the VerticalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerticalPodAutoscaler field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithVerticalPodAutoscaler(value *shared.KubernetesResourceOverlayApplyConfiguration) *RateLimiterConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.VerticalPodAutoscaler = value
+	return b
+}
+
+// WithRedis sets the Redis field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Redis field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithRedis(value *RedisClientConfigApplyConfiguration) *RateLimiterConfigurationApplyConfiguration {
+	b.Redis = value
+	return b
+}
+
+// WithServiceAccountName sets the ServiceAccountName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountName field is set to the value of the last call.
+func (b *RateLimiterConfigurationApplyConfiguration) WithServiceAccountName(value string) *RateLimiterConfigurationApplyConfiguration {
+	b.ServiceAccountName = &value
+	return b
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// RBACJWTPrincipalApplyConfiguration represents a declarative configuration of the RBACJWTPrincipal type for use
+// with apply.
+//
+// A JWT principal. To use this, JWT authentication MUST be configured as well.
+type RBACJWTPrincipalApplyConfiguration struct {
+	// Set of claims that make up this principal. Commonly, the 'iss' and 'sub' or 'email' claims are used.
+	// If you specify the path for a nested claim, such as 'parent.child.foo', you must also specify
+	// a non-empty string value for the `nested_claim_delimiter` field in the Policy.
+	Claims map[string]string `json:"claims,omitempty"`
+	// Verify that the JWT came from a specific provider. This usually can be left empty
+	// and a provider will be chosen automatically.
+	Provider *string `json:"provider,omitempty"`
+	// The matcher to use when evaluating this principal. If omitted, exact string comparison (ExactString) is used.
+	Matcher *v1alpha1enterprisekgateway.RBACJWTPrincipalClaimMatcher `json:"matcher,omitempty"`
+}
+
+// RBACJWTPrincipalApplyConfiguration constructs a declarative configuration of the RBACJWTPrincipal type for use with
+// apply.
+func RBACJWTPrincipal() *RBACJWTPrincipalApplyConfiguration {
+	return &RBACJWTPrincipalApplyConfiguration{}
+}
+
+// WithClaims puts the entries into the Claims field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Claims field,
+// overwriting an existing map entries in Claims field with the same key.
+func (b *RBACJWTPrincipalApplyConfiguration) WithClaims(entries map[string]string) *RBACJWTPrincipalApplyConfiguration {
+	if b.Claims == nil && len(entries) > 0 {
+		b.Claims = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Claims[k] = v
+	}
+	return b
+}
+
+// WithProvider sets the Provider field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provider field is set to the value of the last call.
+func (b *RBACJWTPrincipalApplyConfiguration) WithProvider(value string) *RBACJWTPrincipalApplyConfiguration {
+	b.Provider = &value
+	return b
+}
+
+// WithMatcher sets the Matcher field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Matcher field is set to the value of the last call.
+func (b *RBACJWTPrincipalApplyConfiguration) WithMatcher(value v1alpha1enterprisekgateway.RBACJWTPrincipalClaimMatcher) *RBACJWTPrincipalApplyConfiguration {
+	b.Matcher = &value
+	return b
+}
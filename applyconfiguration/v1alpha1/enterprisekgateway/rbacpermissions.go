@@ -0,0 +1,54 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// RBACPermissionsApplyConfiguration represents a declarative configuration of the RBACPermissions type for use
+// with apply.
+//
+// What permissions should be granted. An empty field means allow-all.
+// If more than one field is added, all of them need to match.
+type RBACPermissionsApplyConfiguration struct {
+	// Paths that have this prefix will be allowed.
+	PathPrefix *string `json:"pathPrefix,omitempty"`
+	// What http methods (GET, POST, ...) are allowed.
+	Methods []string `json:"methods,omitempty"`
+}
+
+// RBACPermissionsApplyConfiguration constructs a declarative configuration of the RBACPermissions type for use with
+// apply.
+func RBACPermissions() *RBACPermissionsApplyConfiguration {
+	return &RBACPermissionsApplyConfiguration{}
+}
+
+// WithPathPrefix sets the PathPrefix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PathPrefix field is set to the value of the last call.
+func (b *RBACPermissionsApplyConfiguration) WithPathPrefix(value string) *RBACPermissionsApplyConfiguration {
+	b.PathPrefix = &value
+	return b
+}
+
+// WithMethods adds the given value to the Methods field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Methods field.
+func (b *RBACPermissionsApplyConfiguration) WithMethods(values ...string) *RBACPermissionsApplyConfiguration {
+	for i := range values {
+		b.Methods = append(b.Methods, values[i])
+	}
+	return b
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// RBACPolicyApplyConfiguration represents a declarative configuration of the RBACPolicy type for use
+// with apply.
+type RBACPolicyApplyConfiguration struct {
+	// Principals in this policy.
+	Principals []RBACPrincipalApplyConfiguration `json:"principals,omitempty"`
+	// Permissions granted to the principals.
+	Permissions *RBACPermissionsApplyConfiguration `json:"permissions,omitempty"`
+	// The delimiter to use when specifying nested claim names within principals.
+	// Default is an empty string, which disables nested claim functionality.
+	// This is commonly set to `.`, allowing for nested claim names of the form
+	// `parent.child.grandchild`
+	NestedClaimDelimiter *string `json:"nestedClaimDelimiter,omitempty"`
+}
+
+// RBACPolicyApplyConfiguration constructs a declarative configuration of the RBACPolicy type for use with
+// apply.
+func RBACPolicy() *RBACPolicyApplyConfiguration {
+	return &RBACPolicyApplyConfiguration{}
+}
+
+// WithPrincipals adds the given value to the Principals field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Principals field.
+func (b *RBACPolicyApplyConfiguration) WithPrincipals(values ...*RBACPrincipalApplyConfiguration) *RBACPolicyApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPrincipals")
+		}
+		b.Principals = append(b.Principals, *values[i])
+	}
+	return b
+}
+
+// WithPermissions sets the Permissions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Permissions field is set to the value of the last call.
+func (b *RBACPolicyApplyConfiguration) WithPermissions(value *RBACPermissionsApplyConfiguration) *RBACPolicyApplyConfiguration {
+	b.Permissions = value
+	return b
+}
+
+// WithNestedClaimDelimiter sets the NestedClaimDelimiter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NestedClaimDelimiter field is set to the value of the last call.
+func (b *RBACPolicyApplyConfiguration) WithNestedClaimDelimiter(value string) *RBACPolicyApplyConfiguration {
+	b.NestedClaimDelimiter = &value
+	return b
+}
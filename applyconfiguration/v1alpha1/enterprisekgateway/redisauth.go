@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// RedisAuthApplyConfiguration represents a declarative configuration of the RedisAuth type for use
+// with apply.
+//
+// RedisAuth configures authentication for a Redis connection.
+// This is a discriminated union: specify exactly one of secretRef or aws.
+type RedisAuthApplyConfiguration struct {
+	// SecretRef configures static credential authentication using a Kubernetes Secret
+	// containing username and password.
+	//
+	SecretRef *RedisSecretAuthApplyConfiguration `json:"secretRef,omitempty"`
+	// AWS configures AWS ElastiCache IAM authentication.
+	// No static credentials are stored; tokens are generated at runtime
+	// using AWS credentials available to the pod.
+	//
+	AWS *RedisAWSAuthApplyConfiguration `json:"aws,omitempty"`
+}
+
+// RedisAuthApplyConfiguration constructs a declarative configuration of the RedisAuth type for use with
+// apply.
+func RedisAuth() *RedisAuthApplyConfiguration {
+	return &RedisAuthApplyConfiguration{}
+}
+
+// WithSecretRef sets the SecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretRef field is set to the value of the last call.
+func (b *RedisAuthApplyConfiguration) WithSecretRef(value *RedisSecretAuthApplyConfiguration) *RedisAuthApplyConfiguration {
+	b.SecretRef = value
+	return b
+}
+
+// WithAWS sets the AWS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AWS field is set to the value of the last call.
+func (b *RedisAuthApplyConfiguration) WithAWS(value *RedisAWSAuthApplyConfiguration) *RedisAuthApplyConfiguration {
+	b.AWS = value
+	return b
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// RedisAWSAuthApplyConfiguration represents a declarative configuration of the RedisAWSAuth type for use
+// with apply.
+//
+// RedisAWSAuth configures AWS ElastiCache IAM authentication.
+// Requires the pod to have AWS credentials, such as through IRSA or EKS Pod Identity.
+type RedisAWSAuthApplyConfiguration struct {
+	// Region is the AWS region of the ElastiCache cluster.
+	//
+	Region *string `json:"region,omitempty"`
+	// ClusterName is the ElastiCache replication group ID.
+	//
+	ClusterName *string `json:"clusterName,omitempty"`
+	// UserName is the ElastiCache user ID with IAM authentication enabled.
+	//
+	UserName *string `json:"userName,omitempty"`
+	// ServerlessCacheName is the AWS ElastiCache Serverless cache name used in
+	// the IAM token signature. Set this for Serverless ElastiCache deployments;
+	// the value is distinct from Address (which remains the Redis endpoint
+	// host:port). Leave unset for provisioned ElastiCache clusters.
+	//
+	ServerlessCacheName *string `json:"serverlessCacheName,omitempty"`
+}
+
+// RedisAWSAuthApplyConfiguration constructs a declarative configuration of the RedisAWSAuth type for use with
+// apply.
+func RedisAWSAuth() *RedisAWSAuthApplyConfiguration {
+	return &RedisAWSAuthApplyConfiguration{}
+}
+
+// WithRegion sets the Region field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Region field is set to the value of the last call.
+func (b *RedisAWSAuthApplyConfiguration) WithRegion(value string) *RedisAWSAuthApplyConfiguration {
+	b.Region = &value
+	return b
+}
+
+// WithClusterName sets the ClusterName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterName field is set to the value of the last call.
+func (b *RedisAWSAuthApplyConfiguration) WithClusterName(value string) *RedisAWSAuthApplyConfiguration {
+	b.ClusterName = &value
+	return b
+}
+
+// WithUserName sets the UserName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UserName field is set to the value of the last call.
+func (b *RedisAWSAuthApplyConfiguration) WithUserName(value string) *RedisAWSAuthApplyConfiguration {
+	b.UserName = &value
+	return b
+}
+
+// WithServerlessCacheName sets the ServerlessCacheName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServerlessCacheName field is set to the value of the last call.
+func (b *RedisAWSAuthApplyConfiguration) WithServerlessCacheName(value string) *RedisAWSAuthApplyConfiguration {
+	b.ServerlessCacheName = &value
+	return b
+}
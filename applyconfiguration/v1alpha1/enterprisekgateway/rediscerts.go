@@ -0,0 +1,59 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// RedisCertsApplyConfiguration represents a declarative configuration of the RedisCerts type for use
+// with apply.
+//
+// RedisCerts configures TLS certificates for the Redis connection.
+type RedisCertsApplyConfiguration struct {
+	// CACertSecretRef references a Kubernetes Secret containing the CA certificate
+	// for verifying the Redis server's TLS certificate.
+	//
+	CACertSecretRef *v1.SecretReferenceApplyConfiguration `json:"caCertSecretRef,omitempty"`
+	// CACertKey is the key within the Secret that contains the CA certificate in PEM format.
+	// Defaults to "ca.crt" if not specified.
+	//
+	CACertKey *string `json:"caCertKey,omitempty"`
+}
+
+// RedisCertsApplyConfiguration constructs a declarative configuration of the RedisCerts type for use with
+// apply.
+func RedisCerts() *RedisCertsApplyConfiguration {
+	return &RedisCertsApplyConfiguration{}
+}
+
+// WithCACertSecretRef sets the CACertSecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CACertSecretRef field is set to the value of the last call.
+func (b *RedisCertsApplyConfiguration) WithCACertSecretRef(value *v1.SecretReferenceApplyConfiguration) *RedisCertsApplyConfiguration {
+	b.CACertSecretRef = value
+	return b
+}
+
+// WithCACertKey sets the CACertKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CACertKey field is set to the value of the last call.
+func (b *RedisCertsApplyConfiguration) WithCACertKey(value string) *RedisCertsApplyConfiguration {
+	b.CACertKey = &value
+	return b
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// RedisClientConfigApplyConfiguration represents a declarative configuration of the RedisClientConfig type for use
+// with apply.
+//
+// RedisClientConfig is a reusable Redis connection configuration structure
+// shared by both the RateLimit and ExtAuth sections of EnterpriseKgatewayParameters.
+type RedisClientConfigApplyConfiguration struct {
+	// Address is the Redis server address in "host:port" format, or a Unix
+	// socket path when socketType is "unix".
+	// Examples: "redis.example.com:6379", "my-redis.default.svc.cluster.local:6379", "/var/run/redis/redis.sock"
+	//
+	Address *string `json:"address,omitempty"`
+	// DB is the Redis database index.
+	// Defaults to 0 if not specified.
+	//
+	DB *int32 `json:"db,omitempty"`
+	// SocketType specifies the connection type: "tcp", "tls", or "unix".
+	// Defaults to "tcp" if not specified.
+	//
+	SocketType *string `json:"socketType,omitempty"`
+	// Clustered enables Redis Cluster mode.
+	// When true, the client uses a ClusterClient that handles MOVED/ASK redirects.
+	//
+	Clustered *bool `json:"clustered,omitempty"`
+	// Certs configures TLS certificates for the Redis connection.
+	// Only applicable when SocketType is "tls".
+	//
+	Certs *RedisCertsApplyConfiguration `json:"certs,omitempty"`
+	// Auth configures authentication for the Redis connection.
+	// Exactly one of secretRef or aws should be specified.
+	// If not specified, no authentication is used.
+	//
+	Auth *RedisAuthApplyConfiguration `json:"auth,omitempty"`
+	// Connection configures connection pool and timeout tuning parameters.
+	// When not specified, Redis client library defaults apply.
+	//
+	Connection *RedisConnectionConfigApplyConfiguration `json:"connection,omitempty"`
+}
+
+// RedisClientConfigApplyConfiguration constructs a declarative configuration of the RedisClientConfig type for use with
+// apply.
+func RedisClientConfig() *RedisClientConfigApplyConfiguration {
+	return &RedisClientConfigApplyConfiguration{}
+}
+
+// WithAddress sets the Address field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Address field is set to the value of the last call.
+func (b *RedisClientConfigApplyConfiguration) WithAddress(value string) *RedisClientConfigApplyConfiguration {
+	b.Address = &value
+	return b
+}
+
+// WithDB sets the DB field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DB field is set to the value of the last call.
+func (b *RedisClientConfigApplyConfiguration) WithDB(value int32) *RedisClientConfigApplyConfiguration {
+	b.DB = &value
+	return b
+}
+
+// WithSocketType sets the SocketType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SocketType field is set to the value of the last call.
+func (b *RedisClientConfigApplyConfiguration) WithSocketType(value string) *RedisClientConfigApplyConfiguration {
+	b.SocketType = &value
+	return b
+}
+
+// WithClustered sets the Clustered field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Clustered field is set to the value of the last call.
+func (b *RedisClientConfigApplyConfiguration) WithClustered(value bool) *RedisClientConfigApplyConfiguration {
+	b.Clustered = &value
+	return b
+}
+
+// WithCerts sets the Certs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Certs field is set to the value of the last call.
+func (b *RedisClientConfigApplyConfiguration) WithCerts(value *RedisCertsApplyConfiguration) *RedisClientConfigApplyConfiguration {
+	b.Certs = value
+	return b
+}
+
+// WithAuth sets the Auth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Auth field is set to the value of the last call.
+func (b *RedisClientConfigApplyConfiguration) WithAuth(value *RedisAuthApplyConfiguration) *RedisClientConfigApplyConfiguration {
+	b.Auth = value
+	return b
+}
+
+// WithConnection sets the Connection field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Connection field is set to the value of the last call.
+func (b *RedisClientConfigApplyConfiguration) WithConnection(value *RedisConnectionConfigApplyConfiguration) *RedisClientConfigApplyConfiguration {
+	b.Connection = value
+	return b
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RedisConnectionConfigApplyConfiguration represents a declarative configuration of the RedisConnectionConfig type for use
+// with apply.
+//
+// RedisConnectionConfig configures connection pool and timeout tuning for Redis.
+type RedisConnectionConfigApplyConfiguration struct {
+	// PoolSize is the maximum number of connections in the pool.
+	//
+	PoolSize *int32 `json:"poolSize,omitempty"`
+	// MinIdleConns is the minimum number of idle connections in the pool.
+	//
+	MinIdleConns *int32 `json:"minIdleConns,omitempty"`
+	// MaxIdleConns is the maximum number of idle connections in the pool.
+	//
+	MaxIdleConns *int32 `json:"maxIdleConns,omitempty"`
+	// DialTimeout is the timeout for establishing new connections.
+	//
+	DialTimeout *v1.Duration `json:"dialTimeout,omitempty"`
+	// ReadTimeout is the timeout for reading a single command reply.
+	//
+	ReadTimeout *v1.Duration `json:"readTimeout,omitempty"`
+	// WriteTimeout is the timeout for writing a single command.
+	//
+	WriteTimeout *v1.Duration `json:"writeTimeout,omitempty"`
+	// PoolTimeout is the time to wait for a connection from the pool
+	// when all connections are busy.
+	//
+	PoolTimeout *v1.Duration `json:"poolTimeout,omitempty"`
+	// ConnMaxIdleTime is the maximum time a connection may be idle before
+	// being closed.
+	//
+	ConnMaxIdleTime *v1.Duration `json:"connMaxIdleTime,omitempty"`
+	// ConnMaxLifetime is the maximum lifetime of a connection before it is
+	// closed and recreated, regardless of activity.
+	//
+	ConnMaxLifetime *v1.Duration `json:"connMaxLifetime,omitempty"`
+	// MaxRetries is the maximum number of retries on failed commands.
+	//
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+	// MinRetryBackoff is the minimum backoff interval between retries.
+	//
+	MinRetryBackoff *v1.Duration `json:"minRetryBackoff,omitempty"`
+	// MaxRetryBackoff is the maximum backoff interval between retries.
+	//
+	MaxRetryBackoff *v1.Duration `json:"maxRetryBackoff,omitempty"`
+}
+
+// RedisConnectionConfigApplyConfiguration constructs a declarative configuration of the RedisConnectionConfig type for use with
+// apply.
+func RedisConnectionConfig() *RedisConnectionConfigApplyConfiguration {
+	return &RedisConnectionConfigApplyConfiguration{}
+}
+
+// WithPoolSize sets the PoolSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PoolSize field is set to the value of the last call.
+func (b *RedisConnectionConfigApplyConfiguration) WithPoolSize(value int32) *RedisConnectionConfigApplyConfiguration {
+	b.PoolSize = &value
+	return b
+}
+
+// WithMinIdleConns sets the MinIdleConns field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinIdleConns field is set to the value of the last call.
+func (b *RedisConnectionConfigApplyConfiguration) WithMinIdleConns(value int32) *RedisConnectionConfigApplyConfiguration {
+	b.MinIdleConns = &value
+	return b
+}
+
+// WithMaxIdleConns sets the MaxIdleConns field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxIdleConns field is set to the value of the last call.
+func (b *RedisConnectionConfigApplyConfiguration) WithMaxIdleConns(value int32) *RedisConnectionConfigApplyConfiguration {
+	b.MaxIdleConns = &value
+	return b
+}
+
+// WithDialTimeout sets the DialTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DialTimeout field is set to the value of the last call.
+func (b *RedisConnectionConfigApplyConfiguration) WithDialTimeout(value v1.Duration) *RedisConnectionConfigApplyConfiguration {
+	b.DialTimeout = &value
+	return b
+}
+
+// WithReadTimeout sets the ReadTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadTimeout field is set to the value of the last call.
+func (b *RedisConnectionConfigApplyConfiguration) WithReadTimeout(value v1.Duration) *RedisConnectionConfigApplyConfiguration {
+	b.ReadTimeout = &value
+	return b
+}
+
+// WithWriteTimeout sets the WriteTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WriteTimeout field is set to the value of the last call.
+func (b *RedisConnectionConfigApplyConfiguration) WithWriteTimeout(value v1.Duration) *RedisConnectionConfigApplyConfiguration {
+	b.WriteTimeout = &value
+	return b
+}
+
+// WithPoolTimeout sets the PoolTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PoolTimeout field is set to the value of the last call.
+func (b *RedisConnectionConfigApplyConfiguration) WithPoolTimeout(value v1.Duration) *RedisConnectionConfigApplyConfiguration {
+	b.PoolTimeout = &value
+	return b
+}
+
+// WithConnMaxIdleTime sets the ConnMaxIdleTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConnMaxIdleTime field is set to the value of the last call.
+func (b *RedisConnectionConfigApplyConfiguration) WithConnMaxIdleTime(value v1.Duration) *RedisConnectionConfigApplyConfiguration {
+	b.ConnMaxIdleTime = &value
+	return b
+}
+
+// WithConnMaxLifetime sets the ConnMaxLifetime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConnMaxLifetime field is set to the value of the last call.
+func (b *RedisConnectionConfigApplyConfiguration) WithConnMaxLifetime(value v1.Duration) *RedisConnectionConfigApplyConfiguration {
+	b.ConnMaxLifetime = &value
+	return b
+}
+
+// WithMaxRetries sets the MaxRetries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRetries field is set to the value of the last call.
+func (b *RedisConnectionConfigApplyConfiguration) WithMaxRetries(value int32) *RedisConnectionConfigApplyConfiguration {
+	b.MaxRetries = &value
+	return b
+}
+
+// WithMinRetryBackoff sets the MinRetryBackoff field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinRetryBackoff field is set to the value of the last call.
+func (b *RedisConnectionConfigApplyConfiguration) WithMinRetryBackoff(value v1.Duration) *RedisConnectionConfigApplyConfiguration {
+	b.MinRetryBackoff = &value
+	return b
+}
+
+// WithMaxRetryBackoff sets the MaxRetryBackoff field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRetryBackoff field is set to the value of the last call.
+func (b *RedisConnectionConfigApplyConfiguration) WithMaxRetryBackoff(value v1.Duration) *RedisConnectionConfigApplyConfiguration {
+	b.MaxRetryBackoff = &value
+	return b
+}
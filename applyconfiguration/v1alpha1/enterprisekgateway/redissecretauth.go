@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// RedisSecretAuthApplyConfiguration represents a declarative configuration of the RedisSecretAuth type for use
+// with apply.
+//
+// RedisSecretAuth configures Redis authentication from a Kubernetes Secret.
+type RedisSecretAuthApplyConfiguration struct {
+	// Name is the name of the Kubernetes Secret containing Redis credentials.
+	//
+	Name *string `json:"name,omitempty"`
+	// Namespace is the namespace of the Secret. If not specified, the Secret
+	// is assumed to be in the same namespace as the extension Deployment.
+	//
+	Namespace *string `json:"namespace,omitempty"`
+	// PasswordKey is the key in the Secret that contains the Redis password.
+	// Defaults to "password" if not specified.
+	//
+	PasswordKey *string `json:"passwordKey,omitempty"`
+	// UsernameKey is the key in the Secret that contains the Redis username.
+	// Defaults to "username" if not specified.
+	//
+	UsernameKey *string `json:"usernameKey,omitempty"`
+}
+
+// RedisSecretAuthApplyConfiguration constructs a declarative configuration of the RedisSecretAuth type for use with
+// apply.
+func RedisSecretAuth() *RedisSecretAuthApplyConfiguration {
+	return &RedisSecretAuthApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *RedisSecretAuthApplyConfiguration) WithName(value string) *RedisSecretAuthApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *RedisSecretAuthApplyConfiguration) WithNamespace(value string) *RedisSecretAuthApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithPasswordKey sets the PasswordKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PasswordKey field is set to the value of the last call.
+func (b *RedisSecretAuthApplyConfiguration) WithPasswordKey(value string) *RedisSecretAuthApplyConfiguration {
+	b.PasswordKey = &value
+	return b
+}
+
+// WithUsernameKey sets the UsernameKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UsernameKey field is set to the value of the last call.
+func (b *RedisSecretAuthApplyConfiguration) WithUsernameKey(value string) *RedisSecretAuthApplyConfiguration {
+	b.UsernameKey = &value
+	return b
+}
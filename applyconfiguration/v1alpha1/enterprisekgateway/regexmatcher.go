@@ -0,0 +1,41 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// RegexMatcherApplyConfiguration represents a declarative configuration of the RegexMatcher type for use
+// with apply.
+//
+// RegexMatcher based on https://github.com/envoyproxy/envoy/blob/4453ce1f809ec502fb2cbe0363cf5c6a971f3836/api/envoy/type/matcher/regex.proto#L19
+type RegexMatcherApplyConfiguration struct {
+	// The regex match string. The string must be supported by the configured engine.
+	Regex *string `json:"regex,omitempty"`
+}
+
+// RegexMatcherApplyConfiguration constructs a declarative configuration of the RegexMatcher type for use with
+// apply.
+func RegexMatcher() *RegexMatcherApplyConfiguration {
+	return &RegexMatcherApplyConfiguration{}
+}
+
+// WithRegex sets the Regex field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Regex field is set to the value of the last call.
+func (b *RegexMatcherApplyConfiguration) WithRegex(value string) *RegexMatcherApplyConfiguration {
+	b.Regex = &value
+	return b
+}
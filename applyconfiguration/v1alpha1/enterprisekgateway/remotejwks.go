@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/applyconfiguration/apis/v1"
+)
+
+// RemoteJWKSApplyConfiguration represents a declarative configuration of the RemoteJWKS type for use
+// with apply.
+type RemoteJWKSApplyConfiguration struct {
+	// The url used when accessing the upstream for Json Web Key Set.
+	// This is used to correctly set the host and path in the JWKS HTTP request.
+	// E.g. https://example.com/.well-known/jwks.json
+	Url *string `json:"url,omitempty"`
+	// The Backend representing the Json Web Key Set server
+	BackendRef *v1.BackendRefApplyConfiguration `json:"backendRef,omitempty"`
+	// Duration after which the cached JWKS should be expired.
+	// If not specified, default cache duration is 5 minutes.
+	CacheDuration *metav1.Duration `json:"cacheDuration,omitempty"`
+	// Fetch Jwks asynchronously in the main thread before the listener is activated.
+	// Fetched Jwks can be used by all worker threads.
+	//
+	// If this feature is not enabled:
+	// - The Jwks is fetched on-demand when the requests come. During the fetching, first
+	// few requests are paused until the Jwks is fetched.
+	// - Each worker thread fetches its own Jwks since Jwks cache is per worker thread.
+	//
+	// If this feature is enabled:
+	// - Fetched Jwks is done in the main thread before the listener is activated. Its fetched
+	// Jwks can be used by all worker threads. Each worker thread doesn't need to fetch its own.
+	// - Jwks is ready when the requests come, not need to wait for the Jwks fetching.
+	AsyncFetch *JwksAsyncFetchApplyConfiguration `json:"asyncFetch,omitempty"`
+}
+
+// RemoteJWKSApplyConfiguration constructs a declarative configuration of the RemoteJWKS type for use with
+// apply.
+func RemoteJWKS() *RemoteJWKSApplyConfiguration {
+	return &RemoteJWKSApplyConfiguration{}
+}
+
+// WithUrl sets the Url field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Url field is set to the value of the last call.
+func (b *RemoteJWKSApplyConfiguration) WithUrl(value string) *RemoteJWKSApplyConfiguration {
+	b.Url = &value
+	return b
+}
+
+// WithBackendRef sets the BackendRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackendRef field is set to the value of the last call.
+func (b *RemoteJWKSApplyConfiguration) WithBackendRef(value *v1.BackendRefApplyConfiguration) *RemoteJWKSApplyConfiguration {
+	b.BackendRef = value
+	return b
+}
+
+// WithCacheDuration sets the CacheDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CacheDuration field is set to the value of the last call.
+func (b *RemoteJWKSApplyConfiguration) WithCacheDuration(value metav1.Duration) *RemoteJWKSApplyConfiguration {
+	b.CacheDuration = &value
+	return b
+}
+
+// WithAsyncFetch sets the AsyncFetch field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AsyncFetch field is set to the value of the last call.
+func (b *RemoteJWKSApplyConfiguration) WithAsyncFetch(value *JwksAsyncFetchApplyConfiguration) *RemoteJWKSApplyConfiguration {
+	b.AsyncFetch = value
+	return b
+}
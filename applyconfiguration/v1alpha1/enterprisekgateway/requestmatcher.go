@@ -0,0 +1,90 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// RequestMatcherApplyConfiguration represents a declarative configuration of the RequestMatcher type for use
+// with apply.
+//
+// RequestMatcher configures transformations to apply on the request.
+type RequestMatcherApplyConfiguration struct {
+	// Matcher defines the request matching parameter. Only when the match is satisfied, the "requires" field will
+	// apply.
+	//
+	// Matches define conditions used for matching the rule against incoming
+	// HTTP requests. Each match is independent, i.e. this rule will be matched
+	// if **any** one of the matches is satisfied.
+	//
+	// For example, take the following matches configuration:
+	//
+	// ```
+	// matches:
+	// - path:
+	// value: "/foo"
+	// headers:
+	// - name: "version"
+	// value "v1"
+	// - path:
+	// value: "/v2/foo"
+	// ```
+	// For a request to match against this rule, a request must satisfy
+	// EITHER of the two conditions:
+	//
+	// - path prefixed with `/foo` AND contains the header `version: v1`
+	// - path prefix of `/v2/foo`
+	//
+	// For example: following match will match all requests.
+	//
+	// matches:
+	// - path:
+	// value: "/"
+	Matcher *TransformationRequestMatcherApplyConfiguration `json:"matcher,omitempty"`
+	// ClearRouteCache should we clear the route cache if a transformation was matched.
+	ClearRouteCache *bool `json:"clearRouteCache,omitempty"`
+	// Transformation to apply on the request.
+	Transformation *TransformationApplyConfiguration `json:"transformation,omitempty"`
+}
+
+// RequestMatcherApplyConfiguration constructs a declarative configuration of the RequestMatcher type for use with
+// apply.
+func RequestMatcher() *RequestMatcherApplyConfiguration {
+	return &RequestMatcherApplyConfiguration{}
+}
+
+// WithMatcher sets the Matcher field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Matcher field is set to the value of the last call.
+func (b *RequestMatcherApplyConfiguration) WithMatcher(value *TransformationRequestMatcherApplyConfiguration) *RequestMatcherApplyConfiguration {
+	b.Matcher = value
+	return b
+}
+
+// WithClearRouteCache sets the ClearRouteCache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClearRouteCache field is set to the value of the last call.
+func (b *RequestMatcherApplyConfiguration) WithClearRouteCache(value bool) *RequestMatcherApplyConfiguration {
+	b.ClearRouteCache = &value
+	return b
+}
+
+// WithTransformation sets the Transformation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Transformation field is set to the value of the last call.
+func (b *RequestMatcherApplyConfiguration) WithTransformation(value *TransformationApplyConfiguration) *RequestMatcherApplyConfiguration {
+	b.Transformation = value
+	return b
+}
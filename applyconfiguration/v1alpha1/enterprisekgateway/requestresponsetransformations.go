@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// RequestResponseTransformationsApplyConfiguration represents a declarative configuration of the RequestResponseTransformations type for use
+// with apply.
+//
+// RequestResponseTransformations configures transformations to apply on the request and response.
+type RequestResponseTransformationsApplyConfiguration struct {
+	// Requests configures transformations to apply on the request. The first request that matches will apply.
+	Requests []RequestMatcherApplyConfiguration `json:"requests,omitempty"`
+	// Responses configures transformations to apply on the response. The first response transformation that
+	// matches will apply.
+	Responses []ResponseMatcherApplyConfiguration `json:"responses,omitempty"`
+}
+
+// RequestResponseTransformationsApplyConfiguration constructs a declarative configuration of the RequestResponseTransformations type for use with
+// apply.
+func RequestResponseTransformations() *RequestResponseTransformationsApplyConfiguration {
+	return &RequestResponseTransformationsApplyConfiguration{}
+}
+
+// WithRequests adds the given value to the Requests field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Requests field.
+func (b *RequestResponseTransformationsApplyConfiguration) WithRequests(values ...*RequestMatcherApplyConfiguration) *RequestResponseTransformationsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRequests")
+		}
+		b.Requests = append(b.Requests, *values[i])
+	}
+	return b
+}
+
+// WithResponses adds the given value to the Responses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Responses field.
+func (b *RequestResponseTransformationsApplyConfiguration) WithResponses(values ...*ResponseMatcherApplyConfiguration) *RequestResponseTransformationsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResponses")
+		}
+		b.Responses = append(b.Responses, *values[i])
+	}
+	return b
+}
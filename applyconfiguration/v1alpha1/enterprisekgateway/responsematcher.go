@@ -0,0 +1,71 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// ResponseMatcherApplyConfiguration represents a declarative configuration of the ResponseMatcher type for use
+// with apply.
+//
+// ResponseMatch configures transformations to apply on the response.
+type ResponseMatcherApplyConfiguration struct {
+	// Specifies a set of headers that the route should match on. The router will
+	// check the response headers against all the specified headers in the route
+	// config. A match will happen if all the headers in the route are present in
+	// the request with the same values (or based on presence if the value field
+	// is not in the config).
+	Headers []TransformationHeaderMatcherApplyConfiguration `json:"matchers,omitempty"`
+	// Only match responses with non-empty response code details (this usually
+	// implies a local reply).
+	ResponseCodeDetails *string `json:"responseCodeDetails,omitempty"`
+	// Transformation to apply on the response.
+	Transformation *TransformationApplyConfiguration `json:"transformation,omitempty"`
+}
+
+// ResponseMatcherApplyConfiguration constructs a declarative configuration of the ResponseMatcher type for use with
+// apply.
+func ResponseMatcher() *ResponseMatcherApplyConfiguration {
+	return &ResponseMatcherApplyConfiguration{}
+}
+
+// WithHeaders adds the given value to the Headers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Headers field.
+func (b *ResponseMatcherApplyConfiguration) WithHeaders(values ...*TransformationHeaderMatcherApplyConfiguration) *ResponseMatcherApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithHeaders")
+		}
+		b.Headers = append(b.Headers, *values[i])
+	}
+	return b
+}
+
+// WithResponseCodeDetails sets the ResponseCodeDetails field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResponseCodeDetails field is set to the value of the last call.
+func (b *ResponseMatcherApplyConfiguration) WithResponseCodeDetails(value string) *ResponseMatcherApplyConfiguration {
+	b.ResponseCodeDetails = &value
+	return b
+}
+
+// WithTransformation sets the Transformation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Transformation field is set to the value of the last call.
+func (b *ResponseMatcherApplyConfiguration) WithTransformation(value *TransformationApplyConfiguration) *ResponseMatcherApplyConfiguration {
+	b.Transformation = value
+	return b
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// SpanTransformerApplyConfiguration represents a declarative configuration of the SpanTransformer type for use
+// with apply.
+//
+// SpanTransformer defines a span transformer for modifying trace spans.
+type SpanTransformerApplyConfiguration struct {
+	// Name specifies a template that sets the span name.
+	Name *v1alpha1enterprisekgateway.InjaTemplate `json:"name,omitempty"`
+}
+
+// SpanTransformerApplyConfiguration constructs a declarative configuration of the SpanTransformer type for use with
+// apply.
+func SpanTransformer() *SpanTransformerApplyConfiguration {
+	return &SpanTransformerApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SpanTransformerApplyConfiguration) WithName(value v1alpha1enterprisekgateway.InjaTemplate) *SpanTransformerApplyConfiguration {
+	b.Name = &value
+	return b
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// StagedJWTApplyConfiguration represents a declarative configuration of the StagedJWT type for use
+// with apply.
+//
+// StagedJWT allows for configuring JWT authentication at various stages of request processing
+type StagedJWTApplyConfiguration struct {
+	// JWT configuration to be enforced after external auth has been processed (if it is present).
+	AfterExtAuth *EntJWTApplyConfiguration `json:"afterExtAuth,omitempty"`
+	// JWT configuration to be enforced before external auth has been processed.
+	BeforeExtAuth *EntJWTApplyConfiguration `json:"beforeExtAuth,omitempty"`
+}
+
+// StagedJWTApplyConfiguration constructs a declarative configuration of the StagedJWT type for use with
+// apply.
+func StagedJWT() *StagedJWTApplyConfiguration {
+	return &StagedJWTApplyConfiguration{}
+}
+
+// WithAfterExtAuth sets the AfterExtAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AfterExtAuth field is set to the value of the last call.
+func (b *StagedJWTApplyConfiguration) WithAfterExtAuth(value *EntJWTApplyConfiguration) *StagedJWTApplyConfiguration {
+	b.AfterExtAuth = value
+	return b
+}
+
+// WithBeforeExtAuth sets the BeforeExtAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BeforeExtAuth field is set to the value of the last call.
+func (b *StagedJWTApplyConfiguration) WithBeforeExtAuth(value *EntJWTApplyConfiguration) *StagedJWTApplyConfiguration {
+	b.BeforeExtAuth = value
+	return b
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// StagedTransformationsApplyConfiguration represents a declarative configuration of the StagedTransformations type for use
+// with apply.
+//
+// StagedTransformations configures transformations to apply for different stages of the filter chain.
+type StagedTransformationsApplyConfiguration struct {
+	// Early transformations happen before most other options (Like Auth and Rate Limit).
+	Early *RequestResponseTransformationsApplyConfiguration `json:"early,omitempty"`
+	// Regular transformations happen after Auth and Rate limit decisions have been made.
+	Regular *RequestResponseTransformationsApplyConfiguration `json:"regular,omitempty"`
+	// PostRouting happen during the router filter chain. This is important for a number of reasons
+	// 1. Retries re-trigger this filter, which might impact performance.
+	// 2. It is the only point where endpoint metadata is available.
+	// 3. `clearRouteCache` does NOT work in this stage as the routing decision is already made.
+	PostRouting *RequestResponseTransformationsApplyConfiguration `json:"postRouting,omitempty"`
+	// When enabled, log request/response body and headers before and after all transformations defined here are applied.\
+	// This overrides the logRequestResponseInfo field in the Transformation message.
+	LogRequestResponseInfo *bool `json:"logRequestResponseInfo,omitempty"`
+	// EscapeCharacters configures the Inja behavior when rendering strings which contain
+	// characters that would need to be escaped to be valid JSON. Note that this
+	// sets the behavior for all staged transformations configured here. This setting
+	// can be overridden per-transformation using the field `escapeCharacters` on
+	// the TransformationTemplate.
+	EscapeCharacters *v1alpha1enterprisekgateway.EscapeCharactersBehavior `json:"escapeCharacters,omitempty"`
+}
+
+// StagedTransformationsApplyConfiguration constructs a declarative configuration of the StagedTransformations type for use with
+// apply.
+func StagedTransformations() *StagedTransformationsApplyConfiguration {
+	return &StagedTransformationsApplyConfiguration{}
+}
+
+// WithEarly sets the Early field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Early field is set to the value of the last call.
+func (b *StagedTransformationsApplyConfiguration) WithEarly(value *RequestResponseTransformationsApplyConfiguration) *StagedTransformationsApplyConfiguration {
+	b.Early = value
+	return b
+}
+
+// WithRegular sets the Regular field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Regular field is set to the value of the last call.
+func (b *StagedTransformationsApplyConfiguration) WithRegular(value *RequestResponseTransformationsApplyConfiguration) *StagedTransformationsApplyConfiguration {
+	b.Regular = value
+	return b
+}
+
+// WithPostRouting sets the PostRouting field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PostRouting field is set to the value of the last call.
+func (b *StagedTransformationsApplyConfiguration) WithPostRouting(value *RequestResponseTransformationsApplyConfiguration) *StagedTransformationsApplyConfiguration {
+	b.PostRouting = value
+	return b
+}
+
+// WithLogRequestResponseInfo sets the LogRequestResponseInfo field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogRequestResponseInfo field is set to the value of the last call.
+func (b *StagedTransformationsApplyConfiguration) WithLogRequestResponseInfo(value bool) *StagedTransformationsApplyConfiguration {
+	b.LogRequestResponseInfo = &value
+	return b
+}
+
+// WithEscapeCharacters sets the EscapeCharacters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EscapeCharacters field is set to the value of the last call.
+func (b *StagedTransformationsApplyConfiguration) WithEscapeCharacters(value v1alpha1enterprisekgateway.EscapeCharactersBehavior) *StagedTransformationsApplyConfiguration {
+	b.EscapeCharacters = &value
+	return b
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// TlsContextMatchOptionsApplyConfiguration represents a declarative configuration of the TlsContextMatchOptions type for use
+// with apply.
+//
+// TlsContextMatchOptions configures the TLS context match options.
+type TlsContextMatchOptionsApplyConfiguration struct {
+	// If specified, the route will match against whether a certificate is presented.
+	// If not specified, certificate presentation status (true or false) will not be considered when route matching.
+	Presented *bool `json:"presented,omitempty"`
+	// If specified, the route will match against whether a certificate is validated.
+	// If not specified, certificate validation status (true or false) will not be considered when route matching.
+	Validated *bool `json:"validated,omitempty"`
+}
+
+// TlsContextMatchOptionsApplyConfiguration constructs a declarative configuration of the TlsContextMatchOptions type for use with
+// apply.
+func TlsContextMatchOptions() *TlsContextMatchOptionsApplyConfiguration {
+	return &TlsContextMatchOptionsApplyConfiguration{}
+}
+
+// WithPresented sets the Presented field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Presented field is set to the value of the last call.
+func (b *TlsContextMatchOptionsApplyConfiguration) WithPresented(value bool) *TlsContextMatchOptionsApplyConfiguration {
+	b.Presented = &value
+	return b
+}
+
+// WithValidated sets the Validated field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Validated field is set to the value of the last call.
+func (b *TlsContextMatchOptionsApplyConfiguration) WithValidated(value bool) *TlsContextMatchOptionsApplyConfiguration {
+	b.Validated = &value
+	return b
+}
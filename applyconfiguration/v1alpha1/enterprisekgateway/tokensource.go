@@ -0,0 +1,58 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// TokenSourceApplyConfiguration represents a declarative configuration of the TokenSource type for use
+// with apply.
+//
+// Describes the location of a JWT token
+type TokenSourceApplyConfiguration struct {
+	// Try to retrieve token from these headers
+	Headers []TokenSourceHeaderSourceApplyConfiguration `json:"headers,omitempty"`
+	// Try to retrieve token from these query params
+	QueryParams []string `json:"queryParams,omitempty"`
+}
+
+// TokenSourceApplyConfiguration constructs a declarative configuration of the TokenSource type for use with
+// apply.
+func TokenSource() *TokenSourceApplyConfiguration {
+	return &TokenSourceApplyConfiguration{}
+}
+
+// WithHeaders adds the given value to the Headers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Headers field.
+func (b *TokenSourceApplyConfiguration) WithHeaders(values ...*TokenSourceHeaderSourceApplyConfiguration) *TokenSourceApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithHeaders")
+		}
+		b.Headers = append(b.Headers, *values[i])
+	}
+	return b
+}
+
+// WithQueryParams adds the given value to the QueryParams field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the QueryParams field.
+func (b *TokenSourceApplyConfiguration) WithQueryParams(values ...string) *TokenSourceApplyConfiguration {
+	for i := range values {
+		b.QueryParams = append(b.QueryParams, values[i])
+	}
+	return b
+}
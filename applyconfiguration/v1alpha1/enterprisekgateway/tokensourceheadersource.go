@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// TokenSourceHeaderSourceApplyConfiguration represents a declarative configuration of the TokenSourceHeaderSource type for use
+// with apply.
+//
+// Describes how to retrieve a JWT from a header
+type TokenSourceHeaderSourceApplyConfiguration struct {
+	// The name of the header. for example, "authorization"
+	Header *string `json:"header,omitempty"`
+	// Prefix before the token. for example, "Bearer "
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// TokenSourceHeaderSourceApplyConfiguration constructs a declarative configuration of the TokenSourceHeaderSource type for use with
+// apply.
+func TokenSourceHeaderSource() *TokenSourceHeaderSourceApplyConfiguration {
+	return &TokenSourceHeaderSourceApplyConfiguration{}
+}
+
+// WithHeader sets the Header field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Header field is set to the value of the last call.
+func (b *TokenSourceHeaderSourceApplyConfiguration) WithHeader(value string) *TokenSourceHeaderSourceApplyConfiguration {
+	b.Header = &value
+	return b
+}
+
+// WithPrefix sets the Prefix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Prefix field is set to the value of the last call.
+func (b *TokenSourceHeaderSourceApplyConfiguration) WithPrefix(value string) *TokenSourceHeaderSourceApplyConfiguration {
+	b.Prefix = &value
+	return b
+}
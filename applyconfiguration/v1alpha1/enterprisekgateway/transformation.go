@@ -0,0 +1,54 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// TransformationApplyConfiguration represents a declarative configuration of the Transformation type for use
+// with apply.
+//
+// TODO(npolshak): Add support for XSLT as part of https://github.com/solo-io/gloo-gateway/issues/106
+// TODO(npolshak): Add support for WrapAsAPIGateway as part of https://github.com/solo-io/gloo-gateway/issues/78
+// TODO(npolshak): Add support for DLP as part of https://github.com/solo-io/gloo-gateway/issues/33
+// Transformation defines a transformation that can be applied to requests or responses.
+type TransformationApplyConfiguration struct {
+	// Template specifies a template-based transformation.
+	Template *TransformationTemplateApplyConfiguration `json:"template,omitempty"`
+	// HeaderBody specifies a header and body transformation.
+	HeaderBody *HeaderBodyTransformApplyConfiguration `json:"headerBody,omitempty"`
+}
+
+// TransformationApplyConfiguration constructs a declarative configuration of the Transformation type for use with
+// apply.
+func Transformation() *TransformationApplyConfiguration {
+	return &TransformationApplyConfiguration{}
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *TransformationApplyConfiguration) WithTemplate(value *TransformationTemplateApplyConfiguration) *TransformationApplyConfiguration {
+	b.Template = value
+	return b
+}
+
+// WithHeaderBody sets the HeaderBody field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HeaderBody field is set to the value of the last call.
+func (b *TransformationApplyConfiguration) WithHeaderBody(value *HeaderBodyTransformApplyConfiguration) *TransformationApplyConfiguration {
+	b.HeaderBody = value
+	return b
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// TransformationHeaderMatcherApplyConfiguration represents a declarative configuration of the TransformationHeaderMatcher type for use
+// with apply.
+//
+// TransformationHeaderMatcher configures the header matching to apply.
+type TransformationHeaderMatcherApplyConfiguration struct {
+	// Specifies the name of the header in the request.
+	Name *string `json:"name,omitempty"`
+	// Specifies the value of the header. If the value is absent a request that
+	// has the name header will match, regardless of the header's value.
+	Value *string `json:"value,omitempty"`
+	// Specifies whether the header value should be treated as regex or not.
+	Regex *bool `json:"regex,omitempty"`
+	// If set to true, the result of the match will be inverted. Defaults to false.
+	//
+	// Examples:
+	// * name=foo, invertMatch=true: matches if no header named `foo` is present
+	// * name=foo, value=bar, invertMatch=true: matches if no header named `foo` with value `bar` is present
+	// * name=foo, value=`\d{3}`, regex=true, invertMatch=true: matches if no header named `foo` with a value consisting of three integers is present
+	InvertMatch *bool `json:"invertMatch,omitempty"`
+}
+
+// TransformationHeaderMatcherApplyConfiguration constructs a declarative configuration of the TransformationHeaderMatcher type for use with
+// apply.
+func TransformationHeaderMatcher() *TransformationHeaderMatcherApplyConfiguration {
+	return &TransformationHeaderMatcherApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *TransformationHeaderMatcherApplyConfiguration) WithName(value string) *TransformationHeaderMatcherApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *TransformationHeaderMatcherApplyConfiguration) WithValue(value string) *TransformationHeaderMatcherApplyConfiguration {
+	b.Value = &value
+	return b
+}
+
+// WithRegex sets the Regex field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Regex field is set to the value of the last call.
+func (b *TransformationHeaderMatcherApplyConfiguration) WithRegex(value bool) *TransformationHeaderMatcherApplyConfiguration {
+	b.Regex = &value
+	return b
+}
+
+// WithInvertMatch sets the InvertMatch field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InvertMatch field is set to the value of the last call.
+func (b *TransformationHeaderMatcherApplyConfiguration) WithInvertMatch(value bool) *TransformationHeaderMatcherApplyConfiguration {
+	b.InvertMatch = &value
+	return b
+}
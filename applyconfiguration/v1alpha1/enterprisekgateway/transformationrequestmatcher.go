@@ -0,0 +1,168 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+// TransformationRequestMatcherApplyConfiguration represents a declarative configuration of the TransformationRequestMatcher type for use
+// with apply.
+//
+// TransformationRequestMatcher configures the matcher to match against the request.
+type TransformationRequestMatcherApplyConfiguration struct {
+	// Prefix configures the prefix rule meaning that the prefix must
+	// match the beginning of the *:path* header.
+	// Max length is following https://gateway-api.sigs.k8s.io/reference/spec/#httppathmatch
+	Prefix *string `json:"prefix,omitempty"`
+	// Path configures the exact path rule meaning that the path must
+	// exactly match the *:path* header once the query string is removed.
+	// Max length is following https://gateway-api.sigs.k8s.io/reference/spec/#httppathmatch
+	Path *string `json:"path,omitempty"`
+	// Regex configures the route regular expression rule meaning that the
+	// regex must match the *:path* header once the query string is removed. The entire path
+	// (without the query string) must match the regex. The rule will not match if only a
+	// subsequence of the *:path* header matches the regex.
+	Regex *RegexMatcherApplyConfiguration `json:"regex,omitempty"`
+	// Connect configures the matcher to only match CONNECT requests.
+	// Note that this will not match HTTP/2 upgrade-style CONNECT requests
+	// (WebSocket and the like) as they are normalized in Envoy as HTTP/1.1 style
+	// upgrades.
+	// This is the only way to match CONNECT requests for HTTP/1.1. For HTTP/2,
+	// where CONNECT requests may have a path, the path matchers will work if
+	// there is a path present.
+	Connect *bool `json:"connect,omitempty"`
+	// CaseSensitive indicates that prefix/path matching should be case-insensitive. The default
+	// is true.
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+	// Specifies a set of headers that the route should match on. The router will
+	// check the request's headers against all the specified headers in the route
+	// config. A match will happen if all the headers in the route are present in
+	// the request with the same values (or based on presence if the value field
+	// is not in the config).
+	Headers []TransformationHeaderMatcherApplyConfiguration `json:"headers,omitempty"`
+	// Specifies a set of URL query parameters on which the route should
+	// match. The router will check the query string from the *path* header
+	// against all the specified query parameters. If the number of specified
+	// query parameters is nonzero, they all must match the *path* header's
+	// query string for a match to occur.
+	QueryParameters []QueryParameterMatcherApplyConfiguration `json:"queryParameters,omitempty"`
+	// If specified, only gRPC requests will be matched. The router will check
+	// that the content-type header has a application/grpc or one of the various
+	// application/grpc+ values.
+	Grpc *bool `json:"grpc,omitempty"`
+	// If specified, the client tls context will be matched against the defined
+	// match options.
+	TlsContext *TlsContextMatchOptionsApplyConfiguration `json:"tlsContext,omitempty"`
+	// HTTP Method/Verb(s) to match on. If none specified, the matcher will ignore the HTTP Method
+	Methods []string `json:"methods,omitempty"`
+}
+
+// TransformationRequestMatcherApplyConfiguration constructs a declarative configuration of the TransformationRequestMatcher type for use with
+// apply.
+func TransformationRequestMatcher() *TransformationRequestMatcherApplyConfiguration {
+	return &TransformationRequestMatcherApplyConfiguration{}
+}
+
+// WithPrefix sets the Prefix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Prefix field is set to the value of the last call.
+func (b *TransformationRequestMatcherApplyConfiguration) WithPrefix(value string) *TransformationRequestMatcherApplyConfiguration {
+	b.Prefix = &value
+	return b
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *TransformationRequestMatcherApplyConfiguration) WithPath(value string) *TransformationRequestMatcherApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithRegex sets the Regex field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Regex field is set to the value of the last call.
+func (b *TransformationRequestMatcherApplyConfiguration) WithRegex(value *RegexMatcherApplyConfiguration) *TransformationRequestMatcherApplyConfiguration {
+	b.Regex = value
+	return b
+}
+
+// WithConnect sets the Connect field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Connect field is set to the value of the last call.
+func (b *TransformationRequestMatcherApplyConfiguration) WithConnect(value bool) *TransformationRequestMatcherApplyConfiguration {
+	b.Connect = &value
+	return b
+}
+
+// WithCaseSensitive sets the CaseSensitive field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CaseSensitive field is set to the value of the last call.
+func (b *TransformationRequestMatcherApplyConfiguration) WithCaseSensitive(value bool) *TransformationRequestMatcherApplyConfiguration {
+	b.CaseSensitive = &value
+	return b
+}
+
+// WithHeaders adds the given value to the Headers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Headers field.
+func (b *TransformationRequestMatcherApplyConfiguration) WithHeaders(values ...*TransformationHeaderMatcherApplyConfiguration) *TransformationRequestMatcherApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithHeaders")
+		}
+		b.Headers = append(b.Headers, *values[i])
+	}
+	return b
+}
+
+// WithQueryParameters adds the given value to the QueryParameters field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the QueryParameters field.
+func (b *TransformationRequestMatcherApplyConfiguration) WithQueryParameters(values ...*QueryParameterMatcherApplyConfiguration) *TransformationRequestMatcherApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithQueryParameters")
+		}
+		b.QueryParameters = append(b.QueryParameters, *values[i])
+	}
+	return b
+}
+
+// WithGrpc sets the Grpc field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Grpc field is set to the value of the last call.
+func (b *TransformationRequestMatcherApplyConfiguration) WithGrpc(value bool) *TransformationRequestMatcherApplyConfiguration {
+	b.Grpc = &value
+	return b
+}
+
+// WithTlsContext sets the TlsContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TlsContext field is set to the value of the last call.
+func (b *TransformationRequestMatcherApplyConfiguration) WithTlsContext(value *TlsContextMatchOptionsApplyConfiguration) *TransformationRequestMatcherApplyConfiguration {
+	b.TlsContext = value
+	return b
+}
+
+// WithMethods adds the given value to the Methods field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Methods field.
+func (b *TransformationRequestMatcherApplyConfiguration) WithMethods(values ...string) *TransformationRequestMatcherApplyConfiguration {
+	for i := range values {
+		b.Methods = append(b.Methods, values[i])
+	}
+	return b
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// TransformationTemplateApplyConfiguration represents a declarative configuration of the TransformationTemplate type for use
+// with apply.
+//
+// TransformationTemplate defines a transformation template.
+type TransformationTemplateApplyConfiguration struct {
+	// AdvancedTemplates determines whether to use JSON pointer notation instead of dot notation.
+	// If set to true, use JSON pointer notation (e.g. "time/start") instead of
+	// dot notation (e.g. "time.start") to access JSON elements. Defaults to
+	// false.
+	//
+	// Please note that, if set to 'true', you will need to use the `extraction`
+	// function to access extractors in the template (e.g. "{{ extraction("my_extractor") }}").
+	// If the default value of 'false' is used, extractors will simply be available by their name (e.g. "{{ my_extractor }}").
+	AdvancedTemplates *bool `json:"advancedTemplates,omitempty"`
+	// Extractors use this attribute to extract information from the request. It consists of
+	// a map of strings to extractors. The extractor will define which
+	// information will be extracted, while the string key will provide the
+	// extractor with a name. You can reference extractors by their name in
+	// templates, e.g. "{{ my-extractor }}" will render to the value of the
+	// "my-extractor" extractor.
+	Extractors map[string]ExtractionApplyConfiguration `json:"extractors,omitempty"`
+	// Headers configures the transform request/response headers. It consists of a
+	// map of strings to templates. The string key determines the name of the
+	// resulting header, the rendered template will determine the value. Any existing
+	// headers with the same header name will be replaced by the transformed header.
+	// If a header name is included in `headers` and `headersToAppend`, it will first
+	// be replaced the template in `headers`, then additional header values will be appended
+	// by the templates defined in `headersToAppend`.
+	// For example, the following header transformation configuration:
+	//
+	// ```yaml
+	//
+	// headers:
+	// x-header-one: {"text": "first {{inja}} template"}
+	// x-header-one: {"text": "second {{inja}} template"}
+	// headersToAppend:
+	// - key: x-header-one
+	// value: {"text": "first appended {{inja}} template"}
+	// - key: x-header-one
+	// value: {"text": "second appended {{inja}} template"}
+	//
+	// ```
+	// will result in the following headers on the HTTP message:
+	//
+	// ```
+	// x-header-one: first inja template
+	// x-header-one: first appended inja template
+	// x-header-one: second appended inja template
+	// ```
+	Headers map[string]v1alpha1enterprisekgateway.InjaTemplate `json:"headers,omitempty"`
+	// HeadersToAppend configures the transform request/response headers. It consists of
+	// an array of string/template objects. Use this attribute to define multiple
+	// templates for a single header. Header template(s) defined here will be appended to any
+	// existing headers with the same header name, not replace existing ones.
+	// See `headers` documentation to see an example of usage.
+	HeadersToAppend []HeaderToAppendApplyConfiguration `json:"headersToAppend,omitempty"`
+	// HeadersToRemove is configured to remove headers from requests. If a header is present multiple
+	// times, all instances of the header will be removed.
+	HeadersToRemove []string `json:"headersToRemove,omitempty"`
+	// BodyTransformation specifies how to transform the body.
+	BodyTransformation *BodyTransformationApplyConfiguration `json:"bodyTransformation,omitempty"`
+	// ParseBodyBehavior determines how the body will be parsed. Defaults to ParseAsJson.
+	ParseBodyBehavior *v1alpha1enterprisekgateway.RequestBodyParse `json:"parseBodyBehavior,omitempty"`
+	// IgnoreErrorOnParse determines whether Envoy should throw an exception if body parsing fails.
+	IgnoreErrorOnParse *bool `json:"ignoreErrorOnParse,omitempty"`
+	// DynamicMetadataValues defines Envoy Dynamic Metadata entries.
+	DynamicMetadataValues []DynamicMetadataValueApplyConfiguration `json:"dynamicMetadataValues,omitempty"`
+	// EscapeCharacters configures the Inja behavior when rendering strings which contain
+	// characters that would need to be escaped to be valid JSON. Note that this
+	// sets the behavior for the entire transformation. Use raw_strings function
+	// for fine-grained control within a template.
+	EscapeCharacters *v1alpha1enterprisekgateway.EscapeCharactersBehavior `json:"escapeCharacters,omitempty"`
+	// SpanTransformer defines a span transformer for modifying trace spans.
+	SpanTransformer *SpanTransformerApplyConfiguration `json:"spanTransformer,omitempty"`
+}
+
+// TransformationTemplateApplyConfiguration constructs a declarative configuration of the TransformationTemplate type for use with
+// apply.
+func TransformationTemplate() *TransformationTemplateApplyConfiguration {
+	return &TransformationTemplateApplyConfiguration{}
+}
+
+// WithAdvancedTemplates sets the AdvancedTemplates field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdvancedTemplates field is set to the value of the last call.
+func (b *TransformationTemplateApplyConfiguration) WithAdvancedTemplates(value bool) *TransformationTemplateApplyConfiguration {
+	b.AdvancedTemplates = &value
+	return b
+}
+
+// WithExtractors puts the entries into the Extractors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Extractors field,
+// overwriting an existing map entries in Extractors field with the same key.
+func (b *TransformationTemplateApplyConfiguration) WithExtractors(entries map[string]ExtractionApplyConfiguration) *TransformationTemplateApplyConfiguration {
+	if b.Extractors == nil && len(entries) > 0 {
+		b.Extractors = make(map[string]ExtractionApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.Extractors[k] = v
+	}
+	return b
+}
+
+// WithHeaders puts the entries into the Headers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Headers field,
+// overwriting an existing map entries in Headers field with the same key.
+func (b *TransformationTemplateApplyConfiguration) WithHeaders(entries map[string]v1alpha1enterprisekgateway.InjaTemplate) *TransformationTemplateApplyConfiguration {
+	if b.Headers == nil && len(entries) > 0 {
+		b.Headers = make(map[string]v1alpha1enterprisekgateway.InjaTemplate, len(entries))
+	}
+	for k, v := range entries {
+		b.Headers[k] = v
+	}
+	return b
+}
+
+// WithHeadersToAppend adds the given value to the HeadersToAppend field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the HeadersToAppend field.
+func (b *TransformationTemplateApplyConfiguration) WithHeadersToAppend(values ...*HeaderToAppendApplyConfiguration) *TransformationTemplateApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithHeadersToAppend")
+		}
+		b.HeadersToAppend = append(b.HeadersToAppend, *values[i])
+	}
+	return b
+}
+
+// WithHeadersToRemove adds the given value to the HeadersToRemove field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the HeadersToRemove field.
+func (b *TransformationTemplateApplyConfiguration) WithHeadersToRemove(values ...string) *TransformationTemplateApplyConfiguration {
+	for i := range values {
+		b.HeadersToRemove = append(b.HeadersToRemove, values[i])
+	}
+	return b
+}
+
+// WithBodyTransformation sets the BodyTransformation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BodyTransformation field is set to the value of the last call.
+func (b *TransformationTemplateApplyConfiguration) WithBodyTransformation(value *BodyTransformationApplyConfiguration) *TransformationTemplateApplyConfiguration {
+	b.BodyTransformation = value
+	return b
+}
+
+// WithParseBodyBehavior sets the ParseBodyBehavior field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParseBodyBehavior field is set to the value of the last call.
+func (b *TransformationTemplateApplyConfiguration) WithParseBodyBehavior(value v1alpha1enterprisekgateway.RequestBodyParse) *TransformationTemplateApplyConfiguration {
+	b.ParseBodyBehavior = &value
+	return b
+}
+
+// WithIgnoreErrorOnParse sets the IgnoreErrorOnParse field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IgnoreErrorOnParse field is set to the value of the last call.
+func (b *TransformationTemplateApplyConfiguration) WithIgnoreErrorOnParse(value bool) *TransformationTemplateApplyConfiguration {
+	b.IgnoreErrorOnParse = &value
+	return b
+}
+
+// WithDynamicMetadataValues adds the given value to the DynamicMetadataValues field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DynamicMetadataValues field.
+func (b *TransformationTemplateApplyConfiguration) WithDynamicMetadataValues(values ...*DynamicMetadataValueApplyConfiguration) *TransformationTemplateApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDynamicMetadataValues")
+		}
+		b.DynamicMetadataValues = append(b.DynamicMetadataValues, *values[i])
+	}
+	return b
+}
+
+// WithEscapeCharacters sets the EscapeCharacters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EscapeCharacters field is set to the value of the last call.
+func (b *TransformationTemplateApplyConfiguration) WithEscapeCharacters(value v1alpha1enterprisekgateway.EscapeCharactersBehavior) *TransformationTemplateApplyConfiguration {
+	b.EscapeCharacters = &value
+	return b
+}
+
+// WithSpanTransformer sets the SpanTransformer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SpanTransformer field is set to the value of the last call.
+func (b *TransformationTemplateApplyConfiguration) WithSpanTransformer(value *SpanTransformerApplyConfiguration) *TransformationTemplateApplyConfiguration {
+	b.SpanTransformer = value
+	return b
+}
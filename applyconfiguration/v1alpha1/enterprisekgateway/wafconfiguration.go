@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	kgateway "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/kgateway"
+	shared "github.com/solo-io/kgateway-client/v2/applyconfiguration/kgateway/v1alpha1/shared"
+	appsv1 "k8s.io/client-go/applyconfigurations/apps/v1"
+	v1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// WAFConfigurationApplyConfiguration represents a declarative configuration of the WAFConfiguration type for use
+// with apply.
+type WAFConfigurationApplyConfiguration struct {
+	DeploymentConfigurationApplyConfiguration `json:",inline"`
+	// LogLevel is the log level for the WAF extproc server. If not set, defaults to "info".
+	LogLevel *v1alpha1enterprisekgateway.WAFLogLevel `json:"logLevel,omitempty"`
+	// Admin configures the WAF admin server.
+	Admin *WAFAdminConfigurationApplyConfiguration `json:"admin,omitempty"`
+}
+
+// WAFConfigurationApplyConfiguration constructs a declarative configuration of the WAFConfiguration type for use with
+// apply.
+func WAFConfiguration() *WAFConfigurationApplyConfiguration {
+	return &WAFConfigurationApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithEnabled(value bool) *WAFConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Enabled = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithResources(value *v1.ResourceRequirementsApplyConfiguration) *WAFConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Resources = value
+	return b
+}
+
+// WithPodTemplate sets the PodTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodTemplate field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithPodTemplate(value *kgateway.PodApplyConfiguration) *WAFConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.PodTemplate = value
+	return b
+}
+
+// WithContainer sets the Container field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Container field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithContainer(value *ContainerConfigurationApplyConfiguration) *WAFConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Container = value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithReplicas(value int32) *WAFConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Replicas = &value
+	return b
+}
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithStrategy(value *appsv1.DeploymentStrategyApplyConfiguration) *WAFConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.Strategy = value
+	return b
+}
+
+// WithDeploymentOverlay sets the DeploymentOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeploymentOverlay field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithDeploymentOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *WAFConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.DeploymentOverlay = value
+	return b
+}
+
+// WithServiceOverlay sets the ServiceOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceOverlay field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithServiceOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *WAFConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.ServiceOverlay = value
+	return b
+}
+
+// WithServiceAccountOverlay sets the ServiceAccountOverlay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountOverlay field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithServiceAccountOverlay(value *shared.KubernetesResourceOverlayApplyConfiguration) *WAFConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.ServiceAccountOverlay = value
+	return b
+}
+
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithPodDisruptionBudget(value *shared.KubernetesResourceOverlayApplyConfiguration) *WAFConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.PodDisruptionBudget = value
+	return b
+}
+
+// WithHorizontalPodAutoscaler sets the HorizontalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HorizontalPodAutoscaler field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithHorizontalPodAutoscaler(value *shared.KubernetesResourceOverlayApplyConfiguration) *WAFConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.HorizontalPodAutoscaler = value
+	return b
+}
+
+// WithVerticalPodAutoscaler sets the VerticalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerticalPodAutoscaler field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithVerticalPodAutoscaler(value *shared.KubernetesResourceOverlayApplyConfiguration) *WAFConfigurationApplyConfiguration {
+	b.DeploymentConfigurationApplyConfiguration.VerticalPodAutoscaler = value
+	return b
+}
+
+// WithLogLevel sets the LogLevel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogLevel field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithLogLevel(value v1alpha1enterprisekgateway.WAFLogLevel) *WAFConfigurationApplyConfiguration {
+	b.LogLevel = &value
+	return b
+}
+
+// WithAdmin sets the Admin field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Admin field is set to the value of the last call.
+func (b *WAFConfigurationApplyConfiguration) WithAdmin(value *WAFAdminConfigurationApplyConfiguration) *WAFConfigurationApplyConfiguration {
+	b.Admin = value
+	return b
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisesolo
+
+import (
+	v1alpha1enterprisesolo "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	apisv1 "sigs.k8s.io/gateway-api/applyconfiguration/apis/v1"
+)
+
+// EnterpriseListenerEntryApplyConfiguration represents a declarative configuration of the EnterpriseListenerEntry type for use
+// with apply.
+type EnterpriseListenerEntryApplyConfiguration struct {
+	// Name is the name of the Listener. This name MUST be unique within a
+	// ListenerSet.
+	//
+	// Name is not required to be unique across a Gateway and ListenerSets.
+	// Routes can attach to a Listener by having a ListenerSet as a parentRef
+	// and setting the SectionName
+	Name *v1.SectionName `json:"name,omitempty"`
+	// Hostname specifies the virtual hostname to match for protocol types that
+	// define this concept. When unspecified, all hostnames are matched. This
+	// field is ignored for protocols that don't require hostname based
+	// matching.
+	//
+	// Implementations MUST apply Hostname matching appropriately for each of
+	// the following protocols:
+	//
+	// * TLS: The Listener Hostname MUST match the SNI.
+	// * HTTP: The Listener Hostname MUST match the Host header of the request.
+	// * HTTPS: The Listener Hostname SHOULD match at both the TLS and HTTP
+	// protocol layers as described above. If an implementation does not
+	// ensure that both the SNI and Host header match the Listener hostname,
+	// it MUST clearly document that.
+	//
+	// For HTTPRoute and TLSRoute resources, there is an interaction with the
+	// `spec.hostnames` array. When both listener and route specify hostnames,
+	// there MUST be an intersection between the values for a Route to be
+	// accepted. For more information, refer to the Route specific Hostnames
+	// documentation.
+	//
+	// Hostnames that are prefixed with a wildcard label (`*.`) are interpreted
+	// as a suffix match. That means that a match for `*.example.com` would match
+	// both `test.example.com`, and `foo.test.example.com`, but not `example.com`.
+	//
+	Hostname *v1.Hostname `json:"hostname,omitempty"`
+	// Port is the network port. Multiple listeners may use the
+	// same port, subject to the Listener compatibility rules.
+	//
+	// If the port is not set or specified as zero, the implementation will assign
+	// a unique port. If the implementation does not support dynamic port
+	// assignment, it MUST set `Accepted` condition to `False` with the
+	// `UnsupportedPort` reason.
+	//
+	//
+	Port *v1alpha1enterprisesolo.PortNumber `json:"port,omitempty"`
+	// Protocol specifies the network protocol this listener expects to receive.
+	Protocol *v1.ProtocolType `json:"protocol,omitempty"`
+	// TLS is the TLS configuration for the Listener. This field is required if
+	// the Protocol field is "HTTPS" or "TLS". It is invalid to set this field
+	// if the Protocol field is "HTTP", "TCP", or "UDP".
+	//
+	// The association of SNIs to Certificate defined in ListenerTLSConfig is
+	// defined based on the Hostname field for this listener.
+	//
+	// The GatewayClass MUST use the longest matching SNI out of all
+	// available certificates for any TLS handshake.
+	//
+	TLS *apisv1.ListenerTLSConfigApplyConfiguration `json:"tls,omitempty"`
+	// AllowedRoutes defines the types of routes that MAY be attached to a
+	// Listener and the trusted namespaces where those Route resources MAY be
+	// present.
+	//
+	// Although a client request may match multiple route rules, only one rule
+	// may ultimately receive the request. Matching precedence MUST be
+	// determined in order of the following criteria:
+	//
+	// * The most specific match as defined by the Route type.
+	// * The oldest Route based on creation timestamp. For example, a Route with
+	// a creation timestamp of "2020-09-08 01:02:03" is given precedence over
+	// a Route with a creation timestamp of "2020-09-08 01:02:04".
+	// * If everything else is equivalent, the Route appearing first in
+	// alphabetical order (namespace/name) should be given precedence. For
+	// example, foo/bar is given precedence over foo/baz.
+	//
+	// All valid rules within a Route attached to this Listener should be
+	// implemented. Invalid Route rules can be ignored (sometimes that will mean
+	// the full Route). If a Route rule transitions from valid to invalid,
+	// support for that Route rule should be dropped to ensure consistency. For
+	// example, even if a filter specified by a Route rule is invalid, the rest
+	// of the rules within that Route should still be supported.
+	//
+	AllowedRoutes *AllowedRoutesApplyConfiguration `json:"allowedRoutes,omitempty"`
+}
+
+// EnterpriseListenerEntryApplyConfiguration constructs a declarative configuration of the EnterpriseListenerEntry type for use with
+// apply.
+func EnterpriseListenerEntry() *EnterpriseListenerEntryApplyConfiguration {
+	return &EnterpriseListenerEntryApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *EnterpriseListenerEntryApplyConfiguration) WithName(value v1.SectionName) *EnterpriseListenerEntryApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithHostname sets the Hostname field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hostname field is set to the value of the last call.
+func (b *EnterpriseListenerEntryApplyConfiguration) WithHostname(value v1.Hostname) *EnterpriseListenerEntryApplyConfiguration {
+	b.Hostname = &value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *EnterpriseListenerEntryApplyConfiguration) WithPort(value v1alpha1enterprisesolo.PortNumber) *EnterpriseListenerEntryApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithProtocol sets the Protocol field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Protocol field is set to the value of the last call.
+func (b *EnterpriseListenerEntryApplyConfiguration) WithProtocol(value v1.ProtocolType) *EnterpriseListenerEntryApplyConfiguration {
+	b.Protocol = &value
+	return b
+}
+
+// WithTLS sets the TLS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLS field is set to the value of the last call.
+func (b *EnterpriseListenerEntryApplyConfiguration) WithTLS(value *apisv1.ListenerTLSConfigApplyConfiguration) *EnterpriseListenerEntryApplyConfiguration {
+	b.TLS = value
+	return b
+}
+
+// WithAllowedRoutes sets the AllowedRoutes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AllowedRoutes field is set to the value of the last call.
+func (b *EnterpriseListenerEntryApplyConfiguration) WithAllowedRoutes(value *AllowedRoutesApplyConfiguration) *EnterpriseListenerEntryApplyConfiguration {
+	b.AllowedRoutes = value
+	return b
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisesolo
+
+import (
+	v1alpha1enterprisesolo "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
+	applyconfigurationapisv1 "sigs.k8s.io/gateway-api/applyconfiguration/apis/v1"
+)
+
+// EnterpriseListenerEntryStatusApplyConfiguration represents a declarative configuration of the EnterpriseListenerEntryStatus type for use
+// with apply.
+//
+// ListenerStatus is the status associated with a Listener.
+type EnterpriseListenerEntryStatusApplyConfiguration struct {
+	// Conditions describe the current condition of this listener.
+	//
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// Name is the name of the Listener that this status corresponds to.
+	Name *apisv1.SectionName `json:"name,omitempty"`
+	// Port is the network port the listener is configured to listen on.
+	//
+	Port *v1alpha1enterprisesolo.PortNumber `json:"port,omitempty"`
+	// SupportedKinds is the list indicating the Kinds supported by this
+	// listener. This MUST represent the kinds an implementation supports for
+	// that Listener configuration.
+	//
+	// If kinds are specified in Spec that are not supported, they MUST NOT
+	// appear in this list and an implementation MUST set the "ResolvedRefs"
+	// condition to "False" with the "InvalidRouteKinds" reason. If both valid
+	// and invalid Route kinds are specified, the implementation MUST
+	// reference the valid Route kinds that have been specified.
+	//
+	SupportedKinds []applyconfigurationapisv1.RouteGroupKindApplyConfiguration `json:"supportedKinds,omitempty"`
+	// AttachedRoutes represents the total number of Routes that have been
+	// successfully attached to this Listener.
+	//
+	// Successful attachment of a Route to a Listener is based solely on the
+	// combination of the AllowedRoutes field on the corresponding Listener
+	// and the Route's ParentRefs field. A Route is successfully attached to
+	// a Listener when it is selected by the Listener's AllowedRoutes field
+	// AND the Route has a valid ParentRef selecting the whole Gateway
+	// resource or a specific Listener as a parent resource (more detail on
+	// attachment semantics can be found in the documentation on the various
+	// Route kinds ParentRefs fields). Listener or Route status does not impact
+	// successful attachment, i.e. the AttachedRoutes field count MUST be set
+	// for Listeners with condition Accepted: false and MUST count successfully
+	// attached Routes that may themselves have Accepted: false conditions.
+	//
+	// Uses for this field include troubleshooting Route attachment and
+	// measuring blast radius/impact of changes to a Listener.
+	AttachedRoutes *int32 `json:"attachedRoutes,omitempty"`
+}
+
+// EnterpriseListenerEntryStatusApplyConfiguration constructs a declarative configuration of the EnterpriseListenerEntryStatus type for use with
+// apply.
+func EnterpriseListenerEntryStatus() *EnterpriseListenerEntryStatusApplyConfiguration {
+	return &EnterpriseListenerEntryStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *EnterpriseListenerEntryStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *EnterpriseListenerEntryStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *EnterpriseListenerEntryStatusApplyConfiguration) WithName(value apisv1.SectionName) *EnterpriseListenerEntryStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *EnterpriseListenerEntryStatusApplyConfiguration) WithPort(value v1alpha1enterprisesolo.PortNumber) *EnterpriseListenerEntryStatusApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithSupportedKinds adds the given value to the SupportedKinds field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SupportedKinds field.
+func (b *EnterpriseListenerEntryStatusApplyConfiguration) WithSupportedKinds(values ...*applyconfigurationapisv1.RouteGroupKindApplyConfiguration) *EnterpriseListenerEntryStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSupportedKinds")
+		}
+		b.SupportedKinds = append(b.SupportedKinds, *values[i])
+	}
+	return b
+}
+
+// WithAttachedRoutes sets the AttachedRoutes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AttachedRoutes field is set to the value of the last call.
+func (b *EnterpriseListenerEntryStatusApplyConfiguration) WithAttachedRoutes(value int32) *EnterpriseListenerEntryStatusApplyConfiguration {
+	b.AttachedRoutes = &value
+	return b
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisesolo
+
+// EnterpriseListenerSetSpecApplyConfiguration represents a declarative configuration of the EnterpriseListenerSetSpec type for use
+// with apply.
+//
+// EnterpriseListenerSetSpec defines the desired state of a ListenerSet.
+type EnterpriseListenerSetSpecApplyConfiguration struct {
+	// ParentRef references the Gateway that the listeners are attached to.
+	ParentRef *ParentGatewayReferenceApplyConfiguration `json:"parentRef,omitempty"`
+	// Listeners associated with this ListenerSet. Listeners define
+	// logical endpoints that are bound on this referenced parent Gateway's addresses.
+	//
+	// Listeners in a `Gateway` and their attached `ListenerSets` are concatenated
+	// as a list when programming the underlying infrastructure. Each listener
+	// name does not need to be unique across the Gateway and ListenerSets.
+	// See ListenerEntry.Name for more details.
+	//
+	// Implementations MUST treat the parent Gateway as having the merged
+	// list of all listeners from itself and attached ListenerSets using
+	// the following precedence:
+	//
+	// 1. "parent" Gateway
+	// 2. ListenerSet ordered by creation time (oldest first)
+	// 3. ListenerSet ordered alphabetically by "{namespace}/{name}".
+	//
+	// An implementation MAY reject listeners by setting the ListenerEntryStatus
+	// `Accepted` condition to False with the Reason `TooManyListeners`
+	//
+	// If a listener has a conflict, this will be reported in the
+	// Status.ListenerEntryStatus setting the `Conflicted` condition to True.
+	//
+	// Implementations SHOULD be cautious about what information from the
+	// parent or siblings are reported to avoid accidentally leaking
+	// sensitive information that the child would not otherwise have access
+	// to. This can include contents of secrets etc.
+	//
+	Listeners []EnterpriseListenerEntryApplyConfiguration `json:"listeners,omitempty"`
+}
+
+// EnterpriseListenerSetSpecApplyConfiguration constructs a declarative configuration of the EnterpriseListenerSetSpec type for use with
+// apply.
+func EnterpriseListenerSetSpec() *EnterpriseListenerSetSpecApplyConfiguration {
+	return &EnterpriseListenerSetSpecApplyConfiguration{}
+}
+
+// WithParentRef sets the ParentRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParentRef field is set to the value of the last call.
+func (b *EnterpriseListenerSetSpecApplyConfiguration) WithParentRef(value *ParentGatewayReferenceApplyConfiguration) *EnterpriseListenerSetSpecApplyConfiguration {
+	b.ParentRef = value
+	return b
+}
+
+// WithListeners adds the given value to the Listeners field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Listeners field.
+func (b *EnterpriseListenerSetSpecApplyConfiguration) WithListeners(values ...*EnterpriseListenerEntryApplyConfiguration) *EnterpriseListenerSetSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithListeners")
+		}
+		b.Listeners = append(b.Listeners, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package enterprisesolo
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// EnterpriseListenerSetStatusApplyConfiguration represents a declarative configuration of the EnterpriseListenerSetStatus type for use
+// with apply.
+type EnterpriseListenerSetStatusApplyConfiguration struct {
+	// Conditions describe the current conditions of the ListenerSet.
+	//
+	// Implementations MUST express ListenerSet conditions using the
+	// `ListenerSetConditionType` and `ListenerSetConditionReason`
+	// constants so that operators and tools can converge on a common
+	// vocabulary to describe ListenerSet state.
+	//
+	// Known condition types are:
+	//
+	// * "Accepted"
+	// * "Programmed"
+	//
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// Listeners provide status for each unique listener port defined in the Spec.
+	//
+	Listeners []EnterpriseListenerEntryStatusApplyConfiguration `json:"listeners,omitempty"`
+}
+
+// EnterpriseListenerSetStatusApplyConfiguration constructs a declarative configuration of the EnterpriseListenerSetStatus type for use with
+// apply.
+func EnterpriseListenerSetStatus() *EnterpriseListenerSetStatusApplyConfiguration {
+	return &EnterpriseListenerSetStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *EnterpriseListenerSetStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *EnterpriseListenerSetStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithListeners adds the given value to the Listeners field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Listeners field.
+func (b *EnterpriseListenerSetStatusApplyConfiguration) WithListeners(values ...*EnterpriseListenerEntryStatusApplyConfiguration) *EnterpriseListenerSetStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithListeners")
+		}
+		b.Listeners = append(b.Listeners, *values[i])
+	}
+	return b
+}
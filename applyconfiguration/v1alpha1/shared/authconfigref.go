@@ -0,0 +1,56 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package shared
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// AuthConfigRefApplyConfiguration represents a declarative configuration of the AuthConfigRef type for use
+// with apply.
+//
+// AuthConfigRef selects the AuthConfig resource with the external auth policy that you want to use. For more details, see the [AuthConfig reference in the Gloo Edge docs](https://docs.solo.io/gloo-edge/main/reference/api/github.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/extauth/v1/extauth.proto.sk/#authconfig).
+type AuthConfigRefApplyConfiguration struct {
+	// Name is the name of the AuthConfig resource.
+	Name *v1.ObjectName `json:"name,omitempty"`
+	// Namespace is the namespace of the AuthConfig resource.
+	// If not set, defaults to the namespace of the policy from which the AuthConfig is referenced.
+	Namespace *v1.Namespace `json:"namespace,omitempty"`
+}
+
+// AuthConfigRefApplyConfiguration constructs a declarative configuration of the AuthConfigRef type for use with
+// apply.
+func AuthConfigRef() *AuthConfigRefApplyConfiguration {
+	return &AuthConfigRefApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *AuthConfigRefApplyConfiguration) WithName(value v1.ObjectName) *AuthConfigRefApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *AuthConfigRefApplyConfiguration) WithNamespace(value v1.Namespace) *AuthConfigRefApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
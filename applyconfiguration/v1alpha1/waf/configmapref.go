@@ -0,0 +1,64 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package waf
+
+// ConfigMapRefApplyConfiguration represents a declarative configuration of the ConfigMapRef type for use
+// with apply.
+//
+// ConfigMapRef contains a reference to a ConfigMap, and optionally specific keys within the ConfigMap.
+type ConfigMapRefApplyConfiguration struct {
+	// Name is the name of the ConfigMap.
+	Name *string `json:"name,omitempty"`
+	// Namespace is the namespace of the ConfigMap.
+	Namespace *string `json:"namespace,omitempty"`
+	// Keys is a list of keys to use from the ConfigMap.
+	// If not set, the values of all keys in the ConfigMap will be used, in lexicographic order by key.
+	Keys []string `json:"keys,omitempty"`
+}
+
+// ConfigMapRefApplyConfiguration constructs a declarative configuration of the ConfigMapRef type for use with
+// apply.
+func ConfigMapRef() *ConfigMapRefApplyConfiguration {
+	return &ConfigMapRefApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ConfigMapRefApplyConfiguration) WithName(value string) *ConfigMapRefApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *ConfigMapRefApplyConfiguration) WithNamespace(value string) *ConfigMapRefApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithKeys adds the given value to the Keys field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Keys field.
+func (b *ConfigMapRefApplyConfiguration) WithKeys(values ...string) *ConfigMapRefApplyConfiguration {
+	for i := range values {
+		b.Keys = append(b.Keys, values[i])
+	}
+	return b
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package waf
+
+// CoreRuleSetApplyConfiguration represents a declarative configuration of the CoreRuleSet type for use
+// with apply.
+//
+// CoreRuleSet specifies custom settings for the OWASP CoreRuleSet.
+type CoreRuleSetApplyConfiguration struct {
+	// Settings are settings that apply to the CoreRuleSet.
+	// For an example Coraza-compatible CoreRuleSet settings file, see: https://github.com/corazawaf/coraza-coreruleset/blob/v4.23.0/rules/%40crs-setup.conf.example
+	Settings *DirectiveSourceApplyConfiguration `json:"settings,omitempty"`
+}
+
+// CoreRuleSetApplyConfiguration constructs a declarative configuration of the CoreRuleSet type for use with
+// apply.
+func CoreRuleSet() *CoreRuleSetApplyConfiguration {
+	return &CoreRuleSetApplyConfiguration{}
+}
+
+// WithSettings sets the Settings field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Settings field is set to the value of the last call.
+func (b *CoreRuleSetApplyConfiguration) WithSettings(value *DirectiveSourceApplyConfiguration) *CoreRuleSetApplyConfiguration {
+	b.Settings = value
+	return b
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package waf
+
+// CustomInterventionResponseApplyConfiguration represents a declarative configuration of the CustomInterventionResponse type for use
+// with apply.
+//
+// CustomInterventionResponse defines the response returned when WAF blocks a request or response.
+type CustomInterventionResponseApplyConfiguration struct {
+	// StatusCode overrides the HTTP status code returned when WAF blocks a request or response.
+	// If not set, the status code defined by the triggered WAF rule is used.
+	StatusCode *int32 `json:"statusCode,omitempty"`
+	// Headers sets the response headers returned when WAF blocks a request or response.
+	// If not set, no header modifications are made.
+	Headers *CustomInterventionResponseHeadersApplyConfiguration `json:"headers,omitempty"`
+	// Body overrides the response body returned when WAF blocks a request or response.
+	// If not set, the response body is empty.
+	Body *string `json:"body,omitempty"`
+}
+
+// CustomInterventionResponseApplyConfiguration constructs a declarative configuration of the CustomInterventionResponse type for use with
+// apply.
+func CustomInterventionResponse() *CustomInterventionResponseApplyConfiguration {
+	return &CustomInterventionResponseApplyConfiguration{}
+}
+
+// WithStatusCode sets the StatusCode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StatusCode field is set to the value of the last call.
+func (b *CustomInterventionResponseApplyConfiguration) WithStatusCode(value int32) *CustomInterventionResponseApplyConfiguration {
+	b.StatusCode = &value
+	return b
+}
+
+// WithHeaders sets the Headers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Headers field is set to the value of the last call.
+func (b *CustomInterventionResponseApplyConfiguration) WithHeaders(value *CustomInterventionResponseHeadersApplyConfiguration) *CustomInterventionResponseApplyConfiguration {
+	b.Headers = value
+	return b
+}
+
+// WithBody sets the Body field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Body field is set to the value of the last call.
+func (b *CustomInterventionResponseApplyConfiguration) WithBody(value string) *CustomInterventionResponseApplyConfiguration {
+	b.Body = &value
+	return b
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package waf
+
+// CustomInterventionResponseHeaderApplyConfiguration represents a declarative configuration of the CustomInterventionResponseHeader type for use
+// with apply.
+//
+// CustomInterventionResponseHeader defines a single header returned when WAF blocks a request.
+type CustomInterventionResponseHeaderApplyConfiguration struct {
+	// Name is the header name.
+	Name *string `json:"name,omitempty"`
+	// Value is the header value.
+	Value *string `json:"value,omitempty"`
+}
+
+// CustomInterventionResponseHeaderApplyConfiguration constructs a declarative configuration of the CustomInterventionResponseHeader type for use with
+// apply.
+func CustomInterventionResponseHeader() *CustomInterventionResponseHeaderApplyConfiguration {
+	return &CustomInterventionResponseHeaderApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *CustomInterventionResponseHeaderApplyConfiguration) WithName(value string) *CustomInterventionResponseHeaderApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *CustomInterventionResponseHeaderApplyConfiguration) WithValue(value string) *CustomInterventionResponseHeaderApplyConfiguration {
+	b.Value = &value
+	return b
+}
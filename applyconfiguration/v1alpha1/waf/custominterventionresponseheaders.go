@@ -0,0 +1,46 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package waf
+
+// CustomInterventionResponseHeadersApplyConfiguration represents a declarative configuration of the CustomInterventionResponseHeaders type for use
+// with apply.
+//
+// CustomInterventionResponseHeaders defines headers returned when WAF blocks a request.
+type CustomInterventionResponseHeadersApplyConfiguration struct {
+	// SetHeaders is the list of headers to set on the response.
+	SetHeaders []CustomInterventionResponseHeaderApplyConfiguration `json:"setHeaders,omitempty"`
+}
+
+// CustomInterventionResponseHeadersApplyConfiguration constructs a declarative configuration of the CustomInterventionResponseHeaders type for use with
+// apply.
+func CustomInterventionResponseHeaders() *CustomInterventionResponseHeadersApplyConfiguration {
+	return &CustomInterventionResponseHeadersApplyConfiguration{}
+}
+
+// WithSetHeaders adds the given value to the SetHeaders field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SetHeaders field.
+func (b *CustomInterventionResponseHeadersApplyConfiguration) WithSetHeaders(values ...*CustomInterventionResponseHeaderApplyConfiguration) *CustomInterventionResponseHeadersApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSetHeaders")
+		}
+		b.SetHeaders = append(b.SetHeaders, *values[i])
+	}
+	return b
+}
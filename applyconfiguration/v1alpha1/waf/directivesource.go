@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package waf
+
+// DirectiveSourceApplyConfiguration represents a declarative configuration of the DirectiveSource type for use
+// with apply.
+//
+// DirectiveSource is a set of directives (e.g. rules or settings) to provide to the WAF engine.
+type DirectiveSourceApplyConfiguration struct {
+	// Inline specifies custom directives as an inline string.
+	Inline *string `json:"inline,omitempty"`
+	// ConfigMap is a reference to a ConfigMap containing custom directives.
+	ConfigMap *ConfigMapRefApplyConfiguration `json:"configMap,omitempty"`
+}
+
+// DirectiveSourceApplyConfiguration constructs a declarative configuration of the DirectiveSource type for use with
+// apply.
+func DirectiveSource() *DirectiveSourceApplyConfiguration {
+	return &DirectiveSourceApplyConfiguration{}
+}
+
+// WithInline sets the Inline field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Inline field is set to the value of the last call.
+func (b *DirectiveSourceApplyConfiguration) WithInline(value string) *DirectiveSourceApplyConfiguration {
+	b.Inline = &value
+	return b
+}
+
+// WithConfigMap sets the ConfigMap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMap field is set to the value of the last call.
+func (b *DirectiveSourceApplyConfiguration) WithConfigMap(value *ConfigMapRefApplyConfiguration) *DirectiveSourceApplyConfiguration {
+	b.ConfigMap = value
+	return b
+}
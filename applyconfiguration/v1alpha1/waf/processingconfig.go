@@ -0,0 +1,53 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package waf
+
+// ProcessingConfigApplyConfiguration represents a declarative configuration of the ProcessingConfig type for use
+// with apply.
+//
+// ProcessingConfig configures how request and response traffic is inspected by WAF.
+type ProcessingConfigApplyConfiguration struct {
+	// Request configures how request traffic is inspected by WAF.
+	// If not set, defaults to inspect headers only.
+	Request *RequestProcessingConfigApplyConfiguration `json:"request,omitempty"`
+	// Response configures how response traffic is inspected by WAF.
+	// If not set, defaults to inspect headers only.
+	Response *ResponseProcessingConfigApplyConfiguration `json:"response,omitempty"`
+}
+
+// ProcessingConfigApplyConfiguration constructs a declarative configuration of the ProcessingConfig type for use with
+// apply.
+func ProcessingConfig() *ProcessingConfigApplyConfiguration {
+	return &ProcessingConfigApplyConfiguration{}
+}
+
+// WithRequest sets the Request field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Request field is set to the value of the last call.
+func (b *ProcessingConfigApplyConfiguration) WithRequest(value *RequestProcessingConfigApplyConfiguration) *ProcessingConfigApplyConfiguration {
+	b.Request = value
+	return b
+}
+
+// WithResponse sets the Response field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Response field is set to the value of the last call.
+func (b *ProcessingConfigApplyConfiguration) WithResponse(value *ResponseProcessingConfigApplyConfiguration) *ProcessingConfigApplyConfiguration {
+	b.Response = value
+	return b
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package waf
+
+import (
+	v1alpha1waf "github.com/solo-io/kgateway-client/v2/api/v1alpha1/waf"
+)
+
+// RequestProcessingConfigApplyConfiguration represents a declarative configuration of the RequestProcessingConfig type for use
+// with apply.
+//
+// RequestProcessingConfig configures how request traffic is inspected by WAF.
+type RequestProcessingConfigApplyConfiguration struct {
+	// Mode controls which parts of a request are inspected by WAF.
+	// If not set, defaults to Headers.
+	Mode *v1alpha1waf.RequestProcessingMode `json:"mode,omitempty"`
+}
+
+// RequestProcessingConfigApplyConfiguration constructs a declarative configuration of the RequestProcessingConfig type for use with
+// apply.
+func RequestProcessingConfig() *RequestProcessingConfigApplyConfiguration {
+	return &RequestProcessingConfigApplyConfiguration{}
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *RequestProcessingConfigApplyConfiguration) WithMode(value v1alpha1waf.RequestProcessingMode) *RequestProcessingConfigApplyConfiguration {
+	b.Mode = &value
+	return b
+}
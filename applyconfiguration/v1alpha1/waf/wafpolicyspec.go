@@ -0,0 +1,93 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package waf
+
+// WAFPolicySpecApplyConfiguration represents a declarative configuration of the WAFPolicySpec type for use
+// with apply.
+//
+// WAFPolicySpec contains Web Application Firewall configuration.
+type WAFPolicySpecApplyConfiguration struct {
+	// CoreRuleSet contains settings for the OWASP CoreRuleSet.
+	// If set, then the OWASP CoreRuleSet rules will be loaded.
+	// The bundled WAF extproc server uses the [v4 CoreRuleSet rules](https://github.com/coreruleset/coreruleset/tree/v4.0/main/rules).
+	CoreRuleSet *CoreRuleSetApplyConfiguration `json:"coreRuleSet,omitempty"`
+	// RuleEngineSettings are settings to configure the WAF rule engine.
+	// For an example Coraza-compatible rule engine settings file, see: https://github.com/corazawaf/coraza-coreruleset/blob/v4.23.0/rules/%40coraza.conf-recommended
+	RuleEngineSettings *DirectiveSourceApplyConfiguration `json:"ruleEngineSettings,omitempty"`
+	// ProcessingConfig configures how request and response traffic is inspected by WAF.
+	// If not set, request headers and response headers will be inspected.
+	ProcessingConfig *ProcessingConfigApplyConfiguration `json:"processingConfig,omitempty"`
+	// CustomDirectives is a list of custom directives to apply.
+	// Custom directives will be applied after the CoreRuleSet rules and settings (if enabled) and WAF rule engine settings,
+	// and can be used to modify/exclude CoreRuleSet rules or add custom rules, for example.
+	CustomDirectives []DirectiveSourceApplyConfiguration `json:"customDirectives,omitempty"`
+	// CustomInterventionResponse is a custom response to send when a request is blocked by WAF.
+	// If not set, returns the status code defined by the WAF rule that was triggered.
+	CustomInterventionResponse *CustomInterventionResponseApplyConfiguration `json:"customInterventionResponse,omitempty"`
+}
+
+// WAFPolicySpecApplyConfiguration constructs a declarative configuration of the WAFPolicySpec type for use with
+// apply.
+func WAFPolicySpec() *WAFPolicySpecApplyConfiguration {
+	return &WAFPolicySpecApplyConfiguration{}
+}
+
+// WithCoreRuleSet sets the CoreRuleSet field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CoreRuleSet field is set to the value of the last call.
+func (b *WAFPolicySpecApplyConfiguration) WithCoreRuleSet(value *CoreRuleSetApplyConfiguration) *WAFPolicySpecApplyConfiguration {
+	b.CoreRuleSet = value
+	return b
+}
+
+// WithRuleEngineSettings sets the RuleEngineSettings field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RuleEngineSettings field is set to the value of the last call.
+func (b *WAFPolicySpecApplyConfiguration) WithRuleEngineSettings(value *DirectiveSourceApplyConfiguration) *WAFPolicySpecApplyConfiguration {
+	b.RuleEngineSettings = value
+	return b
+}
+
+// WithProcessingConfig sets the ProcessingConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProcessingConfig field is set to the value of the last call.
+func (b *WAFPolicySpecApplyConfiguration) WithProcessingConfig(value *ProcessingConfigApplyConfiguration) *WAFPolicySpecApplyConfiguration {
+	b.ProcessingConfig = value
+	return b
+}
+
+// WithCustomDirectives adds the given value to the CustomDirectives field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the CustomDirectives field.
+func (b *WAFPolicySpecApplyConfiguration) WithCustomDirectives(values ...*DirectiveSourceApplyConfiguration) *WAFPolicySpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithCustomDirectives")
+		}
+		b.CustomDirectives = append(b.CustomDirectives, *values[i])
+	}
+	return b
+}
+
+// WithCustomInterventionResponse sets the CustomInterventionResponse field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CustomInterventionResponse field is set to the value of the last call.
+func (b *WAFPolicySpecApplyConfiguration) WithCustomInterventionResponse(value *CustomInterventionResponseApplyConfiguration) *WAFPolicySpecApplyConfiguration {
+	b.CustomInterventionResponse = value
+	return b
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package waf
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	apisv1 "sigs.k8s.io/gateway-api/applyconfiguration/apis/v1"
+)
+
+// WAFPolicyStatusApplyConfiguration represents a declarative configuration of the WAFPolicyStatus type for use
+// with apply.
+//
+// WAFPolicyStatus contains WAF server acceptance status and per-ancestor (traffic policy) attachment status.
+type WAFPolicyStatusApplyConfiguration struct {
+	// Conditions contains information about WAF server acceptance of the WAFPolicy.
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// Ancestors contains attachment information for referencing resources.
+	Ancestors []apisv1.PolicyAncestorStatusApplyConfiguration `json:"ancestors,omitempty"`
+}
+
+// WAFPolicyStatusApplyConfiguration constructs a declarative configuration of the WAFPolicyStatus type for use with
+// apply.
+func WAFPolicyStatus() *WAFPolicyStatusApplyConfiguration {
+	return &WAFPolicyStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *WAFPolicyStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *WAFPolicyStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithAncestors adds the given value to the Ancestors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Ancestors field.
+func (b *WAFPolicyStatusApplyConfiguration) WithAncestors(values ...*apisv1.PolicyAncestorStatusApplyConfiguration) *WAFPolicyStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAncestors")
+		}
+		b.Ancestors = append(b.Ancestors, *values[i])
+	}
+	return b
+}
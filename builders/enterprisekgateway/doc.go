@@ -0,0 +1,24 @@
+// Package enterprisekgateway provides chainable builders for EnterpriseKgatewayTrafficPolicy
+// objects and the configuration nested in them: target references, JWT providers, RBAC
+// policies, WAF and ext-auth references, and staged transformations.
+//
+// Builders only record what they are given. Build checks the result against the OpenAPI
+// and oneOf markers of the API types, the CRD's CEL rules (see the celvalidation package)
+// and the Inja templates of transformations (see the transformation package), and reports
+// every violation with its field path, so that a built policy is accepted by the API server:
+//
+//	policy, err := enterprisekgateway.NewTrafficPolicy("default", "petstore").
+//		TargetRefs(enterprisekgateway.HTTPRouteTarget("petstore")).
+//		JWTBeforeExtAuth(enterprisekgateway.NewJWT().
+//			Provider("idp", enterprisekgateway.NewJWTProvider().
+//				Issuer("https://idp.example.com").
+//				RemoteJWKS("https://idp.example.com/.well-known/jwks.json", "idp", 443))).
+//		RBACPolicy("admins", enterprisekgateway.NewRBACPolicy().
+//			Claims(map[string]string{"groups": "admins"}).
+//			Methods("GET", "POST")).
+//		WAFPolicy("", "owasp-crs").
+//		Build()
+//
+// The nested builders may also be built on their own, for example to compose a policy
+// by hand; their errors are then reported relative to the built value.
+package enterprisekgateway
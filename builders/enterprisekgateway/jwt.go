@@ -0,0 +1,227 @@
+package enterprisekgateway
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	upstreamshared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	enterprisekgatewayv1alpha1 "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/celvalidation"
+)
+
+// JWTBuilder builds the JWT configuration of one stage of a traffic policy.
+type JWTBuilder struct {
+	jwt       enterprisekgatewayv1alpha1.EntJWT
+	providers map[string]*JWTProviderBuilder
+}
+
+// NewJWT returns a builder for a JWT configuration without providers.
+func NewJWT() *JWTBuilder {
+	return &JWTBuilder{providers: map[string]*JWTProviderBuilder{}}
+}
+
+// Provider adds a provider under the given name, replacing any provider of that name.
+// Requests are authenticated if their JWT is valid for any of the providers.
+func (b *JWTBuilder) Provider(name string, provider *JWTProviderBuilder) *JWTBuilder {
+	b.providers[name] = provider
+	return b
+}
+
+// ValidationPolicy sets how requests with missing or invalid JWTs are handled.
+func (b *JWTBuilder) ValidationPolicy(policy enterprisekgatewayv1alpha1.JwtValidationPolicy) *JWTBuilder {
+	b.jwt.ValidationPolicy = ptr.To(policy)
+	return b
+}
+
+// Disable disables JWT authentication, such as one configured at a higher level. It cannot
+// be combined with providers or a validation policy.
+func (b *JWTBuilder) Disable() *JWTBuilder {
+	b.jwt.Disable = &upstreamshared.PolicyDisable{}
+	return b
+}
+
+// Build returns the JWT configuration, or an error if it is not valid.
+func (b *JWTBuilder) Build() (*enterprisekgatewayv1alpha1.EntJWT, error) {
+	jwt, allErrs := b.build(nil)
+	allErrs = append(allErrs, jwt.Validate()...)
+	allErrs = append(allErrs, celvalidation.Validate(jwt)...)
+	if len(allErrs) > 0 {
+		return nil, allErrs.ToAggregate()
+	}
+	return jwt, nil
+}
+
+func (b *JWTBuilder) build(fldPath *field.Path) (*enterprisekgatewayv1alpha1.EntJWT, field.ErrorList) {
+	var allErrs field.ErrorList
+	jwt := b.jwt.DeepCopy()
+	for _, name := range slices.Sorted(maps.Keys(b.providers)) {
+		p, errs := b.providers[name].build(fldPath.Child("providers").Key(name))
+		allErrs = append(allErrs, errs...)
+		if jwt.Providers == nil {
+			jwt.Providers = map[string]enterprisekgatewayv1alpha1.JWTProvider{}
+		}
+		jwt.Providers[name] = p
+	}
+	return jwt, allErrs
+}
+
+// JWTProviderBuilder builds a JWT provider: where the keys that sign its tokens come from,
+// which tokens it accepts and what is done with their claims.
+type JWTProviderBuilder struct {
+	provider      enterprisekgatewayv1alpha1.JWTProvider
+	cacheDuration *time.Duration
+	fastListener  *bool
+}
+
+// NewJWTProvider returns a builder for a JWT provider. One of LocalJWKS and RemoteJWKS must
+// be called before it is built.
+func NewJWTProvider() *JWTProviderBuilder {
+	return &JWTProviderBuilder{}
+}
+
+// LocalJWKS sets the keys of the provider inline, as a JSON Web Key, key set or PEM key.
+func (b *JWTProviderBuilder) LocalJWKS(key string) *JWTProviderBuilder {
+	b.provider.JWKS = enterprisekgatewayv1alpha1.JWKS{
+		Local: &enterprisekgatewayv1alpha1.LocalJWKS{Key: key},
+	}
+	return b
+}
+
+// RemoteJWKS sets the URL the keys of the provider are fetched from, through the Service
+// of the given name and port in the namespace of the policy.
+func (b *JWTProviderBuilder) RemoteJWKS(url, service string, port int32) *JWTProviderBuilder {
+	return b.RemoteJWKSBackend(url, gwv1.BackendRef{
+		BackendObjectReference: gwv1.BackendObjectReference{
+			Name: gwv1.ObjectName(service),
+			Port: ptr.To(gwv1.PortNumber(port)),
+		},
+	})
+}
+
+// RemoteJWKSBackend sets the URL the keys of the provider are fetched from, through the
+// given backend.
+func (b *JWTProviderBuilder) RemoteJWKSBackend(url string, backendRef gwv1.BackendRef) *JWTProviderBuilder {
+	b.provider.JWKS = enterprisekgatewayv1alpha1.JWKS{
+		Remote: &enterprisekgatewayv1alpha1.RemoteJWKS{Url: url, BackendRef: backendRef},
+	}
+	return b
+}
+
+// CacheDuration sets how long remote keys are cached. It requires RemoteJWKS.
+func (b *JWTProviderBuilder) CacheDuration(d time.Duration) *JWTProviderBuilder {
+	b.cacheDuration = &d
+	return b
+}
+
+// AsyncFetch fetches remote keys before the listener is activated, rather than on the
+// first requests. If fastListener is set, the listener does not wait for the first fetch
+// to complete. It requires RemoteJWKS.
+func (b *JWTProviderBuilder) AsyncFetch(fastListener bool) *JWTProviderBuilder {
+	b.fastListener = &fastListener
+	return b
+}
+
+// Issuer sets the value the "iss" claim of tokens must have.
+func (b *JWTProviderBuilder) Issuer(issuer string) *JWTProviderBuilder {
+	b.provider.Issuer = &issuer
+	return b
+}
+
+// Audiences adds values one of which the "aud" claim of tokens must have.
+func (b *JWTProviderBuilder) Audiences(audiences ...string) *JWTProviderBuilder {
+	b.provider.Audiences = append(b.provider.Audiences, audiences...)
+	return b
+}
+
+// TokenFromHeader reads tokens from the given header, after the prefix, such as "Bearer ".
+func (b *JWTProviderBuilder) TokenFromHeader(header, prefix string) *JWTProviderBuilder {
+	source := enterprisekgatewayv1alpha1.TokenSourceHeaderSource{Header: header}
+	if prefix != "" {
+		source.Prefix = &prefix
+	}
+	b.tokenSource().Headers = append(b.tokenSource().Headers, source)
+	return b
+}
+
+// TokenFromQueryParam reads tokens from the given query parameter.
+func (b *JWTProviderBuilder) TokenFromQueryParam(name string) *JWTProviderBuilder {
+	b.tokenSource().QueryParams = append(b.tokenSource().QueryParams, name)
+	return b
+}
+
+func (b *JWTProviderBuilder) tokenSource() *enterprisekgatewayv1alpha1.TokenSource {
+	if b.provider.TokenSource == nil {
+		b.provider.TokenSource = &enterprisekgatewayv1alpha1.TokenSource{}
+	}
+	return b.provider.TokenSource
+}
+
+// KeepToken forwards tokens upstream instead of removing them from requests.
+func (b *JWTProviderBuilder) KeepToken() *JWTProviderBuilder {
+	b.provider.KeepToken = ptr.To(true)
+	return b
+}
+
+// ClaimToHeader copies a claim of verified tokens to a request header, appending to the
+// header if it exists and appendToHeader is set, and replacing it otherwise.
+func (b *JWTProviderBuilder) ClaimToHeader(claim, header string, appendToHeader bool) *JWTProviderBuilder {
+	c := enterprisekgatewayv1alpha1.ClaimToHeader{Claim: claim, Header: header}
+	if appendToHeader {
+		c.Append = ptr.To(true)
+	}
+	b.provider.ClaimsToHeaders = append(b.provider.ClaimsToHeaders, c)
+	return b
+}
+
+// ClockSkew sets the tolerance when checking the time claims of tokens, such as "exp". It
+// is truncated to seconds.
+func (b *JWTProviderBuilder) ClockSkew(d time.Duration) *JWTProviderBuilder {
+	b.provider.ClockSkewSeconds = ptr.To(int32(d / time.Second))
+	return b
+}
+
+// AttachFailedStatusToMetadata stores the status of failed authentications under the given
+// dynamic metadata key, for example to log it.
+func (b *JWTProviderBuilder) AttachFailedStatusToMetadata(key string) *JWTProviderBuilder {
+	b.provider.AttachFailedStatusToMetadata = &key
+	return b
+}
+
+// Build returns the JWT provider, or an error if it is not valid.
+func (b *JWTProviderBuilder) Build() (enterprisekgatewayv1alpha1.JWTProvider, error) {
+	provider, allErrs := b.build(nil)
+	allErrs = append(allErrs, provider.Validate()...)
+	allErrs = append(allErrs, celvalidation.Validate(&provider)...)
+	if len(allErrs) > 0 {
+		return enterprisekgatewayv1alpha1.JWTProvider{}, allErrs.ToAggregate()
+	}
+	return provider, nil
+}
+
+func (b *JWTProviderBuilder) build(fldPath *field.Path) (enterprisekgatewayv1alpha1.JWTProvider, field.ErrorList) {
+	var allErrs field.ErrorList
+	provider := *b.provider.DeepCopy()
+	remote := provider.JWKS.Remote
+	remotePath := fldPath.Child("jwks", "remote")
+	if b.cacheDuration != nil {
+		if remote == nil {
+			allErrs = append(allErrs, field.Required(remotePath, "cacheDuration requires a remote JWKS"))
+		} else {
+			remote.CacheDuration = &metav1.Duration{Duration: *b.cacheDuration}
+		}
+	}
+	if b.fastListener != nil {
+		if remote == nil {
+			allErrs = append(allErrs, field.Required(remotePath, "asyncFetch requires a remote JWKS"))
+		} else {
+			remote.AsyncFetch = &enterprisekgatewayv1alpha1.JwksAsyncFetch{FastListener: b.fastListener}
+		}
+	}
+	return provider, allErrs
+}
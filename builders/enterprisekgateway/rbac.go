@@ -0,0 +1,116 @@
+package enterprisekgateway
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	enterprisekgatewayv1alpha1 "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/celvalidation"
+)
+
+// RBACPolicyBuilder builds an RBAC policy, which grants permissions to the principals
+// authenticated by the JWT providers of a traffic policy.
+type RBACPolicyBuilder struct {
+	policy     enterprisekgatewayv1alpha1.RBACPolicy
+	principals []*JWTPrincipalBuilder
+}
+
+// NewRBACPolicy returns a builder for an RBAC policy. At least one principal must be added
+// before it is built.
+func NewRBACPolicy() *RBACPolicyBuilder {
+	return &RBACPolicyBuilder{}
+}
+
+// Principals adds principals to the policy. A request is allowed if it matches any of them.
+func (b *RBACPolicyBuilder) Principals(principals ...*JWTPrincipalBuilder) *RBACPolicyBuilder {
+	b.principals = append(b.principals, principals...)
+	return b
+}
+
+// Claims adds a principal matching tokens whose claims have all the given values.
+func (b *RBACPolicyBuilder) Claims(claims map[string]string) *RBACPolicyBuilder {
+	return b.Principals(JWTPrincipal(claims))
+}
+
+// PathPrefix restricts the permissions to requests whose path has the given prefix.
+func (b *RBACPolicyBuilder) PathPrefix(prefix string) *RBACPolicyBuilder {
+	b.permissions().PathPrefix = &prefix
+	return b
+}
+
+// Methods restricts the permissions to requests with one of the given HTTP methods.
+func (b *RBACPolicyBuilder) Methods(methods ...string) *RBACPolicyBuilder {
+	b.permissions().Methods = append(b.permissions().Methods, methods...)
+	return b
+}
+
+func (b *RBACPolicyBuilder) permissions() *enterprisekgatewayv1alpha1.RBACPermissions {
+	if b.policy.Permissions == nil {
+		b.policy.Permissions = &enterprisekgatewayv1alpha1.RBACPermissions{}
+	}
+	return b.policy.Permissions
+}
+
+// NestedClaimDelimiter sets the delimiter of nested claim names, such as "." for
+// "parent.child".
+func (b *RBACPolicyBuilder) NestedClaimDelimiter(delimiter string) *RBACPolicyBuilder {
+	b.policy.NestedClaimDelimiter = &delimiter
+	return b
+}
+
+// Build returns the RBAC policy, or an error if it is not valid.
+func (b *RBACPolicyBuilder) Build() (enterprisekgatewayv1alpha1.RBACPolicy, error) {
+	policy, allErrs := b.build(nil)
+	allErrs = append(allErrs, policy.Validate()...)
+	allErrs = append(allErrs, celvalidation.Validate(&policy)...)
+	if len(allErrs) > 0 {
+		return enterprisekgatewayv1alpha1.RBACPolicy{}, allErrs.ToAggregate()
+	}
+	return policy, nil
+}
+
+func (b *RBACPolicyBuilder) build(fldPath *field.Path) (enterprisekgatewayv1alpha1.RBACPolicy, field.ErrorList) {
+	var allErrs field.ErrorList
+	policy := *b.policy.DeepCopy()
+	principalsPath := fldPath.Child("principals")
+	for i, principal := range b.principals {
+		p, errs := principal.build(principalsPath.Index(i).Child("jwtPrincipal"))
+		allErrs = append(allErrs, errs...)
+		policy.Principals = append(policy.Principals, enterprisekgatewayv1alpha1.RBACPrincipal{JWTPrincipal: p})
+	}
+	return policy, allErrs
+}
+
+// JWTPrincipalBuilder builds a principal of an RBAC policy from the claims of JWTs.
+type JWTPrincipalBuilder struct {
+	principal enterprisekgatewayv1alpha1.RBACJWTPrincipal
+}
+
+// JWTPrincipal returns a builder for a principal matching tokens whose claims have all the
+// given values. Nested claims may be named with the nested claim delimiter of the policy.
+func JWTPrincipal(claims map[string]string) *JWTPrincipalBuilder {
+	b := &JWTPrincipalBuilder{}
+	b.principal.Claims = claims
+	return b
+}
+
+// Provider restricts the principal to tokens authenticated by the named JWT provider.
+func (b *JWTPrincipalBuilder) Provider(name string) *JWTPrincipalBuilder {
+	b.principal.Provider = &name
+	return b
+}
+
+// Matcher sets how claim values are compared, which is by exact string comparison by default.
+func (b *JWTPrincipalBuilder) Matcher(matcher enterprisekgatewayv1alpha1.RBACJWTPrincipalClaimMatcher) *JWTPrincipalBuilder {
+	b.principal.Matcher = ptr.To(matcher)
+	return b
+}
+
+func (b *JWTPrincipalBuilder) build(fldPath *field.Path) (enterprisekgatewayv1alpha1.RBACJWTPrincipal, field.ErrorList) {
+	principal := *b.principal.DeepCopy()
+	if len(principal.Claims) == 0 {
+		// Claims is required, and a principal without claims would match any token.
+		return principal, field.ErrorList{field.Required(fldPath.Child("claims"), "")}
+	}
+	return principal, nil
+}
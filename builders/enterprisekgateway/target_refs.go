@@ -0,0 +1,87 @@
+package enterprisekgateway
+
+import (
+	"strings"
+
+	upstreamshared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	// maxTargetRefs is the MaxItems bound on TrafficPolicySpec.TargetRefs.
+	maxTargetRefs = 16
+	// targetKindMessage is the message of the CEL rule on TrafficPolicySpec.TargetRefs.
+	targetKindMessage = "targetRefs may only reference Gateway, HTTPRoute, or ListenerSet resources"
+)
+
+// TargetRefBuilder builds a reference to the Gateway, HTTPRoute or ListenerSet that a
+// policy attaches to. The target must be in the namespace of the policy.
+type TargetRefBuilder struct {
+	ref upstreamshared.LocalPolicyTargetReferenceWithSectionName
+}
+
+// TargetRef returns a builder for a reference to the named object of the given group and kind.
+func TargetRef(group, kind, name string) *TargetRefBuilder {
+	b := &TargetRefBuilder{}
+	b.ref.Group = gwv1.Group(group)
+	b.ref.Kind = gwv1.Kind(kind)
+	b.ref.Name = gwv1.ObjectName(name)
+	return b
+}
+
+// GatewayTarget returns a builder for a reference to the named Gateway.
+func GatewayTarget(name string) *TargetRefBuilder {
+	return TargetRef(gwv1.GroupName, "Gateway", name)
+}
+
+// HTTPRouteTarget returns a builder for a reference to the named HTTPRoute.
+func HTTPRouteTarget(name string) *TargetRefBuilder {
+	return TargetRef(gwv1.GroupName, "HTTPRoute", name)
+}
+
+// SectionName restricts the reference to a section of the target, such as a listener of a
+// Gateway or a rule of an HTTPRoute.
+func (b *TargetRefBuilder) SectionName(name string) *TargetRefBuilder {
+	b.ref.SectionName = ptr.To(gwv1.SectionName(name))
+	return b
+}
+
+// Build returns the target reference, or an error if it is not a valid policy target.
+func (b *TargetRefBuilder) Build() (upstreamshared.LocalPolicyTargetReferenceWithSectionName, error) {
+	if errs := validateTargetRef(&b.ref, nil); len(errs) > 0 {
+		return upstreamshared.LocalPolicyTargetReferenceWithSectionName{}, errs.ToAggregate()
+	}
+	return b.ref, nil
+}
+
+// validateTargetRefs mirrors the markers on the upstream TrafficPolicySpec.TargetRefs,
+// whose CEL rules are not known to the celvalidation package.
+func validateTargetRefs(refs []upstreamshared.LocalPolicyTargetReferenceWithSectionName, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(refs) > maxTargetRefs {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(refs), maxTargetRefs))
+	}
+	for i := range refs {
+		allErrs = append(allErrs, validateTargetRef(&refs[i], fldPath.Index(i))...)
+	}
+	return allErrs
+}
+
+func validateTargetRef(ref *upstreamshared.LocalPolicyTargetReferenceWithSectionName, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch {
+	case ref.Kind == "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("kind"), ""))
+	case ref.Kind != "Gateway" && ref.Kind != "HTTPRoute" && !strings.HasSuffix(string(ref.Kind), "ListenerSet"):
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("kind"), ref.Kind, targetKindMessage))
+	}
+	if ref.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	}
+	if ref.SectionName != nil && *ref.SectionName == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("sectionName"), "", "sectionName must not be empty"))
+	}
+	return allErrs
+}
@@ -0,0 +1,277 @@
+package enterprisekgateway
+
+import (
+	"maps"
+	"slices"
+
+	upstreamshared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	enterprisekgatewayv1alpha1 "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/shared"
+	"github.com/solo-io/kgateway-client/v2/celvalidation"
+	"github.com/solo-io/kgateway-client/v2/transformation"
+)
+
+// TrafficPolicyBuilder builds an EnterpriseKgatewayTrafficPolicy.
+type TrafficPolicyBuilder struct {
+	policy enterprisekgatewayv1alpha1.EnterpriseKgatewayTrafficPolicy
+
+	targetRefs      []*TargetRefBuilder
+	jwtBefore       *JWTBuilder
+	jwtAfter        *JWTBuilder
+	rbac            map[string]*RBACPolicyBuilder
+	transformations *StagedTransformationsBuilder
+	specFuncs       []func(*enterprisekgatewayv1alpha1.EnterpriseKgatewayTrafficPolicySpec)
+}
+
+// NewTrafficPolicy returns a builder for a traffic policy of the given namespace and name.
+func NewTrafficPolicy(namespace, name string) *TrafficPolicyBuilder {
+	b := &TrafficPolicyBuilder{rbac: map[string]*RBACPolicyBuilder{}}
+	b.policy.APIVersion = enterprisekgatewayv1alpha1.SchemeGroupVersion.String()
+	b.policy.Kind = "EnterpriseKgatewayTrafficPolicy"
+	b.policy.Namespace = namespace
+	b.policy.Name = name
+	return b
+}
+
+// Labels adds labels to the policy.
+func (b *TrafficPolicyBuilder) Labels(labels map[string]string) *TrafficPolicyBuilder {
+	b.policy.Labels = merge(b.policy.Labels, labels)
+	return b
+}
+
+// Annotations adds annotations to the policy.
+func (b *TrafficPolicyBuilder) Annotations(annotations map[string]string) *TrafficPolicyBuilder {
+	b.policy.Annotations = merge(b.policy.Annotations, annotations)
+	return b
+}
+
+func merge(dst, src map[string]string) map[string]string {
+	if dst == nil && len(src) > 0 {
+		dst = make(map[string]string, len(src))
+	}
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
+
+// TargetRefs adds the Gateways, HTTPRoutes and ListenerSets the policy attaches to.
+func (b *TrafficPolicyBuilder) TargetRefs(refs ...*TargetRefBuilder) *TrafficPolicyBuilder {
+	b.targetRefs = append(b.targetRefs, refs...)
+	return b
+}
+
+// AuthConfig authorizes requests with the named AuthConfig. An empty namespace refers to
+// the namespace of the policy.
+func (b *TrafficPolicyBuilder) AuthConfig(namespace, name string) *TrafficPolicyBuilder {
+	b.extAuth().AuthConfigRef = &shared.AuthConfigRef{
+		Name:      gwv1.ObjectName(name),
+		Namespace: optionalNamespace(namespace),
+	}
+	return b
+}
+
+// ExtAuthExtension sets the GatewayExtension providing the external authorization service,
+// instead of the one provisioned for the GatewayClass.
+func (b *TrafficPolicyBuilder) ExtAuthExtension(namespace, name string) *TrafficPolicyBuilder {
+	b.extAuth().ExtensionRef = namespacedObjectReference(namespace, name)
+	return b
+}
+
+// DisableExtAuth disables external authorization, such as one configured at a higher level.
+func (b *TrafficPolicyBuilder) DisableExtAuth() *TrafficPolicyBuilder {
+	b.extAuth().Disable = &upstreamshared.PolicyDisable{}
+	return b
+}
+
+func (b *TrafficPolicyBuilder) extAuth() *enterprisekgatewayv1alpha1.EntExtAuth {
+	if b.policy.Spec.EntExtAuth == nil {
+		b.policy.Spec.EntExtAuth = &enterprisekgatewayv1alpha1.EntExtAuth{}
+	}
+	return b.policy.Spec.EntExtAuth
+}
+
+// RateLimitConfig adds the named RateLimitConfig to the global rate limits of the policy.
+// An empty namespace refers to the namespace of the policy.
+func (b *TrafficPolicyBuilder) RateLimitConfig(namespace, name string) *TrafficPolicyBuilder {
+	global := &b.rateLimit().Global
+	global.RateLimitConfigRefs = append(global.RateLimitConfigRefs, shared.RateLimitConfigRef{
+		Name:      gwv1.ObjectName(name),
+		Namespace: optionalNamespace(namespace),
+	})
+	return b
+}
+
+// RateLimitExtension sets the GatewayExtension providing the global rate limit service,
+// instead of the default one.
+func (b *TrafficPolicyBuilder) RateLimitExtension(namespace, name string) *TrafficPolicyBuilder {
+	b.rateLimit().Global.ExtensionRef = namespacedObjectReference(namespace, name)
+	return b
+}
+
+func (b *TrafficPolicyBuilder) rateLimit() *enterprisekgatewayv1alpha1.EntRateLimit {
+	if b.policy.Spec.EntRateLimit == nil {
+		b.policy.Spec.EntRateLimit = &enterprisekgatewayv1alpha1.EntRateLimit{}
+	}
+	return b.policy.Spec.EntRateLimit
+}
+
+// WAFPolicy inspects requests with the named WAFPolicy. An empty namespace refers to the
+// namespace of the policy.
+func (b *TrafficPolicyBuilder) WAFPolicy(namespace, name string) *TrafficPolicyBuilder {
+	b.waf().WAFPolicyRef = &shared.WAFPolicyRef{
+		Name:      gwv1.ObjectName(name),
+		Namespace: optionalNamespace(namespace),
+	}
+	return b
+}
+
+// WAFServer sets the external processing service that applies the WAF policy, instead of
+// the default one.
+func (b *TrafficPolicyBuilder) WAFServer(ref gwv1.BackendObjectReference) *TrafficPolicyBuilder {
+	b.waf().WAFServerRef = &ref
+	return b
+}
+
+// DisableWAF disables WAF, such as a WAF policy configured at a higher level.
+func (b *TrafficPolicyBuilder) DisableWAF() *TrafficPolicyBuilder {
+	b.waf().Disable = &upstreamshared.PolicyDisable{}
+	return b
+}
+
+func (b *TrafficPolicyBuilder) waf() *enterprisekgatewayv1alpha1.EntWAF {
+	if b.policy.Spec.EntWAF == nil {
+		b.policy.Spec.EntWAF = &enterprisekgatewayv1alpha1.EntWAF{}
+	}
+	return b.policy.Spec.EntWAF
+}
+
+// JWTBeforeExtAuth authenticates requests with JWTs before external authorization.
+func (b *TrafficPolicyBuilder) JWTBeforeExtAuth(jwt *JWTBuilder) *TrafficPolicyBuilder {
+	b.jwtBefore = jwt
+	return b
+}
+
+// JWTAfterExtAuth authenticates requests with JWTs after external authorization.
+func (b *TrafficPolicyBuilder) JWTAfterExtAuth(jwt *JWTBuilder) *TrafficPolicyBuilder {
+	b.jwtAfter = jwt
+	return b
+}
+
+// RBACPolicy adds an RBAC policy under the given name, replacing any policy of that name.
+// Requests are allowed if they match any of the policies.
+func (b *TrafficPolicyBuilder) RBACPolicy(name string, policy *RBACPolicyBuilder) *TrafficPolicyBuilder {
+	b.rbac[name] = policy
+	return b
+}
+
+// DisableRBAC disables RBAC checks, such as ones configured at a higher level. It cannot be
+// combined with RBAC policies.
+func (b *TrafficPolicyBuilder) DisableRBAC() *TrafficPolicyBuilder {
+	b.entRBAC().Disable = &upstreamshared.PolicyDisable{}
+	return b
+}
+
+func (b *TrafficPolicyBuilder) entRBAC() *enterprisekgatewayv1alpha1.EntRBAC {
+	if b.policy.Spec.EntRBAC == nil {
+		b.policy.Spec.EntRBAC = &enterprisekgatewayv1alpha1.EntRBAC{}
+	}
+	return b.policy.Spec.EntRBAC
+}
+
+// Transformations sets the staged Enterprise transformations of the policy.
+func (b *TrafficPolicyBuilder) Transformations(transformations *StagedTransformationsBuilder) *TrafficPolicyBuilder {
+	b.transformations = transformations
+	return b
+}
+
+// Spec calls f with the spec when the policy is built, after the other settings are
+// applied, to set fields that have no builder method, such as the ones of the embedded
+// upstream TrafficPolicySpec. The result is validated like the rest of the policy.
+func (b *TrafficPolicyBuilder) Spec(f func(spec *enterprisekgatewayv1alpha1.EnterpriseKgatewayTrafficPolicySpec)) *TrafficPolicyBuilder {
+	b.specFuncs = append(b.specFuncs, f)
+	return b
+}
+
+// Build returns the traffic policy, or an error listing every field that violates the
+// schema or CEL rules of the CRD, or whose transformation templates do not parse. The
+// builder is not modified and may be built again.
+func (b *TrafficPolicyBuilder) Build() (*enterprisekgatewayv1alpha1.EnterpriseKgatewayTrafficPolicy, error) {
+	policy := b.policy.DeepCopy()
+	allErrs := b.build(policy)
+	if len(allErrs) > 0 {
+		return nil, allErrs.ToAggregate()
+	}
+	return policy, nil
+}
+
+func (b *TrafficPolicyBuilder) build(policy *enterprisekgatewayv1alpha1.EnterpriseKgatewayTrafficPolicy) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, apimachineryvalidation.ValidateObjectMeta(&policy.ObjectMeta, true,
+		apimachineryvalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+
+	spec := &policy.Spec
+	specPath := field.NewPath("spec")
+	for _, ref := range b.targetRefs {
+		spec.TargetRefs = append(spec.TargetRefs, ref.ref)
+	}
+	if b.jwtBefore != nil || b.jwtAfter != nil {
+		spec.EntJWT = &enterprisekgatewayv1alpha1.StagedJWT{}
+		jwtPath := specPath.Child("entJWT")
+		if b.jwtBefore != nil {
+			jwt, errs := b.jwtBefore.build(jwtPath.Child("beforeExtAuth"))
+			allErrs = append(allErrs, errs...)
+			spec.EntJWT.BeforeExtAuth = jwt
+		}
+		if b.jwtAfter != nil {
+			jwt, errs := b.jwtAfter.build(jwtPath.Child("afterExtAuth"))
+			allErrs = append(allErrs, errs...)
+			spec.EntJWT.AfterExtAuth = jwt
+		}
+	}
+	if len(b.rbac) > 0 {
+		if spec.EntRBAC == nil {
+			spec.EntRBAC = &enterprisekgatewayv1alpha1.EntRBAC{}
+		}
+		spec.EntRBAC.Policies = map[string]enterprisekgatewayv1alpha1.RBACPolicy{}
+		policiesPath := specPath.Child("entRBAC", "policies")
+		for _, name := range slices.Sorted(maps.Keys(b.rbac)) {
+			p, errs := b.rbac[name].build(policiesPath.Key(name))
+			allErrs = append(allErrs, errs...)
+			spec.EntRBAC.Policies[name] = p
+		}
+	}
+	if b.transformations != nil {
+		t, errs := b.transformations.build(specPath.Child("entTransformation"))
+		allErrs = append(allErrs, errs...)
+		spec.EntTransformation = t
+	}
+	for _, f := range b.specFuncs {
+		f(spec)
+	}
+
+	allErrs = append(allErrs, validateTargetRefs(spec.TargetRefs, specPath.Child("targetRefs"))...)
+	allErrs = append(allErrs, spec.Validate()...)
+	allErrs = append(allErrs, celvalidation.Validate(policy)...)
+	allErrs = append(allErrs, transformation.Check(spec.EntTransformation, specPath.Child("entTransformation"))...)
+	return allErrs
+}
+
+func optionalNamespace(namespace string) *gwv1.Namespace {
+	if namespace == "" {
+		return nil
+	}
+	ns := gwv1.Namespace(namespace)
+	return &ns
+}
+
+func namespacedObjectReference(namespace, name string) *upstreamshared.NamespacedObjectReference {
+	return &upstreamshared.NamespacedObjectReference{
+		Name:      gwv1.ObjectName(name),
+		Namespace: optionalNamespace(namespace),
+	}
+}
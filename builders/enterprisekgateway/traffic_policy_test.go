@@ -0,0 +1,64 @@
+package enterprisekgateway
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTrafficPolicyBuild(t *testing.T) {
+	policy, err := NewTrafficPolicy("default", "petstore").
+		TargetRefs(HTTPRouteTarget("petstore")).
+		JWTBeforeExtAuth(NewJWT().
+			Provider("idp", NewJWTProvider().
+				Issuer("https://idp.example.com").
+				RemoteJWKS("https://idp.example.com/.well-known/jwks.json", "idp", 443).
+				CacheDuration(5*time.Minute))).
+		RBACPolicy("admins", NewRBACPolicy().
+			Claims(map[string]string{"groups": "admins"}).
+			Methods("GET", "POST")).
+		WAFPolicy("", "owasp-crs").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := policy.Spec.EntJWT.BeforeExtAuth.Providers["idp"].JWKS.Remote.CacheDuration.Duration; got != 5*time.Minute {
+		t.Errorf("cacheDuration = %v, want 5m", got)
+	}
+	if _, ok := policy.Spec.EntRBAC.Policies["admins"]; !ok {
+		t.Errorf("entRBAC.policies = %v, want admins", policy.Spec.EntRBAC.Policies)
+	}
+}
+
+func TestTrafficPolicyBuildRejectsInvalidSpec(t *testing.T) {
+	b := NewTrafficPolicy("default", "Petstore").
+		TargetRefs(TargetRef("", "Service", "petstore")).
+		JWTAfterExtAuth(NewJWT().
+			Provider("remote", NewJWTProvider().
+				RemoteJWKS("https://idp.example.com/.well-known/jwks.json", "idp", 443).
+				CacheDuration(time.Microsecond)).
+			Provider("none", NewJWTProvider())).
+		RBACPolicy("admins", NewRBACPolicy().Claims(map[string]string{"groups": "admins"})).
+		DisableRBAC().
+		Transformations(NewStagedTransformations().
+			Request(StageRegular, RequestTransformation(NewTemplate().SetHeader("x-user", "{{ user"))))
+	policy, err := b.Build()
+	if err == nil {
+		t.Fatalf("Build() = %v, want an error", policy)
+	}
+	for _, want := range []string{
+		`metadata.name: Invalid value: "Petstore": a lowercase RFC 1123 subdomain`,
+		`spec.targetRefs[0].kind: Invalid value: "Service": targetRefs may only reference Gateway, HTTPRoute, or ListenerSet resources`,
+		`spec.entJWT.afterExtAuth.providers[none].jwks: Invalid value: "object": exactly one of the fields in [local remote] must be set`,
+		`spec.entJWT.afterExtAuth.providers[remote].jwks.remote.cacheDuration: Invalid value: "1µs": cacheDuration must be at least 1ms.`,
+		`spec.entRBAC: Invalid value: "object": exactly one of the fields in [disable policies] must be set`,
+		`spec.entTransformation.stages.regular.requests[0].transformation.template.headers[x-user]: Invalid value: "{{ user": 1:1: expected expression close`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Build() error = %v, want %s", err, want)
+		}
+	}
+	if _, again := b.Build(); again == nil || again.Error() != err.Error() {
+		t.Errorf("second Build() error = %v, want %v", again, err)
+	}
+}
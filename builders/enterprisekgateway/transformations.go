@@ -0,0 +1,377 @@
+package enterprisekgateway
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	enterprisekgatewayv1alpha1 "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/celvalidation"
+	"github.com/solo-io/kgateway-client/v2/transformation"
+)
+
+// Stage is a stage of the filter chain at which transformations run.
+type Stage string
+
+const (
+	// StageEarly runs transformations before all other filters.
+	StageEarly Stage = "early"
+	// StageRegular runs transformations at the regular stage.
+	StageRegular Stage = "regular"
+	// StagePostRouting runs transformations after routing, in the upstream filter chain.
+	StagePostRouting Stage = "postRouting"
+)
+
+var supportedStages = []string{string(StageEarly), string(StageRegular), string(StagePostRouting)}
+
+// StagedTransformationsBuilder builds the Enterprise transformations of a traffic policy.
+type StagedTransformationsBuilder struct {
+	stages    enterprisekgatewayv1alpha1.StagedTransformations
+	requests  []stagedRequest
+	responses []stagedResponse
+}
+
+type stagedRequest struct {
+	stage   Stage
+	request *RequestTransformationBuilder
+}
+
+type stagedResponse struct {
+	stage    Stage
+	response *ResponseTransformationBuilder
+}
+
+// NewStagedTransformations returns a builder for staged transformations without any
+// transformation.
+func NewStagedTransformations() *StagedTransformationsBuilder {
+	return &StagedTransformationsBuilder{}
+}
+
+// Request adds a request transformation to the given stage. The first transformation of the
+// stage that matches a request is applied.
+func (b *StagedTransformationsBuilder) Request(stage Stage, request *RequestTransformationBuilder) *StagedTransformationsBuilder {
+	b.requests = append(b.requests, stagedRequest{stage, request})
+	return b
+}
+
+// Response adds a response transformation to the given stage. The first transformation of
+// the stage that matches a response is applied.
+func (b *StagedTransformationsBuilder) Response(stage Stage, response *ResponseTransformationBuilder) *StagedTransformationsBuilder {
+	b.responses = append(b.responses, stagedResponse{stage, response})
+	return b
+}
+
+// LogRequestResponseInfo logs the requests and responses before and after they are
+// transformed, at debug level.
+func (b *StagedTransformationsBuilder) LogRequestResponseInfo() *StagedTransformationsBuilder {
+	b.stages.LogRequestResponseInfo = ptr.To(true)
+	return b
+}
+
+// EscapeCharacters sets whether templates escape the characters that need to be escaped in
+// JSON, unless the template overrides it.
+func (b *StagedTransformationsBuilder) EscapeCharacters(behavior enterprisekgatewayv1alpha1.EscapeCharactersBehavior) *StagedTransformationsBuilder {
+	b.stages.EscapeCharacters = ptr.To(behavior)
+	return b
+}
+
+// Build returns the transformation configuration, or an error if it is not valid or a
+// template does not parse.
+func (b *StagedTransformationsBuilder) Build() (*enterprisekgatewayv1alpha1.EntTransformation, error) {
+	t, allErrs := b.build(nil)
+	allErrs = append(allErrs, t.Validate()...)
+	allErrs = append(allErrs, celvalidation.Validate(t)...)
+	allErrs = append(allErrs, transformation.Check(t, nil)...)
+	if len(allErrs) > 0 {
+		return nil, allErrs.ToAggregate()
+	}
+	return t, nil
+}
+
+func (b *StagedTransformationsBuilder) build(fldPath *field.Path) (*enterprisekgatewayv1alpha1.EntTransformation, field.ErrorList) {
+	var allErrs field.ErrorList
+	stages := b.stages.DeepCopy()
+	stagesPath := fldPath.Child("stages")
+	for _, r := range b.requests {
+		s := stageOf(stages, r.stage)
+		if s == nil {
+			allErrs = append(allErrs, field.NotSupported(stagesPath, string(r.stage), supportedStages))
+			continue
+		}
+		m, errs := r.request.build(stagesPath.Child(string(r.stage), "requests").Index(len(s.Requests)))
+		allErrs = append(allErrs, errs...)
+		s.Requests = append(s.Requests, m)
+	}
+	for _, r := range b.responses {
+		s := stageOf(stages, r.stage)
+		if s == nil {
+			allErrs = append(allErrs, field.NotSupported(stagesPath, string(r.stage), supportedStages))
+			continue
+		}
+		m, errs := r.response.build(stagesPath.Child(string(r.stage), "responses").Index(len(s.Responses)))
+		allErrs = append(allErrs, errs...)
+		s.Responses = append(s.Responses, m)
+	}
+	return &enterprisekgatewayv1alpha1.EntTransformation{Stages: stages}, allErrs
+}
+
+// stageOf returns the transformations of stage in s, creating them if needed, or nil if
+// stage is not a stage.
+func stageOf(s *enterprisekgatewayv1alpha1.StagedTransformations, stage Stage) *enterprisekgatewayv1alpha1.RequestResponseTransformations {
+	var t **enterprisekgatewayv1alpha1.RequestResponseTransformations
+	switch stage {
+	case StageEarly:
+		t = &s.Early
+	case StageRegular:
+		t = &s.Regular
+	case StagePostRouting:
+		t = &s.PostRouting
+	default:
+		return nil
+	}
+	if *t == nil {
+		*t = &enterprisekgatewayv1alpha1.RequestResponseTransformations{}
+	}
+	return *t
+}
+
+// RequestTransformationBuilder builds a request transformation and the matcher selecting
+// the requests it applies to.
+type RequestTransformationBuilder struct {
+	matcher  enterprisekgatewayv1alpha1.RequestMatcher
+	template *TemplateBuilder
+}
+
+// RequestTransformation returns a builder for a transformation of requests with the given
+// template. Without a matcher, it applies to all requests.
+func RequestTransformation(template *TemplateBuilder) *RequestTransformationBuilder {
+	return &RequestTransformationBuilder{template: template}
+}
+
+// Prefix matches requests whose path starts with prefix.
+func (b *RequestTransformationBuilder) Prefix(prefix string) *RequestTransformationBuilder {
+	b.requestMatcher().Prefix = &prefix
+	return b
+}
+
+// Path matches requests whose path, without the query string, is path.
+func (b *RequestTransformationBuilder) Path(path string) *RequestTransformationBuilder {
+	b.requestMatcher().Path = &path
+	return b
+}
+
+// Regex matches requests whose whole path, without the query string, matches regex.
+func (b *RequestTransformationBuilder) Regex(regex string) *RequestTransformationBuilder {
+	b.requestMatcher().Regex = &enterprisekgatewayv1alpha1.RegexMatcher{Regex: regex}
+	return b
+}
+
+// Header matches requests with the given header. An empty value matches any value.
+func (b *RequestTransformationBuilder) Header(name, value string) *RequestTransformationBuilder {
+	m := b.requestMatcher()
+	m.Headers = append(m.Headers, headerMatcher(name, value))
+	return b
+}
+
+func (b *RequestTransformationBuilder) requestMatcher() *enterprisekgatewayv1alpha1.TransformationRequestMatcher {
+	if b.matcher.Matcher == nil {
+		b.matcher.Matcher = &enterprisekgatewayv1alpha1.TransformationRequestMatcher{}
+	}
+	return b.matcher.Matcher
+}
+
+// ClearRouteCache recomputes the route of requests after they are transformed.
+func (b *RequestTransformationBuilder) ClearRouteCache() *RequestTransformationBuilder {
+	b.matcher.ClearRouteCache = ptr.To(true)
+	return b
+}
+
+func (b *RequestTransformationBuilder) build(fldPath *field.Path) (enterprisekgatewayv1alpha1.RequestMatcher, field.ErrorList) {
+	m := *b.matcher.DeepCopy()
+	tmpl, allErrs := b.template.build(fldPath.Child("transformation", "template"))
+	m.Transformation.Template = tmpl
+	return m, allErrs
+}
+
+// ResponseTransformationBuilder builds a response transformation and the matcher
+// selecting the responses it applies to.
+type ResponseTransformationBuilder struct {
+	matcher  enterprisekgatewayv1alpha1.ResponseMatcher
+	template *TemplateBuilder
+}
+
+// ResponseTransformation returns a builder for a transformation of responses with the
+// given template. Without a matcher, it applies to all responses.
+func ResponseTransformation(template *TemplateBuilder) *ResponseTransformationBuilder {
+	return &ResponseTransformationBuilder{template: template}
+}
+
+// Header matches responses with the given header, such as ":status". An empty value
+// matches any value.
+func (b *ResponseTransformationBuilder) Header(name, value string) *ResponseTransformationBuilder {
+	b.matcher.Headers = append(b.matcher.Headers, headerMatcher(name, value))
+	return b
+}
+
+// ResponseCodeDetails matches responses with the given response code details, such as
+// "ext_authz_denied".
+func (b *ResponseTransformationBuilder) ResponseCodeDetails(details string) *ResponseTransformationBuilder {
+	b.matcher.ResponseCodeDetails = &details
+	return b
+}
+
+func (b *ResponseTransformationBuilder) build(fldPath *field.Path) (enterprisekgatewayv1alpha1.ResponseMatcher, field.ErrorList) {
+	m := *b.matcher.DeepCopy()
+	tmpl, allErrs := b.template.build(fldPath.Child("transformation", "template"))
+	m.Transformation.Template = tmpl
+	return m, allErrs
+}
+
+func headerMatcher(name, value string) enterprisekgatewayv1alpha1.TransformationHeaderMatcher {
+	m := enterprisekgatewayv1alpha1.TransformationHeaderMatcher{Name: name}
+	if value != "" {
+		m.Value = &value
+	}
+	return m
+}
+
+// TemplateBuilder builds an Inja transformation template.
+type TemplateBuilder struct {
+	tmpl enterprisekgatewayv1alpha1.TransformationTemplate
+}
+
+// NewTemplate returns a builder for a template that leaves messages unchanged.
+func NewTemplate() *TemplateBuilder {
+	return &TemplateBuilder{}
+}
+
+// AdvancedTemplates accesses JSON elements with JSON pointers, such as "time/start", rather
+// than dot notation. Extractors are then accessed with the extraction function.
+func (b *TemplateBuilder) AdvancedTemplates() *TemplateBuilder {
+	b.tmpl.AdvancedTemplates = ptr.To(true)
+	return b
+}
+
+// ExtractHeader declares an extractor of the given name that extracts the subgroup of
+// regex from a header.
+func (b *TemplateBuilder) ExtractHeader(name, header, regex string, subgroup int32) *TemplateBuilder {
+	return b.Extractor(name, enterprisekgatewayv1alpha1.Extraction{
+		ExtractionHeader: &header,
+		Regex:            regex,
+		Subgroup:         &subgroup,
+	})
+}
+
+// ExtractBody declares an extractor of the given name that extracts the subgroup of regex
+// from the body.
+func (b *TemplateBuilder) ExtractBody(name, regex string, subgroup int32) *TemplateBuilder {
+	return b.Extractor(name, enterprisekgatewayv1alpha1.Extraction{
+		ExtractionBody: ptr.To(true),
+		Regex:          regex,
+		Subgroup:       &subgroup,
+	})
+}
+
+// Extractor declares an extractor of the given name, replacing any extractor of that name.
+func (b *TemplateBuilder) Extractor(name string, extraction enterprisekgatewayv1alpha1.Extraction) *TemplateBuilder {
+	if b.tmpl.Extractors == nil {
+		b.tmpl.Extractors = map[string]enterprisekgatewayv1alpha1.Extraction{}
+	}
+	b.tmpl.Extractors[name] = extraction
+	return b
+}
+
+// SetHeader sets a header to the rendered template, replacing its values.
+func (b *TemplateBuilder) SetHeader(name, template string) *TemplateBuilder {
+	if b.tmpl.Headers == nil {
+		b.tmpl.Headers = map[string]enterprisekgatewayv1alpha1.InjaTemplate{}
+	}
+	b.tmpl.Headers[name] = enterprisekgatewayv1alpha1.InjaTemplate(template)
+	return b
+}
+
+// AppendHeader appends the rendered template to the values of a header.
+func (b *TemplateBuilder) AppendHeader(name, template string) *TemplateBuilder {
+	b.tmpl.HeadersToAppend = append(b.tmpl.HeadersToAppend, enterprisekgatewayv1alpha1.HeaderToAppend{
+		Key:   name,
+		Value: enterprisekgatewayv1alpha1.InjaTemplate(template),
+	})
+	return b
+}
+
+// RemoveHeaders removes all the values of the given headers.
+func (b *TemplateBuilder) RemoveHeaders(names ...string) *TemplateBuilder {
+	b.tmpl.HeadersToRemove = append(b.tmpl.HeadersToRemove, names...)
+	return b
+}
+
+// Body replaces the body with the rendered template.
+func (b *TemplateBuilder) Body(template string) *TemplateBuilder {
+	b.tmpl.BodyTransformation = &enterprisekgatewayv1alpha1.BodyTransformation{
+		Type: enterprisekgatewayv1alpha1.BodyTransformationTypeBody,
+		Body: ptr.To(enterprisekgatewayv1alpha1.InjaTemplate(template)),
+	}
+	return b
+}
+
+// Passthrough leaves the body unchanged without buffering it. Templates cannot refer to
+// the body.
+func (b *TemplateBuilder) Passthrough() *TemplateBuilder {
+	b.tmpl.BodyTransformation = &enterprisekgatewayv1alpha1.BodyTransformation{
+		Type: enterprisekgatewayv1alpha1.BodyTransformationTypePassthrough,
+	}
+	return b
+}
+
+// MergeExtractorsToBody replaces the body with a JSON object of the extracted values.
+func (b *TemplateBuilder) MergeExtractorsToBody() *TemplateBuilder {
+	b.tmpl.BodyTransformation = &enterprisekgatewayv1alpha1.BodyTransformation{
+		Type: enterprisekgatewayv1alpha1.BodyTransformationTypeMergeExtractorsToBody,
+	}
+	return b
+}
+
+// ParseBody sets how the body is parsed before templates are rendered.
+func (b *TemplateBuilder) ParseBody(parse enterprisekgatewayv1alpha1.RequestBodyParse) *TemplateBuilder {
+	b.tmpl.ParseBodyBehavior = ptr.To(parse)
+	return b
+}
+
+// IgnoreErrorOnParse renders templates even if the body cannot be parsed as JSON.
+func (b *TemplateBuilder) IgnoreErrorOnParse() *TemplateBuilder {
+	b.tmpl.IgnoreErrorOnParse = ptr.To(true)
+	return b
+}
+
+// DynamicMetadata sets a dynamic metadata key of the given namespace, or of the
+// transformation filter if it is empty, to the rendered template.
+func (b *TemplateBuilder) DynamicMetadata(namespace, key, template string) *TemplateBuilder {
+	v := enterprisekgatewayv1alpha1.DynamicMetadataValue{
+		Key:   key,
+		Value: enterprisekgatewayv1alpha1.InjaTemplate(template),
+	}
+	if namespace != "" {
+		v.MetadataNamespace = &namespace
+	}
+	b.tmpl.DynamicMetadataValues = append(b.tmpl.DynamicMetadataValues, v)
+	return b
+}
+
+// Build returns the template, or an error if it is not valid or does not parse.
+func (b *TemplateBuilder) Build() (*enterprisekgatewayv1alpha1.TransformationTemplate, error) {
+	tmpl, allErrs := b.build(nil)
+	allErrs = append(allErrs, tmpl.Validate()...)
+	allErrs = append(allErrs, celvalidation.Validate(tmpl)...)
+	allErrs = append(allErrs, transformation.CheckTemplate(tmpl, nil)...)
+	if len(allErrs) > 0 {
+		return nil, allErrs.ToAggregate()
+	}
+	return tmpl, nil
+}
+
+func (b *TemplateBuilder) build(fldPath *field.Path) (*enterprisekgatewayv1alpha1.TransformationTemplate, field.ErrorList) {
+	if b == nil {
+		// Reported by the oneOf validation of the transformation.
+		return nil, nil
+	}
+	return b.tmpl.DeepCopy(), nil
+}
@@ -0,0 +1,265 @@
+// Command gen extracts the +kubebuilder:validation:XValidation markers from the
+// enterprise API types and writes them to celvalidation/zz_generated.rules.go.
+//
+// It is run through go generate from the celvalidation package directory.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	modulePath   = "github.com/solo-io/kgateway-client/v2"
+	markerPrefix = "+kubebuilder:validation:XValidation:"
+)
+
+// apiPackages are the directories, relative to the module root, whose types are scanned.
+var apiPackages = []string{
+	"api/v1alpha1/enterprisekgateway",
+	"api/v1alpha1/enterprisesolo",
+	"api/v1alpha1/shared",
+	"api/v1alpha1/waf",
+}
+
+type rule struct {
+	Rule    string
+	Message string
+}
+
+type typeRules struct {
+	Pkg    string
+	Type   string
+	Rules  []rule
+	Fields []fieldRules
+}
+
+type fieldRules struct {
+	Name  string
+	Rules []rule
+}
+
+func main() {
+	root := flag.String("root", "..", "path to the module root")
+	out := flag.String("out", "zz_generated.rules.go", "output file")
+	flag.Parse()
+
+	var all []typeRules
+	for _, dir := range apiPackages {
+		found, err := scanPackage(filepath.Join(*root, dir), dir)
+		if err != nil {
+			log.Fatalf("scanning %s: %v", dir, err)
+		}
+		all = append(all, found...)
+	}
+
+	src, err := render(all)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func scanPackage(dir, rel string) ([]typeRules, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []typeRules
+	for _, pkg := range pkgs {
+		files := make([]string, 0, len(pkg.Files))
+		for name := range pkg.Files {
+			files = append(files, name)
+		}
+		sort.Strings(files)
+
+		for _, name := range files {
+			for _, decl := range pkg.Files[name].Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					ts := spec.(*ast.TypeSpec)
+					st, ok := ts.Type.(*ast.StructType)
+					if !ok || ts.Assign != 0 {
+						continue
+					}
+					doc := ts.Doc
+					if doc == nil && len(gen.Specs) == 1 {
+						doc = gen.Doc
+					}
+					tr := typeRules{Pkg: rel, Type: ts.Name.Name}
+					if tr.Rules, err = parseRules(doc); err != nil {
+						return nil, fmt.Errorf("%s: %w", ts.Name.Name, err)
+					}
+					for _, f := range st.Fields.List {
+						jsonName := jsonFieldName(f)
+						if jsonName == "" {
+							continue
+						}
+						rules, err := parseRules(f.Doc)
+						if err != nil {
+							return nil, fmt.Errorf("%s.%s: %w", ts.Name.Name, jsonName, err)
+						}
+						if len(rules) > 0 {
+							tr.Fields = append(tr.Fields, fieldRules{Name: jsonName, Rules: rules})
+						}
+					}
+					if len(tr.Rules) > 0 || len(tr.Fields) > 0 {
+						result = append(result, tr)
+					}
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func jsonFieldName(f *ast.Field) string {
+	if f.Tag == nil || len(f.Names) == 0 {
+		return ""
+	}
+	tag, err := strconv.Unquote(f.Tag.Value)
+	if err != nil {
+		return ""
+	}
+	name, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+func parseRules(doc *ast.CommentGroup) ([]rule, error) {
+	if doc == nil {
+		return nil, nil
+	}
+	var rules []rule
+	for _, c := range doc.List {
+		text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+		args, ok := strings.CutPrefix(text, markerPrefix)
+		if !ok {
+			continue
+		}
+		values, err := parseMarkerArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		if values["rule"] == "" {
+			return nil, fmt.Errorf("XValidation marker without a rule: %s", text)
+		}
+		rules = append(rules, rule{Rule: values["rule"], Message: values["message"]})
+	}
+	return rules, nil
+}
+
+// parseMarkerArgs parses the comma separated key=value arguments of a marker,
+// where values are double quoted or backquoted strings.
+func parseMarkerArgs(s string) (map[string]string, error) {
+	values := map[string]string{}
+	for len(s) > 0 {
+		key, rest, ok := strings.Cut(s, "=")
+		if !ok {
+			return nil, fmt.Errorf("malformed marker arguments %q", s)
+		}
+		var value string
+		switch {
+		case strings.HasPrefix(rest, "\""):
+			end := 1
+			for ; end < len(rest); end++ {
+				if rest[end] == '\\' {
+					end++
+					continue
+				}
+				if rest[end] == '"' {
+					break
+				}
+			}
+			if end >= len(rest) {
+				return nil, fmt.Errorf("unterminated string in %q", rest)
+			}
+			unquoted, err := strconv.Unquote(rest[:end+1])
+			if err != nil {
+				return nil, err
+			}
+			value, rest = unquoted, rest[end+1:]
+		case strings.HasPrefix(rest, "`"):
+			end := strings.Index(rest[1:], "`")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string in %q", rest)
+			}
+			value, rest = rest[1:end+1], rest[end+2:]
+		default:
+			value, rest, _ = strings.Cut(rest, ",")
+			rest = "," + rest
+		}
+		values[strings.TrimSpace(key)] = value
+		s = strings.TrimPrefix(strings.TrimSpace(rest), ",")
+	}
+	return values, nil
+}
+
+func render(all []typeRules) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by celvalidation/gen. DO NOT EDIT.\n\n")
+	buf.WriteString("package celvalidation\n\n")
+	buf.WriteString("import (\n\t\"reflect\"\n\n")
+
+	pkgs := map[string]bool{}
+	for _, tr := range all {
+		pkgs[tr.Pkg] = true
+	}
+	imports := make([]string, 0, len(pkgs))
+	for p := range pkgs {
+		imports = append(imports, p)
+	}
+	sort.Strings(imports)
+	for _, p := range imports {
+		fmt.Fprintf(&buf, "\t%q\n", path.Join(modulePath, p))
+	}
+	buf.WriteString(")\n\n")
+
+	buf.WriteString("func init() {\n")
+	for _, tr := range all {
+		typeExpr := fmt.Sprintf("reflect.TypeFor[%s.%s]()", path.Base(tr.Pkg), tr.Type)
+		if len(tr.Rules) > 0 {
+			fmt.Fprintf(&buf, "\tregisterTypeRules(%s,\n", typeExpr)
+			writeRules(&buf, tr.Rules)
+			buf.WriteString("\t)\n")
+		}
+		for _, f := range tr.Fields {
+			fmt.Fprintf(&buf, "\tregisterFieldRules(%s, %q,\n", typeExpr, f.Name)
+			writeRules(&buf, f.Rules)
+			buf.WriteString("\t)\n")
+		}
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+func writeRules(buf *bytes.Buffer, rules []rule) {
+	for _, r := range rules {
+		fmt.Fprintf(buf, "\t\tRule{Rule: %q, Message: %q},\n", r.Rule, r.Message)
+	}
+}
@@ -0,0 +1,48 @@
+package celvalidation
+
+import "reflect"
+
+//go:generate go run ./gen -root .. -out zz_generated.rules.go
+
+// Rule is a CEL validation rule declared with a +kubebuilder:validation:XValidation marker.
+type Rule struct {
+	// Rule is the CEL expression. It is evaluated with the marked value bound to `self`
+	// and must return true for the value to be valid.
+	Rule string
+	// Message is reported when the rule evaluates to false.
+	Message string
+}
+
+// typeRules holds the rules declared on a Go type.
+var typeRules = map[reflect.Type][]Rule{}
+
+// fieldRules holds the rules declared on a struct field, keyed by the JSON name of the field.
+var fieldRules = map[reflect.Type]map[string][]Rule{}
+
+func registerTypeRules(t reflect.Type, rules ...Rule) {
+	typeRules[t] = append(typeRules[t], rules...)
+}
+
+func registerFieldRules(t reflect.Type, jsonName string, rules ...Rule) {
+	if fieldRules[t] == nil {
+		fieldRules[t] = map[string][]Rule{}
+	}
+	fieldRules[t][jsonName] = append(fieldRules[t][jsonName], rules...)
+}
+
+// TypeRules returns the rules declared on the type of obj.
+func TypeRules(obj interface{}) []Rule {
+	return typeRules[indirectType(reflect.TypeOf(obj))]
+}
+
+// FieldRules returns the rules declared on the fields of the type of obj, keyed by JSON field name.
+func FieldRules(obj interface{}) map[string][]Rule {
+	return fieldRules[indirectType(reflect.TypeOf(obj))]
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
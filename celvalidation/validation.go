@@ -0,0 +1,234 @@
+// Package celvalidation evaluates the CEL rules declared with
+// +kubebuilder:validation:XValidation markers on the enterprise API types
+// against Go objects, so that they can be checked without an API server.
+//
+// The rules are extracted from the API types at generation time (see gen) and
+// evaluated with cel-go against the unstructured form of each marked value.
+// Failures are reported with the same field paths, error types and messages
+// as the apiextensions-apiserver.
+//
+// Only the rules declared in this module are evaluated. The rules of the
+// upstream kgateway types embedded in the enterprise types, such as those that
+// TrafficPolicySpec declares on its target references, retries and timeouts,
+// are not: they are enforced by the CRDs of the API server, and some of them
+// use Kubernetes CEL libraries, such as quantity(), that are not loaded here.
+package celvalidation
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+
+	// programs caches compiled programs keyed by rule expression.
+	programs sync.Map
+)
+
+// Validate evaluates the CEL rules declared on obj and every value reachable from it.
+// obj is typically a pointer to an API object, such as an EnterpriseKgatewayTrafficPolicy,
+// in which case the returned field paths start at the top level of the object (e.g. "spec").
+func Validate(obj interface{}) field.ErrorList {
+	return ValidateWithPath(obj, nil)
+}
+
+// ValidateWithPath is like Validate, but reports errors relative to fldPath.
+func ValidateWithPath(obj interface{}, fldPath *field.Path) field.ErrorList {
+	return validateValue(reflect.ValueOf(obj), fldPath)
+}
+
+func validateValue(v reflect.Value, fldPath *field.Path) field.ErrorList {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	var allErrs field.ErrorList
+	switch v.Kind() {
+	case reflect.Struct:
+		allErrs = append(allErrs, validateStruct(v, fldPath)...)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			allErrs = append(allErrs, validateValue(v.Index(i), fldPath.Index(i))...)
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, k := range keys {
+			allErrs = append(allErrs, validateValue(v.MapIndex(k), fldPath.Key(k.String()))...)
+		}
+	}
+	return allErrs
+}
+
+func validateStruct(v reflect.Value, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	t := v.Type()
+
+	tRules, fRules := typeRules[t], fieldRules[t]
+	if len(tRules) > 0 || len(fRules) > 0 {
+		obj, err := toUnstructured(v)
+		if err != nil {
+			return field.ErrorList{field.InternalError(fldPath, err)}
+		}
+		for _, rule := range tRules {
+			if err := evaluate(rule, obj, fldPath); err != nil {
+				allErrs = append(allErrs, err)
+			}
+		}
+		names := make([]string, 0, len(fRules))
+		for name := range fRules {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			// Rules on a field are only evaluated when the field is set.
+			self, ok := obj[name]
+			if !ok {
+				continue
+			}
+			for _, rule := range fRules[name] {
+				if err := evaluate(rule, self, fldPath.Child(name)); err != nil {
+					allErrs = append(allErrs, err)
+				}
+			}
+		}
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, inline := jsonName(sf)
+		if name == "-" {
+			continue
+		}
+		childPath := fldPath
+		if !inline {
+			childPath = fldPath.Child(name)
+		}
+		allErrs = append(allErrs, validateValue(v.Field(i), childPath)...)
+	}
+	return allErrs
+}
+
+// evaluate evaluates rule with self bound to the unstructured value and returns
+// the error the API server would report, or nil if the rule holds.
+func evaluate(rule Rule, self interface{}, fldPath *field.Path) *field.Error {
+	prg, err := compile(rule.Rule)
+	if err != nil {
+		return field.InternalError(fldPath, fmt.Errorf("rule compile error: %v", err))
+	}
+
+	schemaType := openAPIType(self)
+	result, _, err := prg.Eval(map[string]interface{}{"self": self})
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "no such overload") {
+			return field.Invalid(fldPath, schemaType, fmt.Sprintf("'%v': call arguments did not match a supported operator, function or macro signature for rule: %v", err, ruleErrorString(rule)))
+		}
+		return field.Invalid(fldPath, schemaType, fmt.Sprintf("%v evaluating rule: %v", err, ruleErrorString(rule)))
+	}
+	if result == types.True {
+		return nil
+	}
+
+	value := self
+	if schemaType == "object" || schemaType == "array" {
+		value = field.OmitValueType{}
+	}
+	return field.Invalid(fldPath, value, ruleMessageOrDefault(rule))
+}
+
+func compile(expr string) (cel.Program, error) {
+	if prg, ok := programs.Load(expr); ok {
+		return prg.(cel.Program), nil
+	}
+
+	envOnce.Do(func() {
+		env, envErr = cel.NewEnv(cel.Variable("self", cel.DynType))
+	})
+	if envErr != nil {
+		return nil, envErr
+	}
+
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	programs.Store(expr, prg)
+	return prg, nil
+}
+
+func toUnstructured(v reflect.Value) (map[string]interface{}, error) {
+	ptr := reflect.New(v.Type())
+	ptr.Elem().Set(v)
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(ptr.Interface())
+}
+
+// jsonName returns the JSON name of a struct field and whether its fields are inlined into the parent.
+func jsonName(sf reflect.StructField) (string, bool) {
+	tag := sf.Tag.Get("json")
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" && (sf.Anonymous || strings.Contains(opts, "inline")) {
+		return "", true
+	}
+	if name == "" {
+		return sf.Name, false
+	}
+	return name, false
+}
+
+// openAPIType returns the OpenAPI schema type of an unstructured value.
+func openAPIType(v interface{}) string {
+	switch v.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case int64:
+		return "integer"
+	case float64:
+		return "number"
+	default:
+		return ""
+	}
+}
+
+func ruleMessageOrDefault(rule Rule) string {
+	if len(rule.Message) == 0 {
+		return fmt.Sprintf("failed rule: %s", ruleErrorString(rule))
+	}
+	return strings.TrimSpace(rule.Message)
+}
+
+func ruleErrorString(rule Rule) string {
+	if len(rule.Message) > 0 {
+		return strings.TrimSpace(rule.Message)
+	}
+	return strings.TrimSpace(rule.Rule)
+}
@@ -0,0 +1,158 @@
+package celvalidation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+func trafficPolicy(t *testing.T, spec string) *enterprisekgateway.EnterpriseKgatewayTrafficPolicy {
+	t.Helper()
+	p := &enterprisekgateway.EnterpriseKgatewayTrafficPolicy{}
+	if err := yaml.UnmarshalStrict([]byte("spec:\n"+spec), p); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		want []string
+	}{
+		{
+			name: "valid",
+			spec: `
+  entJWT:
+    beforeExtAuth:
+      providers:
+        idp:
+          jwks:
+            remote:
+              url: https://idp.example.com/jwks.json
+              backendRef:
+                name: idp
+              cacheDuration: 5m
+  entTransformation:
+    stages:
+      regular:
+        requests:
+        - transformation:
+            template:
+              bodyTransformation:
+                type: Body
+                body: "{{ user }}"
+`,
+		},
+		{
+			name: "staged JWT without a stage",
+			spec: "  entJWT: {}\n",
+			want: []string{`spec.entJWT: Invalid value: for staged JWT usage, at least one stage must be set`},
+		},
+		{
+			name: "providers of a disabled JWT stage",
+			spec: `
+  entJWT:
+    afterExtAuth:
+      disable: {}
+      providers:
+        idp:
+          jwks:
+            local:
+              key: k
+`,
+			want: []string{`spec.entJWT.afterExtAuth: Invalid value: providers can not be set if disable is set`},
+		},
+		{
+			name: "invalid cache duration",
+			spec: `
+  entJWT:
+    beforeExtAuth:
+      providers:
+        idp:
+          jwks:
+            remote:
+              url: https://idp.example.com/jwks.json
+              backendRef:
+                name: idp
+              cacheDuration: 500us
+`,
+			want: []string{
+				`spec.entJWT.beforeExtAuth.providers[idp].jwks.remote.cacheDuration: Invalid value: "500µs": invalid duration value`,
+				`spec.entJWT.beforeExtAuth.providers[idp].jwks.remote.cacheDuration: Invalid value: "500µs": cacheDuration must be at least 1ms.`,
+			},
+		},
+		{
+			name: "body transformation without a body",
+			spec: `
+  entTransformation:
+    stages:
+      early:
+        responses:
+        - transformation:
+            template:
+              bodyTransformation:
+                type: Body
+`,
+			want: []string{`spec.entTransformation.stages.early.responses[0].transformation.template.bodyTransformation: Invalid value: body must be set when type is Body`},
+		},
+		{
+			// The rules of the upstream TrafficPolicySpec are not evaluated: this target kind
+			// is rejected by the CRD only.
+			name: "upstream rules",
+			spec: `
+  targetRefs:
+  - group: ""
+    kind: Service
+    name: api
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, err := range Validate(trafficPolicy(t, tt.spec)) {
+				got = append(got, err.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestValidateWithPath(t *testing.T) {
+	redis := &enterprisekgateway.RedisClientConfig{
+		SocketType: ptr.To("tcp"),
+		Certs:      &enterprisekgateway.RedisCerts{},
+	}
+	errs := ValidateWithPath(redis, field.NewPath("spec", "redis"))
+	want := field.ErrorList{field.Invalid(field.NewPath("spec", "redis"), field.OmitValueType{}, "certs can only be set when socketType is 'tls'")}
+	if !reflect.DeepEqual(errs, want) {
+		t.Errorf("ValidateWithPath() = %v, want %v", errs, want)
+	}
+
+	redis.SocketType = ptr.To("tls")
+	if errs := ValidateWithPath(redis, field.NewPath("spec", "redis")); len(errs) != 0 {
+		t.Errorf("ValidateWithPath() = %v, want no errors", errs)
+	}
+}
+
+func TestTypeRules(t *testing.T) {
+	if rules := TypeRules(&enterprisekgateway.StagedJWT{}); len(rules) != 1 {
+		t.Errorf("TypeRules(StagedJWT) = %v, want 1 rule", rules)
+	}
+	if rules := FieldRules(enterprisekgateway.RemoteJWKS{})["cacheDuration"]; len(rules) != 2 {
+		t.Errorf("FieldRules(RemoteJWKS)[cacheDuration] = %v, want 2 rules", rules)
+	}
+	if rules := TypeRules(&enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec{}); rules != nil {
+		t.Errorf("TypeRules(EnterpriseKgatewayTrafficPolicySpec) = %v, want none", rules)
+	}
+}
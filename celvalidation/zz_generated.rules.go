@@ -0,0 +1,38 @@
+// Code generated by celvalidation/gen. DO NOT EDIT.
+
+package celvalidation
+
+import (
+	"reflect"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+)
+
+func init() {
+	registerTypeRules(reflect.TypeFor[enterprisekgateway.RedisClientConfig](),
+		Rule{Rule: "!has(self.certs) || (has(self.socketType) && self.socketType == 'tls')", Message: "certs can only be set when socketType is 'tls'"},
+	)
+	registerTypeRules(reflect.TypeFor[enterprisekgateway.StagedJWT](),
+		Rule{Rule: "has(self.afterExtAuth) || has(self.beforeExtAuth)", Message: "for staged JWT usage, at least one stage must be set"},
+	)
+	registerTypeRules(reflect.TypeFor[enterprisekgateway.EntJWT](),
+		Rule{Rule: "!has(self.providers) || !has(self.disable)", Message: "providers can not be set if disable is set"},
+		Rule{Rule: "!has(self.validationPolicy) || !has(self.disable)", Message: "validationPolicy can not be set if disable is set"},
+	)
+	registerFieldRules(reflect.TypeFor[enterprisekgateway.RemoteJWKS](), "cacheDuration",
+		Rule{Rule: "matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')", Message: "invalid duration value"},
+		Rule{Rule: "duration(self) >= duration('1ms')", Message: "cacheDuration must be at least 1ms."},
+	)
+	registerTypeRules(reflect.TypeFor[enterprisekgateway.BodyTransformation](),
+		Rule{Rule: "self.type == 'Body' ? has(self.body) : true", Message: "body must be set when type is Body"},
+		Rule{Rule: "self.type == 'MergeJsonKeys' ? has(self.mergeJsonKeys) : true", Message: "mergeJsonKeys must be set when type is MergeJsonKeys"},
+	)
+	registerFieldRules(reflect.TypeFor[enterprisesolo.EnterpriseListenerSetSpec](), "listeners",
+		Rule{Rule: "self.all(l, l.protocol in ['HTTP', 'TCP', 'UDP'] ? !has(l.tls) : true)", Message: "tls must not be specified for protocols ['HTTP', 'TCP', 'UDP']"},
+		Rule{Rule: "self.all(l, (l.protocol == 'HTTPS' && has(l.tls)) ? (l.tls.mode == '' || l.tls.mode == 'Terminate') : true)", Message: "tls mode must be Terminate for protocol HTTPS"},
+		Rule{Rule: "self.all(l, l.protocol in ['TCP', 'UDP']  ? (!has(l.hostname) || l.hostname == '') : true)", Message: "hostname must not be specified for protocols ['TCP', 'UDP']"},
+		Rule{Rule: "self.all(l1, self.exists_one(l2, l1.name == l2.name))", Message: "Listener name must be unique within the Gateway"},
+		Rule{Rule: "self.all(l1, !has(l1.port) || self.exists_one(l2, has(l2.port) && l1.port == l2.port && l1.protocol == l2.protocol && (has(l1.hostname) && has(l2.hostname) ? l1.hostname == l2.hostname : !has(l1.hostname) && !has(l2.hostname))))", Message: "Combination of port, protocol and hostname must be unique for each listener"},
+	)
+}
@@ -20,8 +20,9 @@ package v1
 import (
 	context "context"
 
-	extauthsoloiov1 "github.com/solo-io/kgateway-client/v2/external/extauth.solo.io/v1"
+	applyconfigurationextauthsoloiov1 "github.com/solo-io/kgateway-client/v2/applyconfiguration/extauth.solo.io/v1"
 	scheme "github.com/solo-io/kgateway-client/v2/clientset/versioned/scheme"
+	extauthsoloiov1 "github.com/solo-io/kgateway-client/v2/external/extauth.solo.io/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
@@ -46,18 +47,21 @@ type AuthConfigInterface interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*extauthsoloiov1.AuthConfigList, error)
 	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *extauthsoloiov1.AuthConfig, err error)
+	Apply(ctx context.Context, authConfig *applyconfigurationextauthsoloiov1.AuthConfigApplyConfiguration, opts metav1.ApplyOptions) (result *extauthsoloiov1.AuthConfig, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, authConfig *applyconfigurationextauthsoloiov1.AuthConfigApplyConfiguration, opts metav1.ApplyOptions) (result *extauthsoloiov1.AuthConfig, err error)
 	AuthConfigExpansion
 }
 
 // authConfigs implements AuthConfigInterface
 type authConfigs struct {
-	*gentype.ClientWithList[*extauthsoloiov1.AuthConfig, *extauthsoloiov1.AuthConfigList]
+	*gentype.ClientWithListAndApply[*extauthsoloiov1.AuthConfig, *extauthsoloiov1.AuthConfigList, *applyconfigurationextauthsoloiov1.AuthConfigApplyConfiguration]
 }
 
 // newAuthConfigs returns a AuthConfigs
 func newAuthConfigs(c *ExtauthV1Client, namespace string) *authConfigs {
 	return &authConfigs{
-		gentype.NewClientWithList[*extauthsoloiov1.AuthConfig, *extauthsoloiov1.AuthConfigList](
+		gentype.NewClientWithListAndApply[*extauthsoloiov1.AuthConfig, *extauthsoloiov1.AuthConfigList, *applyconfigurationextauthsoloiov1.AuthConfigApplyConfiguration](
 			"authconfigs",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -18,20 +18,21 @@ limitations under the License.
 package fake
 
 import (
+	extauthsoloiov1 "github.com/solo-io/kgateway-client/v2/applyconfiguration/extauth.solo.io/v1"
+	typedextauthsoloiov1 "github.com/solo-io/kgateway-client/v2/clientset/versioned/typed/extauth.solo.io/v1"
 	v1 "github.com/solo-io/kgateway-client/v2/external/extauth.solo.io/v1"
-	extauthsoloiov1 "github.com/solo-io/kgateway-client/v2/clientset/versioned/typed/extauth.solo.io/v1"
 	gentype "k8s.io/client-go/gentype"
 )
 
 // fakeAuthConfigs implements AuthConfigInterface
 type fakeAuthConfigs struct {
-	*gentype.FakeClientWithList[*v1.AuthConfig, *v1.AuthConfigList]
+	*gentype.FakeClientWithListAndApply[*v1.AuthConfig, *v1.AuthConfigList, *extauthsoloiov1.AuthConfigApplyConfiguration]
 	Fake *FakeExtauthV1
 }
 
-func newFakeAuthConfigs(fake *FakeExtauthV1, namespace string) extauthsoloiov1.AuthConfigInterface {
+func newFakeAuthConfigs(fake *FakeExtauthV1, namespace string) typedextauthsoloiov1.AuthConfigInterface {
 	return &fakeAuthConfigs{
-		gentype.NewFakeClientWithList[*v1.AuthConfig, *v1.AuthConfigList](
+		gentype.NewFakeClientWithListAndApply[*v1.AuthConfig, *v1.AuthConfigList, *extauthsoloiov1.AuthConfigApplyConfiguration](
 			fake.Fake,
 			namespace,
 			v1.SchemeGroupVersion.WithResource("authconfigs"),
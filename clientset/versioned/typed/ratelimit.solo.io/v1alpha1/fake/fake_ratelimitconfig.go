@@ -18,20 +18,21 @@ limitations under the License.
 package fake
 
 import (
+	ratelimitsoloiov1alpha1 "github.com/solo-io/kgateway-client/v2/applyconfiguration/ratelimit.solo.io/v1alpha1"
+	typedratelimitsoloiov1alpha1 "github.com/solo-io/kgateway-client/v2/clientset/versioned/typed/ratelimit.solo.io/v1alpha1"
 	v1alpha1 "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
-	ratelimitsoloiov1alpha1 "github.com/solo-io/kgateway-client/v2/clientset/versioned/typed/ratelimit.solo.io/v1alpha1"
 	gentype "k8s.io/client-go/gentype"
 )
 
 // fakeRateLimitConfigs implements RateLimitConfigInterface
 type fakeRateLimitConfigs struct {
-	*gentype.FakeClientWithList[*v1alpha1.RateLimitConfig, *v1alpha1.RateLimitConfigList]
+	*gentype.FakeClientWithListAndApply[*v1alpha1.RateLimitConfig, *v1alpha1.RateLimitConfigList, *ratelimitsoloiov1alpha1.RateLimitConfigApplyConfiguration]
 	Fake *FakeRatelimitV1alpha1
 }
 
-func newFakeRateLimitConfigs(fake *FakeRatelimitV1alpha1, namespace string) ratelimitsoloiov1alpha1.RateLimitConfigInterface {
+func newFakeRateLimitConfigs(fake *FakeRatelimitV1alpha1, namespace string) typedratelimitsoloiov1alpha1.RateLimitConfigInterface {
 	return &fakeRateLimitConfigs{
-		gentype.NewFakeClientWithList[*v1alpha1.RateLimitConfig, *v1alpha1.RateLimitConfigList](
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.RateLimitConfig, *v1alpha1.RateLimitConfigList, *ratelimitsoloiov1alpha1.RateLimitConfigApplyConfiguration](
 			fake.Fake,
 			namespace,
 			v1alpha1.SchemeGroupVersion.WithResource("ratelimitconfigs"),
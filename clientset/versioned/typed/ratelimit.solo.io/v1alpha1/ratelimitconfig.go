@@ -20,8 +20,9 @@ package v1alpha1
 import (
 	context "context"
 
-	ratelimitsoloiov1alpha1 "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
+	applyconfigurationratelimitsoloiov1alpha1 "github.com/solo-io/kgateway-client/v2/applyconfiguration/ratelimit.solo.io/v1alpha1"
 	scheme "github.com/solo-io/kgateway-client/v2/clientset/versioned/scheme"
+	ratelimitsoloiov1alpha1 "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
@@ -46,18 +47,21 @@ type RateLimitConfigInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*ratelimitsoloiov1alpha1.RateLimitConfigList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *ratelimitsoloiov1alpha1.RateLimitConfig, err error)
+	Apply(ctx context.Context, rateLimitConfig *applyconfigurationratelimitsoloiov1alpha1.RateLimitConfigApplyConfiguration, opts v1.ApplyOptions) (result *ratelimitsoloiov1alpha1.RateLimitConfig, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, rateLimitConfig *applyconfigurationratelimitsoloiov1alpha1.RateLimitConfigApplyConfiguration, opts v1.ApplyOptions) (result *ratelimitsoloiov1alpha1.RateLimitConfig, err error)
 	RateLimitConfigExpansion
 }
 
 // rateLimitConfigs implements RateLimitConfigInterface
 type rateLimitConfigs struct {
-	*gentype.ClientWithList[*ratelimitsoloiov1alpha1.RateLimitConfig, *ratelimitsoloiov1alpha1.RateLimitConfigList]
+	*gentype.ClientWithListAndApply[*ratelimitsoloiov1alpha1.RateLimitConfig, *ratelimitsoloiov1alpha1.RateLimitConfigList, *applyconfigurationratelimitsoloiov1alpha1.RateLimitConfigApplyConfiguration]
 }
 
 // newRateLimitConfigs returns a RateLimitConfigs
 func newRateLimitConfigs(c *RatelimitV1alpha1Client, namespace string) *rateLimitConfigs {
 	return &rateLimitConfigs{
-		gentype.NewClientWithList[*ratelimitsoloiov1alpha1.RateLimitConfig, *ratelimitsoloiov1alpha1.RateLimitConfigList](
+		gentype.NewClientWithListAndApply[*ratelimitsoloiov1alpha1.RateLimitConfig, *ratelimitsoloiov1alpha1.RateLimitConfigList, *applyconfigurationratelimitsoloiov1alpha1.RateLimitConfigApplyConfiguration](
 			"ratelimitconfigs",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -21,6 +21,7 @@ import (
 	context "context"
 
 	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	applyconfigurationv1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/enterprisekgateway"
 	scheme "github.com/solo-io/kgateway-client/v2/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -46,18 +47,21 @@ type EnterpriseKgatewayParametersInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1enterprisekgateway.EnterpriseKgatewayParametersList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1enterprisekgateway.EnterpriseKgatewayParameters, err error)
+	Apply(ctx context.Context, enterpriseKgatewayParameters *applyconfigurationv1alpha1enterprisekgateway.EnterpriseKgatewayParametersApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1enterprisekgateway.EnterpriseKgatewayParameters, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, enterpriseKgatewayParameters *applyconfigurationv1alpha1enterprisekgateway.EnterpriseKgatewayParametersApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1enterprisekgateway.EnterpriseKgatewayParameters, err error)
 	EnterpriseKgatewayParametersExpansion
 }
 
 // enterpriseKgatewayParameters implements EnterpriseKgatewayParametersInterface
 type enterpriseKgatewayParameters struct {
-	*gentype.ClientWithList[*v1alpha1enterprisekgateway.EnterpriseKgatewayParameters, *v1alpha1enterprisekgateway.EnterpriseKgatewayParametersList]
+	*gentype.ClientWithListAndApply[*v1alpha1enterprisekgateway.EnterpriseKgatewayParameters, *v1alpha1enterprisekgateway.EnterpriseKgatewayParametersList, *applyconfigurationv1alpha1enterprisekgateway.EnterpriseKgatewayParametersApplyConfiguration]
 }
 
 // newEnterpriseKgatewayParameters returns a EnterpriseKgatewayParameters
 func newEnterpriseKgatewayParameters(c *EnterprisekgatewayEnterprisekgatewayClient, namespace string) *enterpriseKgatewayParameters {
 	return &enterpriseKgatewayParameters{
-		gentype.NewClientWithList[*v1alpha1enterprisekgateway.EnterpriseKgatewayParameters, *v1alpha1enterprisekgateway.EnterpriseKgatewayParametersList](
+		gentype.NewClientWithListAndApply[*v1alpha1enterprisekgateway.EnterpriseKgatewayParameters, *v1alpha1enterprisekgateway.EnterpriseKgatewayParametersList, *applyconfigurationv1alpha1enterprisekgateway.EnterpriseKgatewayParametersApplyConfiguration](
 			"enterprisekgatewayparameters",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -21,6 +21,7 @@ import (
 	context "context"
 
 	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	applyconfigurationv1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/enterprisekgateway"
 	scheme "github.com/solo-io/kgateway-client/v2/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -46,18 +47,21 @@ type EnterpriseKgatewayTrafficPolicyInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy, err error)
+	Apply(ctx context.Context, enterpriseKgatewayTrafficPolicy *applyconfigurationv1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, enterpriseKgatewayTrafficPolicy *applyconfigurationv1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy, err error)
 	EnterpriseKgatewayTrafficPolicyExpansion
 }
 
 // enterpriseKgatewayTrafficPolicies implements EnterpriseKgatewayTrafficPolicyInterface
 type enterpriseKgatewayTrafficPolicies struct {
-	*gentype.ClientWithList[*v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy, *v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyList]
+	*gentype.ClientWithListAndApply[*v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy, *v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyList, *applyconfigurationv1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyApplyConfiguration]
 }
 
 // newEnterpriseKgatewayTrafficPolicies returns a EnterpriseKgatewayTrafficPolicies
 func newEnterpriseKgatewayTrafficPolicies(c *EnterprisekgatewayEnterprisekgatewayClient, namespace string) *enterpriseKgatewayTrafficPolicies {
 	return &enterpriseKgatewayTrafficPolicies{
-		gentype.NewClientWithList[*v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy, *v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyList](
+		gentype.NewClientWithListAndApply[*v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy, *v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyList, *applyconfigurationv1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyApplyConfiguration](
 			"enterprisekgatewaytrafficpolicies",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -19,19 +19,20 @@ package fake
 
 import (
 	enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
-	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/clientset/versioned/typed/v1alpha1/enterprisekgateway"
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/enterprisekgateway"
+	typedv1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/clientset/versioned/typed/v1alpha1/enterprisekgateway"
 	gentype "k8s.io/client-go/gentype"
 )
 
 // fakeEnterpriseKgatewayParameters implements EnterpriseKgatewayParametersInterface
 type fakeEnterpriseKgatewayParameters struct {
-	*gentype.FakeClientWithList[*enterprisekgateway.EnterpriseKgatewayParameters, *enterprisekgateway.EnterpriseKgatewayParametersList]
+	*gentype.FakeClientWithListAndApply[*enterprisekgateway.EnterpriseKgatewayParameters, *enterprisekgateway.EnterpriseKgatewayParametersList, *v1alpha1enterprisekgateway.EnterpriseKgatewayParametersApplyConfiguration]
 	Fake *FakeEnterprisekgatewayEnterprisekgateway
 }
 
-func newFakeEnterpriseKgatewayParameters(fake *FakeEnterprisekgatewayEnterprisekgateway, namespace string) v1alpha1enterprisekgateway.EnterpriseKgatewayParametersInterface {
+func newFakeEnterpriseKgatewayParameters(fake *FakeEnterprisekgatewayEnterprisekgateway, namespace string) typedv1alpha1enterprisekgateway.EnterpriseKgatewayParametersInterface {
 	return &fakeEnterpriseKgatewayParameters{
-		gentype.NewFakeClientWithList[*enterprisekgateway.EnterpriseKgatewayParameters, *enterprisekgateway.EnterpriseKgatewayParametersList](
+		gentype.NewFakeClientWithListAndApply[*enterprisekgateway.EnterpriseKgatewayParameters, *enterprisekgateway.EnterpriseKgatewayParametersList, *v1alpha1enterprisekgateway.EnterpriseKgatewayParametersApplyConfiguration](
 			fake.Fake,
 			namespace,
 			enterprisekgateway.SchemeGroupVersion.WithResource("enterprisekgatewayparameters"),
@@ -19,19 +19,20 @@ package fake
 
 import (
 	enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
-	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/clientset/versioned/typed/v1alpha1/enterprisekgateway"
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/enterprisekgateway"
+	typedv1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/clientset/versioned/typed/v1alpha1/enterprisekgateway"
 	gentype "k8s.io/client-go/gentype"
 )
 
 // fakeEnterpriseKgatewayTrafficPolicies implements EnterpriseKgatewayTrafficPolicyInterface
 type fakeEnterpriseKgatewayTrafficPolicies struct {
-	*gentype.FakeClientWithList[*enterprisekgateway.EnterpriseKgatewayTrafficPolicy, *enterprisekgateway.EnterpriseKgatewayTrafficPolicyList]
+	*gentype.FakeClientWithListAndApply[*enterprisekgateway.EnterpriseKgatewayTrafficPolicy, *enterprisekgateway.EnterpriseKgatewayTrafficPolicyList, *v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyApplyConfiguration]
 	Fake *FakeEnterprisekgatewayEnterprisekgateway
 }
 
-func newFakeEnterpriseKgatewayTrafficPolicies(fake *FakeEnterprisekgatewayEnterprisekgateway, namespace string) v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyInterface {
+func newFakeEnterpriseKgatewayTrafficPolicies(fake *FakeEnterprisekgatewayEnterprisekgateway, namespace string) typedv1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyInterface {
 	return &fakeEnterpriseKgatewayTrafficPolicies{
-		gentype.NewFakeClientWithList[*enterprisekgateway.EnterpriseKgatewayTrafficPolicy, *enterprisekgateway.EnterpriseKgatewayTrafficPolicyList](
+		gentype.NewFakeClientWithListAndApply[*enterprisekgateway.EnterpriseKgatewayTrafficPolicy, *enterprisekgateway.EnterpriseKgatewayTrafficPolicyList, *v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyApplyConfiguration](
 			fake.Fake,
 			namespace,
 			enterprisekgateway.SchemeGroupVersion.WithResource("enterprisekgatewaytrafficpolicies"),
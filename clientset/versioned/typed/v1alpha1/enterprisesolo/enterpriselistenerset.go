@@ -21,6 +21,7 @@ import (
 	context "context"
 
 	v1alpha1enterprisesolo "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+	applyconfigurationv1alpha1enterprisesolo "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/enterprisesolo"
 	scheme "github.com/solo-io/kgateway-client/v2/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -46,18 +47,21 @@ type EnterpriseListenerSetInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1enterprisesolo.EnterpriseListenerSetList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1enterprisesolo.EnterpriseListenerSet, err error)
+	Apply(ctx context.Context, enterpriseListenerSet *applyconfigurationv1alpha1enterprisesolo.EnterpriseListenerSetApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1enterprisesolo.EnterpriseListenerSet, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, enterpriseListenerSet *applyconfigurationv1alpha1enterprisesolo.EnterpriseListenerSetApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1enterprisesolo.EnterpriseListenerSet, err error)
 	EnterpriseListenerSetExpansion
 }
 
 // enterpriseListenerSets implements EnterpriseListenerSetInterface
 type enterpriseListenerSets struct {
-	*gentype.ClientWithList[*v1alpha1enterprisesolo.EnterpriseListenerSet, *v1alpha1enterprisesolo.EnterpriseListenerSetList]
+	*gentype.ClientWithListAndApply[*v1alpha1enterprisesolo.EnterpriseListenerSet, *v1alpha1enterprisesolo.EnterpriseListenerSetList, *applyconfigurationv1alpha1enterprisesolo.EnterpriseListenerSetApplyConfiguration]
 }
 
 // newEnterpriseListenerSets returns a EnterpriseListenerSets
 func newEnterpriseListenerSets(c *EnterprisekgatewayEnterprisesoloClient, namespace string) *enterpriseListenerSets {
 	return &enterpriseListenerSets{
-		gentype.NewClientWithList[*v1alpha1enterprisesolo.EnterpriseListenerSet, *v1alpha1enterprisesolo.EnterpriseListenerSetList](
+		gentype.NewClientWithListAndApply[*v1alpha1enterprisesolo.EnterpriseListenerSet, *v1alpha1enterprisesolo.EnterpriseListenerSetList, *applyconfigurationv1alpha1enterprisesolo.EnterpriseListenerSetApplyConfiguration](
 			"enterpriselistenersets",
 			c.RESTClient(),
 			scheme.ParameterCodec,
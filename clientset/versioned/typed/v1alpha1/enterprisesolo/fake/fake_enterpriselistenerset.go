@@ -19,19 +19,20 @@ package fake
 
 import (
 	enterprisesolo "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
-	v1alpha1enterprisesolo "github.com/solo-io/kgateway-client/v2/clientset/versioned/typed/v1alpha1/enterprisesolo"
+	v1alpha1enterprisesolo "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/enterprisesolo"
+	typedv1alpha1enterprisesolo "github.com/solo-io/kgateway-client/v2/clientset/versioned/typed/v1alpha1/enterprisesolo"
 	gentype "k8s.io/client-go/gentype"
 )
 
 // fakeEnterpriseListenerSets implements EnterpriseListenerSetInterface
 type fakeEnterpriseListenerSets struct {
-	*gentype.FakeClientWithList[*enterprisesolo.EnterpriseListenerSet, *enterprisesolo.EnterpriseListenerSetList]
+	*gentype.FakeClientWithListAndApply[*enterprisesolo.EnterpriseListenerSet, *enterprisesolo.EnterpriseListenerSetList, *v1alpha1enterprisesolo.EnterpriseListenerSetApplyConfiguration]
 	Fake *FakeEnterprisekgatewayEnterprisesolo
 }
 
-func newFakeEnterpriseListenerSets(fake *FakeEnterprisekgatewayEnterprisesolo, namespace string) v1alpha1enterprisesolo.EnterpriseListenerSetInterface {
+func newFakeEnterpriseListenerSets(fake *FakeEnterprisekgatewayEnterprisesolo, namespace string) typedv1alpha1enterprisesolo.EnterpriseListenerSetInterface {
 	return &fakeEnterpriseListenerSets{
-		gentype.NewFakeClientWithList[*enterprisesolo.EnterpriseListenerSet, *enterprisesolo.EnterpriseListenerSetList](
+		gentype.NewFakeClientWithListAndApply[*enterprisesolo.EnterpriseListenerSet, *enterprisesolo.EnterpriseListenerSetList, *v1alpha1enterprisesolo.EnterpriseListenerSetApplyConfiguration](
 			fake.Fake,
 			namespace,
 			enterprisesolo.SchemeGroupVersion.WithResource("enterpriselistenersets"),
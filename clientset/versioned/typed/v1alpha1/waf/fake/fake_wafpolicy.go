@@ -19,19 +19,20 @@ package fake
 
 import (
 	waf "github.com/solo-io/kgateway-client/v2/api/v1alpha1/waf"
-	v1alpha1waf "github.com/solo-io/kgateway-client/v2/clientset/versioned/typed/v1alpha1/waf"
+	v1alpha1waf "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/waf"
+	typedv1alpha1waf "github.com/solo-io/kgateway-client/v2/clientset/versioned/typed/v1alpha1/waf"
 	gentype "k8s.io/client-go/gentype"
 )
 
 // fakeWAFPolicies implements WAFPolicyInterface
 type fakeWAFPolicies struct {
-	*gentype.FakeClientWithList[*waf.WAFPolicy, *waf.WAFPolicyList]
+	*gentype.FakeClientWithListAndApply[*waf.WAFPolicy, *waf.WAFPolicyList, *v1alpha1waf.WAFPolicyApplyConfiguration]
 	Fake *FakeEnterprisekgatewayWaf
 }
 
-func newFakeWAFPolicies(fake *FakeEnterprisekgatewayWaf, namespace string) v1alpha1waf.WAFPolicyInterface {
+func newFakeWAFPolicies(fake *FakeEnterprisekgatewayWaf, namespace string) typedv1alpha1waf.WAFPolicyInterface {
 	return &fakeWAFPolicies{
-		gentype.NewFakeClientWithList[*waf.WAFPolicy, *waf.WAFPolicyList](
+		gentype.NewFakeClientWithListAndApply[*waf.WAFPolicy, *waf.WAFPolicyList, *v1alpha1waf.WAFPolicyApplyConfiguration](
 			fake.Fake,
 			namespace,
 			waf.SchemeGroupVersion.WithResource("wafpolicies"),
@@ -21,6 +21,7 @@ import (
 	context "context"
 
 	v1alpha1waf "github.com/solo-io/kgateway-client/v2/api/v1alpha1/waf"
+	applyconfigurationv1alpha1waf "github.com/solo-io/kgateway-client/v2/applyconfiguration/v1alpha1/waf"
 	scheme "github.com/solo-io/kgateway-client/v2/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -46,18 +47,21 @@ type WAFPolicyInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1waf.WAFPolicyList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1waf.WAFPolicy, err error)
+	Apply(ctx context.Context, wAFPolicy *applyconfigurationv1alpha1waf.WAFPolicyApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1waf.WAFPolicy, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, wAFPolicy *applyconfigurationv1alpha1waf.WAFPolicyApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1waf.WAFPolicy, err error)
 	WAFPolicyExpansion
 }
 
 // wAFPolicies implements WAFPolicyInterface
 type wAFPolicies struct {
-	*gentype.ClientWithList[*v1alpha1waf.WAFPolicy, *v1alpha1waf.WAFPolicyList]
+	*gentype.ClientWithListAndApply[*v1alpha1waf.WAFPolicy, *v1alpha1waf.WAFPolicyList, *applyconfigurationv1alpha1waf.WAFPolicyApplyConfiguration]
 }
 
 // newWAFPolicies returns a WAFPolicies
 func newWAFPolicies(c *EnterprisekgatewayWafClient, namespace string) *wAFPolicies {
 	return &wAFPolicies{
-		gentype.NewClientWithList[*v1alpha1waf.WAFPolicy, *v1alpha1waf.WAFPolicyList](
+		gentype.NewClientWithListAndApply[*v1alpha1waf.WAFPolicy, *v1alpha1waf.WAFPolicyList, *applyconfigurationv1alpha1waf.WAFPolicyApplyConfiguration](
 			"wafpolicies",
 			c.RESTClient(),
 			scheme.ParameterCodec,
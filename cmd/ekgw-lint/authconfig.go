@@ -0,0 +1,65 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	enterprisev1 "github.com/solo-io/kgateway-client/v2/external/enterprise.gloo.solo.io/v1"
+	"github.com/solo-io/kgateway-client/v2/external/protojsonutil"
+)
+
+// checkAuthConfig checks the spec of an AuthConfig, which is decoded as an unstructured
+// object, as the Go type can only be decoded with the
+// kubernetes_protomessage_one_more_release tag. The checks do not need it.
+func (l *linter) checkAuthConfig(doc *document, name string, u *unstructured.Unstructured) {
+	specPath := field.NewPath("spec")
+	spec, _, _ := unstructured.NestedFieldNoCopy(u.Object, "spec")
+	if spec == nil {
+		spec = map[string]interface{}{}
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		l.report(doc, name, specPath.String(), ruleDecode, err.Error())
+		return
+	}
+	md, err := enterprisev1.AuthConfigSpecDescriptor()
+	if err != nil {
+		l.report(doc, name, specPath.String(), ruleDecode, err.Error())
+		return
+	}
+
+	unknown, err := protojsonutil.UnknownFields(data, md, specPath)
+	if err != nil {
+		l.report(doc, name, specPath.String(), ruleDecode, err.Error())
+		return
+	}
+	for _, p := range unknown {
+		l.report(doc, name, p.String(), ruleUnknownField, "unknown field")
+	}
+	errs, err := enterprisev1.ValidateAuthConfigSpecJSON(data)
+	if err != nil {
+		l.report(doc, name, specPath.String(), ruleSchema, err.Error())
+		return
+	}
+	for _, e := range errs {
+		l.report(doc, name, e.Field, ruleSchema, e.ErrorBody())
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	yaml "go.yaml.in/yaml/v3"
+)
+
+// document is a YAML document of an input file.
+type document struct {
+	file string
+	// line is the line of the file at which the document starts, starting at 1.
+	line int
+	data []byte
+	// root is the parsed document, used to locate fields. It is nil if the document is
+	// not valid YAML.
+	root *yaml.Node
+}
+
+var separator = regexp.MustCompile(`^---(\s.*)?$`)
+
+// splitDocuments splits the YAML stream r into documents, skipping empty ones.
+func splitDocuments(file string, r io.Reader) ([]*document, error) {
+	var docs []*document
+	var buf bytes.Buffer
+	start, lineNo := 1, 0
+	flush := func() {
+		if len(bytes.TrimSpace(stripComments(buf.Bytes()))) > 0 {
+			doc := &document{file: file, line: start, data: append([]byte(nil), buf.Bytes()...)}
+			var root yaml.Node
+			if err := yaml.Unmarshal(doc.data, &root); err == nil && len(root.Content) > 0 {
+				doc.root = root.Content[0]
+			}
+			docs = append(docs, doc)
+		}
+		buf.Reset()
+		start = lineNo + 1
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		if separator.MatchString(line) {
+			flush()
+			continue
+		}
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return docs, nil
+}
+
+func stripComments(data []byte) []byte {
+	var out bytes.Buffer
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if trimmed := bytes.TrimSpace(line); len(trimmed) > 0 && trimmed[0] != '#' {
+			out.Write(line)
+			out.WriteByte('\n')
+		}
+	}
+	return out.Bytes()
+}
+
+// locate returns the line and column in the file of the field at path, such as
+// "spec.entExtAuth.authConfigRef.name" or "spec.stages[0].headers[x-foo]". If the field
+// is not present, the position of its closest present parent is returned.
+func (d *document) locate(path string) (int, int) {
+	if d.root == nil {
+		return d.line, 0
+	}
+	pos, node := d.root, d.root
+	for _, segment := range splitPath(path) {
+		key, val := child(node, segment)
+		if val == nil {
+			break
+		}
+		pos, node = key, val
+	}
+	return d.line + pos.Line - 1, pos.Column
+}
+
+// child returns the node at which the named field of a mapping or the item of a sequence
+// is reported, which is the key for fields, and its value.
+func child(node *yaml.Node, segment string) (*yaml.Node, *yaml.Node) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == segment {
+				return node.Content[i], node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		if i, err := strconv.Atoi(segment); err == nil && i >= 0 && i < len(node.Content) {
+			return node.Content[i], node.Content[i]
+		}
+	}
+	return nil, nil
+}
+
+// splitPath splits a field.Path string into its segments: field names, indexes and keys.
+func splitPath(path string) []string {
+	var segments []string
+	for len(path) > 0 {
+		switch path[0] {
+		case '.':
+			path = path[1:]
+		case '[':
+			end := strings.IndexByte(path, ']')
+			if end < 0 {
+				return append(segments, path[1:])
+			}
+			segments = append(segments, path[1:end])
+			path = path[end+1:]
+		default:
+			end := strings.IndexAny(path, ".[")
+			if end < 0 {
+				end = len(path)
+			}
+			segments = append(segments, path[:end])
+			path = path[end:]
+		}
+	}
+	return segments
+}
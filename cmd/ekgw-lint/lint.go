@@ -0,0 +1,337 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/waf"
+	"github.com/solo-io/kgateway-client/v2/celvalidation"
+	"github.com/solo-io/kgateway-client/v2/clientset/versioned/scheme"
+	ratelimitv1alpha1 "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
+	"github.com/solo-io/kgateway-client/v2/refgraph"
+	"github.com/solo-io/kgateway-client/v2/transformation"
+)
+
+// severity is the severity of a finding.
+type severity string
+
+const (
+	severityError   severity = "error"
+	severityWarning severity = "warning"
+)
+
+// rank orders severities for --fail-on.
+func (s severity) rank() int {
+	if s == severityError {
+		return 2
+	}
+	return 1
+}
+
+// rule is a check performed by the linter.
+type rule struct {
+	id          string
+	severity    severity
+	description string
+}
+
+var (
+	ruleDecode       = rule{"decode", severityError, "The document is not a valid Kubernetes object."}
+	ruleUnknownField = rule{"unknown-field", severityError, "The object has unknown or duplicate fields."}
+	ruleSchema       = rule{"schema", severityError, "The object violates the OpenAPI schema of its CRD, such as required fields, enums, bounds and oneOf groups."}
+	ruleCEL          = rule{"cel", severityError, "The object violates an x-kubernetes-validations CEL rule of its CRD."}
+	ruleTemplate     = rule{"template", severityError, "A transformation template is not a valid Inja template."}
+	ruleDuplicate    = rule{"duplicate-object", severityError, "The object is declared more than once."}
+	ruleMissingRef   = rule{"missing-reference", severityError, "The object references an object that is not declared."}
+	ruleCrossNs      = rule{"cross-namespace-reference", severityWarning, "The object references an object in another namespace without a ReferenceGrant."}
+	ruleOrphaned     = rule{"orphaned", severityWarning, "The AuthConfig, RateLimitConfig or WAFPolicy is not referenced by any policy."}
+)
+
+// rules are all the rules, in the order they are documented.
+var rules = []rule{ruleDecode, ruleUnknownField, ruleSchema, ruleCEL, ruleTemplate, ruleDuplicate, ruleMissingRef, ruleCrossNs, ruleOrphaned}
+
+// finding is a problem found in an input document.
+type finding struct {
+	File     string   `json:"file"`
+	Line     int      `json:"line"`
+	Column   int      `json:"column,omitempty"`
+	Object   string   `json:"object,omitempty"`
+	Field    string   `json:"field,omitempty"`
+	Rule     string   `json:"rule"`
+	Severity severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+// linter checks the documents of its inputs.
+type linter struct {
+	// namespace is the namespace of objects that do not set one.
+	namespace string
+	// orphans enables the orphaned rule.
+	orphans bool
+
+	strict   runtime.Decoder
+	fallback runtime.Decoder
+
+	objects  []runtime.Object
+	docs     map[refgraph.ObjectKey]*document
+	findings []finding
+}
+
+func newLinter(namespace string, orphans bool) *linter {
+	return &linter{
+		namespace: namespace,
+		orphans:   orphans,
+		strict: json.NewSerializerWithOptions(json.DefaultMetaFactory, scheme.Scheme, scheme.Scheme, json.SerializerOptions{
+			Yaml:   true,
+			Strict: true,
+		}),
+		fallback: serializer.NewCodecFactory(refgraph.Scheme).UniversalDeserializer(),
+		docs:     map[refgraph.ObjectKey]*document{},
+	}
+}
+
+// strictField extracts the problem and the field of a strict decoding error, such as
+// `unknown field "spec.foo"`.
+var strictField = regexp.MustCompile(`^(unknown|duplicate) field "([^"]*)"$`)
+
+// add decodes and checks a document. Objects of kinds that are not enterprise kinds, such
+// as ConfigMaps and ReferenceGrants, are only used to resolve references.
+func (l *linter) add(doc *document) {
+	obj, enterprise, err := l.decode(doc.data)
+	if err != nil {
+		l.report(doc, "", "", ruleDecode, err.Error())
+		return
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		// Lists and other objects without metadata are not linted.
+		return
+	}
+	if accessor.GetNamespace() == "" {
+		accessor.SetNamespace(l.namespace)
+	}
+	key, err := objectKey(obj)
+	if err != nil {
+		l.report(doc, "", "", ruleDecode, err.Error())
+		return
+	}
+	name := key.String()
+
+	if enterprise {
+		if _, _, err := l.strict.Decode(doc.data, nil, nil); err != nil {
+			if strictErr, ok := runtime.AsStrictDecodingError(err); ok {
+				for _, e := range strictErr.Errors() {
+					path, msg := "", e.Error()
+					if m := strictField.FindStringSubmatch(msg); m != nil {
+						path, msg = m[2], m[1]+" field"
+					}
+					l.report(doc, name, path, ruleUnknownField, msg)
+				}
+			}
+		}
+		for _, e := range validate(obj) {
+			l.report(doc, name, e.err.Field, e.rule, e.err.ErrorBody())
+		}
+	}
+	if u, ok := obj.(*unstructured.Unstructured); ok && key.GroupKind == refgraph.AuthConfigKind {
+		l.checkAuthConfig(doc, name, u)
+	}
+	if _, ok := obj.(*ratelimitv1alpha1.RateLimitConfig); ok {
+		l.checkRateLimitConfig(doc, name)
+	}
+
+	if prev, ok := l.docs[key]; ok {
+		l.report(doc, name, "", ruleDuplicate, fmt.Sprintf("%s is also declared at %s:%d", key, prev.file, prev.line))
+		return
+	}
+	l.docs[key] = doc
+	l.objects = append(l.objects, obj)
+}
+
+// decode decodes an enterprise object with scheme.Codecs, and reports whether it is one.
+// Objects of the other kinds known to refgraph are decoded to their Go types, so that
+// ReferenceGrants are honored, and the others to unstructured objects.
+//
+// AuthConfigs are always decoded to unstructured objects: their generated spec descriptors
+// refer to a Go type that is not a proto message, on which the protobuf runtime panics.
+// Their spec is checked by checkAuthConfig instead.
+func (l *linter) decode(data []byte) (runtime.Object, bool, error) {
+	jsonData, err := utilyaml.ToJSON(data)
+	if err != nil {
+		return nil, false, err
+	}
+	gvk, err := json.DefaultMetaFactory.Interpret(jsonData)
+	if err != nil {
+		return nil, false, err
+	}
+	if gvk.GroupKind() != refgraph.AuthConfigKind {
+		obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(jsonData, nil, nil)
+		if !runtime.IsNotRegisteredError(err) {
+			return obj, err == nil, err
+		}
+		obj, _, err = l.fallback.Decode(jsonData, nil, nil)
+		if !runtime.IsNotRegisteredError(err) {
+			return obj, false, err
+		}
+	}
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(jsonData); err != nil {
+		return nil, false, err
+	}
+	return u, false, nil
+}
+
+type ruleError struct {
+	rule rule
+	err  *field.Error
+}
+
+// validate runs the schema, CEL and template checks of obj.
+func validate(obj runtime.Object) []ruleError {
+	var errs []ruleError
+	add := func(r rule, list field.ErrorList) {
+		for _, err := range list {
+			errs = append(errs, ruleError{r, err})
+		}
+	}
+	switch obj := obj.(type) {
+	case *enterprisekgateway.EnterpriseKgatewayTrafficPolicy:
+		add(ruleSchema, obj.Spec.Validate())
+		add(ruleCEL, celvalidation.Validate(obj))
+		add(ruleTemplate, transformation.Check(obj.Spec.EntTransformation, field.NewPath("spec", "entTransformation")))
+	case *enterprisekgateway.EnterpriseKgatewayParameters:
+		add(ruleSchema, obj.Spec.Validate())
+		add(ruleCEL, celvalidation.Validate(obj))
+	case *waf.WAFPolicy:
+		add(ruleSchema, obj.Spec.Validate())
+		add(ruleCEL, celvalidation.Validate(obj))
+	case *enterprisesolo.EnterpriseListenerSet:
+		add(ruleSchema, obj.Spec.Validate())
+		add(ruleCEL, celvalidation.Validate(obj))
+	case *ratelimitv1alpha1.RateLimitConfig:
+		add(ruleSchema, obj.Spec.Validate())
+	}
+	return errs
+}
+
+// crossCheck reports the reference issues between the objects added so far.
+func (l *linter) crossCheck() {
+	graph, err := refgraph.New(l.objects...)
+	if err != nil {
+		// Duplicates are reported and skipped by add, so this is not expected.
+		l.findings = append(l.findings, finding{Rule: ruleDecode.id, Severity: severityError, Message: err.Error()})
+		return
+	}
+	declared := map[schema.GroupKind]bool{}
+	for key := range l.docs {
+		declared[key.GroupKind] = true
+	}
+	for _, issue := range graph.Check() {
+		doc := l.docs[issue.Object]
+		path := ""
+		if issue.Ref != nil {
+			path = issue.Ref.Field.String()
+		}
+		switch issue.Type {
+		case refgraph.IssueMissingTarget:
+			// Only report missing kinds the input is expected to declare: the enterprise
+			// kinds and the kinds declared elsewhere in the input.
+			if !isEnterpriseKind(issue.Ref.To.GroupKind) && !declared[issue.Ref.To.GroupKind] {
+				continue
+			}
+			l.report(doc, issue.Object.String(), path, ruleMissingRef, issue.Message)
+		case refgraph.IssueCrossNamespace:
+			l.report(doc, issue.Object.String(), path, ruleCrossNs, issue.Message)
+		case refgraph.IssueOrphaned:
+			if l.orphans {
+				l.report(doc, issue.Object.String(), path, ruleOrphaned, issue.Message)
+			}
+		case refgraph.IssueUnchecked:
+			// The AuthConfig specs that cannot be decoded are reported by checkAuthConfig.
+		}
+	}
+}
+
+// isEnterpriseKind reports whether gk is a kind of the clientset scheme. The core group is
+// registered in it too, for the unversioned meta types, but holds no enterprise kind.
+func isEnterpriseKind(gk schema.GroupKind) bool {
+	return gk.Group != "" && scheme.Scheme.IsGroupRegistered(gk.Group)
+}
+
+func (l *linter) report(doc *document, object, path string, r rule, message string) {
+	f := finding{
+		Object:   object,
+		Field:    path,
+		Rule:     r.id,
+		Severity: r.severity,
+		// refgraph messages start with the field, which findings report separately.
+		Message: strings.TrimPrefix(message, path+": "),
+	}
+	if doc != nil {
+		f.File = doc.file
+		f.Line, f.Column = doc.locate(path)
+	}
+	l.findings = append(l.findings, f)
+}
+
+// sortedFindings returns the findings sorted by file and position.
+func (l *linter) sortedFindings() []finding {
+	sort.SliceStable(l.findings, func(i, j int) bool {
+		a, b := l.findings[i], l.findings[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return l.findings
+}
+
+func objectKey(obj runtime.Object) (refgraph.ObjectKey, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return refgraph.ObjectKey{}, err
+	}
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Kind == "" {
+		gvks, _, err := refgraph.Scheme.ObjectKinds(obj)
+		if err != nil {
+			return refgraph.ObjectKey{}, err
+		}
+		gvk = gvks[0]
+	}
+	key := refgraph.ObjectKey{GroupKind: gvk.GroupKind()}
+	key.Namespace, key.Name = accessor.GetNamespace(), accessor.GetName()
+	return key, nil
+}
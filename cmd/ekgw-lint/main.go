@@ -0,0 +1,183 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command ekgw-lint checks Solo Enterprise for kgateway manifests without a cluster.
+//
+// It reads multi-document YAML from files, directories or stdin, decodes the enterprise
+// objects with the clientset scheme, validates them against the structural, oneOf and CEL
+// rules of their CRDs and checks the references between the objects:
+//
+//	ekgw-lint [-o text|json|sarif] [--fail-on error|warning] [-n NAMESPACE] [PATH...]
+//
+// The exit status is 1 if a finding is at least as severe as --fail-on, and 2 on usage or
+// read errors.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const usage = `ekgw-lint checks Solo Enterprise for kgateway manifests without a cluster.
+
+Usage:
+  ekgw-lint [flags] [PATH...]
+
+PATH is a YAML or JSON file, a directory searched recursively for *.yaml, *.yml and
+*.json files, or - for stdin. Stdin is read if no PATH is given.
+
+Flags:
+`
+
+// errFindings is returned by run when findings are at least as severe as --fail-on.
+var errFindings = errors.New("findings reported")
+
+func main() {
+	err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	switch {
+	case err == nil:
+	case errors.Is(err, errFindings):
+		os.Exit(1)
+	case errors.Is(err, flag.ErrHelp):
+		os.Exit(0)
+	default:
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(2)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	var (
+		output    string
+		failOn    string
+		namespace string
+		orphans   bool
+	)
+	flags := flag.NewFlagSet("ekgw-lint", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+	flags.StringVar(&output, "o", "text", "output format: text, json or sarif")
+	flags.StringVar(&output, "output", "text", "output format: text, json or sarif")
+	flags.StringVar(&failOn, "fail-on", "error", "lowest severity that fails the run: error or warning")
+	flags.StringVar(&namespace, "n", "default", "namespace of objects that do not set one")
+	flags.StringVar(&namespace, "namespace", "default", "namespace of objects that do not set one")
+	flags.BoolVar(&orphans, "orphans", true, "report AuthConfigs, RateLimitConfigs and WAFPolicies that no policy references")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	report, ok := reporters[output]
+	if !ok {
+		return fmt.Errorf("unknown output format %q", output)
+	}
+	threshold := severity(failOn)
+	if threshold != severityError && threshold != severityWarning {
+		return fmt.Errorf("unknown severity %q", failOn)
+	}
+
+	paths := flags.Args()
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+	l := newLinter(namespace, orphans)
+	for _, path := range paths {
+		if err := lintPath(l, path, stdin); err != nil {
+			return err
+		}
+	}
+	l.crossCheck()
+
+	findings := l.sortedFindings()
+	if err := report(stdout, findings); err != nil {
+		return err
+	}
+	for _, f := range findings {
+		if f.Severity.rank() >= threshold.rank() {
+			return errFindings
+		}
+	}
+	return nil
+}
+
+// lintPath adds the documents of a file, of the manifests of a directory, or of stdin.
+func lintPath(l *linter, path string, stdin io.Reader) error {
+	if path == "-" {
+		return lintReader(l, "<stdin>", stdin)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return lintFile(l, path)
+	}
+	var files []string
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != path && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch filepath.Ext(p) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		if err := lintFile(l, file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func lintFile(l *linter, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return lintReader(l, path, f)
+}
+
+func lintReader(l *linter, name string, r io.Reader) error {
+	docs, err := splitDocuments(name, r)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", name, err)
+	}
+	for _, doc := range docs {
+		l.add(doc)
+	}
+	return nil
+}
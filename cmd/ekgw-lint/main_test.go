@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files of testdata")
+
+// checkGolden compares got with the golden file name of testdata, or updates it with
+// -update.
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output differs from %s:\n%s", path, got)
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		golden  string
+		wantErr error
+	}{
+		{name: "valid", args: []string{"testdata/valid.yaml"}, golden: "valid.txt.golden"},
+		{name: "text", args: []string{"testdata/invalid.yaml"}, golden: "invalid.txt.golden", wantErr: errFindings},
+		{name: "sarif", args: []string{"-o", "sarif", "testdata/invalid.yaml"}, golden: "invalid.sarif.golden", wantErr: errFindings},
+		{
+			name:   "warnings below threshold",
+			args:   []string{"testdata/valid.yaml", "testdata/orphaned.yaml"},
+			golden: "orphaned.txt.golden",
+		},
+		{
+			name:    "fail on warnings",
+			args:    []string{"--fail-on", "warning", "testdata/valid.yaml", "testdata/orphaned.yaml"},
+			golden:  "orphaned.txt.golden",
+			wantErr: errFindings,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			err := run(tt.args, strings.NewReader(""), &stdout, &stderr)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("run() error = %v, want %v", err, tt.wantErr)
+			}
+			checkGolden(t, tt.golden, stdout.Bytes())
+		})
+	}
+}
+
+func TestReportWithoutFile(t *testing.T) {
+	findings := []finding{{Rule: ruleDecode.id, Severity: severityError, Message: "duplicate object"}}
+
+	var text bytes.Buffer
+	if err := writeText(&text, findings); err != nil {
+		t.Fatal(err)
+	}
+	if want := "error: duplicate object [decode]\n"; text.String() != want {
+		t.Errorf("writeText() = %q, want %q", text.String(), want)
+	}
+
+	var sarif bytes.Buffer
+	if err := writeSARIF(&sarif, findings); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(sarif.String(), "locations") {
+		t.Errorf("writeSARIF() reported a location for a finding without a file:\n%s", sarif.String())
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/solo-io/kgateway-client/v2/external/protojsonutil"
+	ratelimitv1alpha1 "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
+)
+
+// checkRateLimitConfig reports the unknown fields of the spec of a RateLimitConfig, which
+// the strict decoder does not see, as the spec is decoded with protojson.
+func (l *linter) checkRateLimitConfig(doc *document, name string) {
+	specPath := field.NewPath("spec")
+	data, err := utilyaml.ToJSON(doc.data)
+	if err != nil {
+		l.report(doc, name, specPath.String(), ruleDecode, err.Error())
+		return
+	}
+	var obj struct {
+		Spec json.RawMessage `json:"spec"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		l.report(doc, name, specPath.String(), ruleDecode, err.Error())
+		return
+	}
+	if len(obj.Spec) == 0 {
+		return
+	}
+	md := (&ratelimitv1alpha1.RateLimitConfigSpec{}).ProtoReflect().Descriptor()
+	unknown, err := protojsonutil.UnknownFields(obj.Spec, md, specPath)
+	if err != nil {
+		l.report(doc, name, specPath.String(), ruleDecode, err.Error())
+		return
+	}
+	for _, p := range unknown {
+		l.report(doc, name, p.String(), ruleUnknownField, "unknown field")
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+)
+
+// reporters write findings in the supported output formats.
+var reporters = map[string]func(w io.Writer, findings []finding) error{
+	"text":  writeText,
+	"json":  writeJSON,
+	"sarif": writeSARIF,
+}
+
+// writeText writes one line per finding, in the format of compilers:
+//
+//	file:line:column: severity: object: field: message [rule]
+//
+// Findings that are not tied to a document have no position.
+func writeText(w io.Writer, findings []finding) error {
+	for _, f := range findings {
+		line := fmt.Sprintf("%s: %s [%s]", f.Severity, f.text(), f.Rule)
+		if f.File != "" {
+			pos := fmt.Sprintf("%s:%d", f.File, f.Line)
+			if f.Column > 0 {
+				pos += fmt.Sprintf(":%d", f.Column)
+			}
+			line = pos + ": " + line
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// text returns the message of f prefixed with its object and field.
+func (f finding) text() string {
+	msg := f.Message
+	if f.Field != "" {
+		msg = f.Field + ": " + msg
+	}
+	if f.Object != "" {
+		msg = f.Object + ": " + msg
+	}
+	return msg
+}
+
+func writeJSON(w io.Writer, findings []finding) error {
+	if findings == nil {
+		findings = []finding{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(findings)
+}
+
+// The types below are the subset of SARIF 2.1.0 used to report findings, as consumed by
+// GitHub code scanning and other CI systems.
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string       `json:"id"`
+	ShortDescription     sarifMessage `json:"shortDescription"`
+	DefaultConfiguration struct {
+		Level string `json:"level"`
+	} `json:"defaultConfiguration"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI string `json:"uri"`
+		} `json:"artifactLocation"`
+		Region struct {
+			StartLine   int `json:"startLine"`
+			StartColumn int `json:"startColumn,omitempty"`
+		} `json:"region"`
+	} `json:"physicalLocation"`
+}
+
+func writeSARIF(w io.Writer, findings []finding) error {
+	driver := sarifDriver{
+		Name:           "ekgw-lint",
+		InformationURI: "https://github.com/solo-io/kgateway-client/tree/main/cmd/ekgw-lint",
+	}
+	ruleIndex := map[string]int{}
+	for i, r := range rules {
+		sr := sarifRule{ID: r.id, ShortDescription: sarifMessage{r.description}}
+		sr.DefaultConfiguration.Level = string(r.severity)
+		driver.Rules = append(driver.Rules, sr)
+		ruleIndex[r.id] = i
+	}
+
+	results := []sarifResult{}
+	for _, f := range findings {
+		result := sarifResult{
+			RuleID:    f.Rule,
+			RuleIndex: ruleIndex[f.Rule],
+			Level:     string(f.Severity),
+			Message:   sarifMessage{f.text()},
+		}
+		// Findings that are not tied to a document, such as the failure to build the
+		// reference graph, are reported without a location.
+		if f.File != "" {
+			var loc sarifLocation
+			loc.PhysicalLocation.ArtifactLocation.URI = filepath.ToSlash(f.File)
+			loc.PhysicalLocation.Region.StartLine = max(f.Line, 1)
+			loc.PhysicalLocation.Region.StartColumn = f.Column
+			result.Locations = []sarifLocation{loc}
+		}
+		results = append(results, result)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	})
+}
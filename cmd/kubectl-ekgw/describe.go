@@ -0,0 +1,207 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/waf"
+	ratelimitv1alpha1 "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
+	"github.com/solo-io/kgateway-client/v2/refgraph"
+)
+
+func runDescribe(ctx context.Context, args []string, stdout io.Writer) error {
+	var opts options
+	fs := flag.NewFlagSet("describe", flag.ContinueOnError)
+	opts.addFlags(fs)
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 2 {
+		return fmt.Errorf("usage: describe KIND NAME")
+	}
+	r, err := lookupResource(args[0])
+	if err != nil {
+		return err
+	}
+	c, err := opts.clients()
+	if err != nil {
+		return err
+	}
+	return c.describe(ctx, r, args[1], stdout)
+}
+
+// describe prints the status of the named resource of kind r, the state of the objects it
+// references and the traffic policies that reference it.
+func (c *clients) describe(ctx context.Context, r *resource, name string, stdout io.Writer) error {
+	obj, err := r.get(ctx, c, c.namespace, name)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	acc := acceptanceOf(obj)
+	fmt.Fprintf(w, "Name:\t%s\n", obj.GetName())
+	fmt.Fprintf(w, "Namespace:\t%s\n", obj.GetNamespace())
+	fmt.Fprintf(w, "Kind:\t%s\n", r.groupKind)
+	fmt.Fprintf(w, "Generation:\t%d\n", obj.GetGeneration())
+	fmt.Fprintf(w, "Age:\t%s\n", age(obj.GetCreationTimestamp()))
+	fmt.Fprintf(w, "State:\t%s\n", acc.phase)
+	if acc.message != "" {
+		fmt.Fprintf(w, "Message:\t%s\n", acc.message)
+	}
+	describeStatus(w, obj)
+
+	graph, err := c.graph(ctx, r.groupKind, obj.(runtime.Object))
+	if err != nil {
+		return err
+	}
+	key := refgraph.ObjectKey{GroupKind: r.groupKind}
+	key.Namespace, key.Name = obj.GetNamespace(), obj.GetName()
+	// The references of an object are unavailable when its spec cannot be decoded, such as
+	// an AuthConfig spec with fields of the wrong type.
+	for _, issue := range graph.Check() {
+		if issue.Type == refgraph.IssueUnchecked && issue.Object == key {
+			fmt.Fprintf(w, "References:\t<unavailable: %s>\n", issue.Message)
+		}
+	}
+	if refs := graph.RefsFrom(key); len(refs) > 0 {
+		fmt.Fprintln(w, "References:")
+		for _, ref := range refs {
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", ref.Field, ref.To, c.resolve(ctx, ref.To))
+		}
+	}
+	if refs := graph.RefsTo(key); len(refs) > 0 {
+		fmt.Fprintln(w, "Referenced By:")
+		for _, ref := range refs {
+			fmt.Fprintf(w, "  %s\t%s\n", ref.From, ref.Field)
+		}
+	}
+	return w.Flush()
+}
+
+// graph returns the reference graph of obj, of kind gk, and, for the kinds referenced by traffic
+// policies, of the traffic policies that may reference it. Traffic policies are listed in
+// all namespaces, or in the namespace of obj if that is not permitted.
+func (c *clients) graph(ctx context.Context, gk schema.GroupKind, obj runtime.Object) (*refgraph.Graph, error) {
+	objs := []runtime.Object{obj}
+	switch gk {
+	case refgraph.WAFPolicyKind, refgraph.AuthConfigKind, refgraph.RateLimitConfigKind:
+		policies := c.ekgw.EnterprisekgatewayEnterprisekgateway().EnterpriseKgatewayTrafficPolicies
+		list, err := policies(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if k8serrors.IsForbidden(err) {
+			list, err = policies(c.namespace).List(ctx, metav1.ListOptions{})
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	}
+	return refgraph.New(objs...)
+}
+
+// resolve describes the state of the referenced object: its acceptance state for the
+// kinds known to the plugin, otherwise whether it exists.
+func (c *clients) resolve(ctx context.Context, key refgraph.ObjectKey) string {
+	if r := resourceFor(key.GroupKind); r != nil {
+		obj, err := r.get(ctx, c, key.Namespace, key.Name)
+		if err != nil {
+			return errorState(err)
+		}
+		return string(acceptanceOf(obj).phase)
+	}
+	mapping, err := c.mapper.RESTMapping(key.GroupKind)
+	if err != nil {
+		return "<unknown kind>"
+	}
+	_, err = c.dynamic.Resource(mapping.Resource).Namespace(key.Namespace).Get(ctx, key.Name, metav1.GetOptions{})
+	if err != nil {
+		return errorState(err)
+	}
+	return "Found"
+}
+
+func errorState(err error) string {
+	if k8serrors.IsNotFound(err) {
+		return "<not found>"
+	}
+	return fmt.Sprintf("<error: %v>", err)
+}
+
+// describeStatus prints the conditions of obj.
+func describeStatus(w io.Writer, obj metav1.Object) {
+	switch obj := obj.(type) {
+	case *enterprisekgateway.EnterpriseKgatewayTrafficPolicy:
+		describeAncestors(w, obj.Status.Ancestors)
+	case *waf.WAFPolicy:
+		fmt.Fprintln(w, "Conditions:")
+		describeConditions(w, "  ", obj.Status.Conditions)
+		describeAncestors(w, obj.Status.Ancestors)
+	case *enterprisesolo.EnterpriseListenerSet:
+		fmt.Fprintln(w, "Conditions:")
+		describeConditions(w, "  ", obj.Status.Conditions)
+		if len(obj.Status.Listeners) > 0 {
+			fmt.Fprintln(w, "Listeners:")
+			for _, l := range obj.Status.Listeners {
+				fmt.Fprintf(w, "  %s (port %d, %d attached routes):\n", l.Name, l.Port, l.AttachedRoutes)
+				describeConditions(w, "    ", l.Conditions)
+			}
+		}
+	case *unstructured.Unstructured:
+		if obj.GroupVersionKind().GroupKind() != refgraph.AuthConfigKind {
+			return
+		}
+		if status, err := authConfigStatus(obj); err == nil && status.GetReportedBy() != "" {
+			fmt.Fprintf(w, "Reported By:\t%s\n", status.GetReportedBy())
+		}
+	case *ratelimitv1alpha1.RateLimitConfig:
+		fmt.Fprintf(w, "Observed Generation:\t%d\n", obj.Status.GetObservedGeneration())
+	}
+}
+
+func describeAncestors(w io.Writer, ancestors []gwv1.PolicyAncestorStatus) {
+	if len(ancestors) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "Ancestors:")
+	for _, a := range ancestors {
+		fmt.Fprintf(w, "  %s (%s):\n", ancestorName(a.AncestorRef), a.ControllerName)
+		describeConditions(w, "    ", a.Conditions)
+	}
+}
+
+func describeConditions(w io.Writer, indent string, conditions []metav1.Condition) {
+	for _, c := range conditions {
+		fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\n", indent, c.Type, c.Status, c.Reason, truncate(c.Message, maxMessageWidth))
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// maxMessageWidth is the width at which messages are truncated in tables.
+const maxMessageWidth = 80
+
+func runGet(ctx context.Context, args []string, stdout io.Writer) error {
+	var opts options
+	var allNamespaces bool
+	fs := flag.NewFlagSet("get", flag.ContinueOnError)
+	opts.addFlags(fs)
+	fs.BoolVar(&allNamespaces, "all-namespaces", false, "list resources in all namespaces")
+	fs.BoolVar(&allNamespaces, "A", false, "list resources in all namespaces (shorthand)")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) > 2 {
+		return fmt.Errorf("usage: get [KIND [NAME]]")
+	}
+
+	c, err := opts.clients()
+	if err != nil {
+		return err
+	}
+	namespace := c.namespace
+	if allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+
+	selected := resources
+	if len(args) > 0 {
+		r, err := lookupResource(args[0])
+		if err != nil {
+			return err
+		}
+		selected = []resource{*r}
+	}
+
+	type row struct {
+		kind string
+		obj  metav1.Object
+	}
+	var rows []row
+	for _, r := range selected {
+		if len(args) == 2 {
+			obj, err := r.get(ctx, c, namespace, args[1])
+			if err != nil {
+				return err
+			}
+			rows = append(rows, row{r.groupKind.Kind, obj})
+			continue
+		}
+		objs, err := r.list(ctx, c, namespace)
+		if err != nil {
+			return fmt.Errorf("listing %s: %w", r.name, err)
+		}
+		for _, obj := range objs {
+			rows = append(rows, row{r.groupKind.Kind, obj})
+		}
+	}
+	if len(rows) == 0 {
+		if allNamespaces {
+			fmt.Fprintln(stdout, "No resources found")
+		} else {
+			fmt.Fprintf(stdout, "No resources found in %s namespace.\n", namespace)
+		}
+		return nil
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 8, 3, ' ', 0)
+	if allNamespaces {
+		fmt.Fprint(w, "NAMESPACE\t")
+	}
+	fmt.Fprintln(w, "NAME\tSTATE\tMESSAGE\tAGE")
+	for _, row := range rows {
+		acc := acceptanceOf(row.obj)
+		if allNamespaces {
+			fmt.Fprintf(w, "%s\t", row.obj.GetNamespace())
+		}
+		fmt.Fprintf(w, "%s/%s\t%s\t%s\t%s\n", row.kind, row.obj.GetName(), acc.phase, truncate(acc.message, maxMessageWidth), age(row.obj.GetCreationTimestamp()))
+	}
+	return w.Flush()
+}
+
+// age formats the age of a resource as kubectl does.
+func age(created metav1.Time) string {
+	if created.IsZero() {
+		return "<unknown>"
+	}
+	return duration.HumanDuration(time.Since(created.Time))
+}
+
+// truncate shortens s to the first line and at most n runes.
+func truncate(s string, n int) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i] + "..."
+	}
+	if r := []rune(s); len(r) > n {
+		return string(r[:n-3]) + "..."
+	}
+	return s
+}
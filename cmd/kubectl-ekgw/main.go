@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command kubectl-ekgw is a kubectl plugin that shows the Solo Enterprise for kgateway
+// resources of a cluster along with their acceptance state.
+//
+// Install it on the PATH and run it as `kubectl ekgw`:
+//
+//	kubectl ekgw get [KIND [NAME]] [-n NAMESPACE | -A]
+//	kubectl ekgw describe KIND NAME [-n NAMESPACE]
+//	kubectl ekgw wait --for=accepted KIND (NAME... | --all) [-n NAMESPACE] [--timeout=30s]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+
+	clientset "github.com/solo-io/kgateway-client/v2/clientset/versioned"
+)
+
+const usage = `kubectl-ekgw shows Solo Enterprise for kgateway resources and their acceptance state.
+
+Usage:
+  kubectl ekgw get [KIND [NAME]] [-n NAMESPACE | -A]
+  kubectl ekgw describe KIND NAME [-n NAMESPACE]
+  kubectl ekgw wait --for=accepted KIND (NAME... | --all) [-n NAMESPACE] [--timeout=30s]
+
+Kinds:
+%s
+Common flags:
+  --kubeconfig string   path to the kubeconfig file
+  --context string      name of the kubeconfig context to use
+  -n, --namespace       namespace to query; defaults to the namespace of the context
+`
+
+// options are the flags shared by all commands.
+type options struct {
+	kubeconfig string
+	context    string
+	namespace  string
+}
+
+func (o *options) addFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.kubeconfig, "kubeconfig", "", "path to the kubeconfig file")
+	fs.StringVar(&o.context, "context", "", "name of the kubeconfig context to use")
+	fs.StringVar(&o.namespace, "namespace", "", "namespace to query")
+	fs.StringVar(&o.namespace, "n", "", "namespace to query (shorthand)")
+}
+
+// clients holds the clients used by the commands.
+type clients struct {
+	ekgw      clientset.Interface
+	dynamic   dynamic.Interface
+	mapper    meta.RESTMapper
+	namespace string
+}
+
+func (o *options) clients() (*clients, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = o.kubeconfig
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{
+		CurrentContext: o.context,
+	})
+	config, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	namespace := o.namespace
+	if namespace == "" {
+		if namespace, _, err = clientConfig.Namespace(); err != nil {
+			return nil, err
+		}
+	}
+	ekgw, err := clientset.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	dyn, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return &clients{
+		ekgw:      ekgw,
+		dynamic:   dyn,
+		mapper:    restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(ekgw.Discovery())),
+		namespace: namespace,
+	}, nil
+}
+
+type command func(ctx context.Context, args []string, stdout io.Writer) error
+
+var commands = map[string]command{
+	"get":      runGet,
+	"describe": runDescribe,
+	"wait":     runWait,
+}
+
+func main() {
+	if err := run(context.Background(), os.Args[1:], os.Stdout); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "error:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdout io.Writer) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printUsage(stdout)
+		return nil
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		printUsage(os.Stderr)
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+	return cmd(ctx, args[1:], stdout)
+}
+
+func printUsage(w io.Writer) {
+	var kinds strings.Builder
+	for _, r := range resources {
+		fmt.Fprintf(&kinds, "  %-18s %s (%s)\n", r.name, r.groupKind.Kind, strings.Join(r.aliases, ", "))
+	}
+	fmt.Fprintf(w, usage, kinds.String())
+}
+
+// parseFlags parses args with fs, allowing flags to follow positional arguments as kubectl
+// does, and returns the positional arguments.
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/solo-io/kgateway-client/v2/clientset/versioned/fake"
+	"github.com/solo-io/kgateway-client/v2/refgraph"
+)
+
+var update = flag.Bool("update", false, "update the golden files of testdata")
+
+// checkGolden compares got with the golden file name of testdata, or updates it with
+// -update.
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output differs from %s:\n%s", path, got)
+	}
+}
+
+// fakeClients returns clients of the apps namespace serving the objects of
+// testdata/resources.yaml: AuthConfigs and Secrets through the dynamic client, the other
+// objects through the clientset.
+func fakeClients(t *testing.T) *clients {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", "resources.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	objs, err := refgraph.Decode(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{corev1.SchemeGroupVersion})
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+
+	var typed, dynamic []runtime.Object
+	for _, obj := range objs {
+		switch obj.(type) {
+		case *unstructured.Unstructured, *corev1.Secret:
+			dynamic = append(dynamic, obj)
+		default:
+			typed = append(typed, obj)
+		}
+	}
+	return &clients{
+		ekgw:      fake.NewSimpleClientset(typed...),
+		dynamic:   dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{authConfigsResource: "AuthConfigList"}, dynamic...),
+		mapper:    mapper,
+		namespace: "apps",
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		kind, name string
+		golden     string
+	}{
+		{kind: "trafficpolicy", name: "api", golden: "trafficpolicy.describe.golden"},
+		{kind: "authconfig", name: "oidc", golden: "authconfig.describe.golden"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind+"/"+tt.name, func(t *testing.T) {
+			r, err := lookupResource(tt.kind)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var out bytes.Buffer
+			if err := fakeClients(t).describe(context.Background(), r, tt.name, &out); err != nil {
+				t.Fatal(err)
+			}
+			checkGolden(t, tt.golden, out.Bytes())
+		})
+	}
+}
+
+func TestDescribeUnavailableReferences(t *testing.T) {
+	r, err := lookupResource("authconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := fakeClients(t).describe(context.Background(), r, "invalid", &out); err != nil {
+		t.Fatal(err)
+	}
+	// The decoding error is not compared, as protojson varies its messages on purpose.
+	if want := "References:  <unavailable: AuthConfig references not checked: proto:"; !strings.Contains(out.String(), want) {
+		t.Errorf("describe() =\n%s\nwant a line starting with %q", &out, want)
+	}
+}
+
+func TestWaitRefusesAuthConfigs(t *testing.T) {
+	err := run(context.Background(), []string{"wait", "--for=accepted", "authconfigs", "oidc"}, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "cannot wait for authconfigs") {
+		t.Errorf("run() error = %v, want wait refused for authconfigs", err)
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+	extauthv1 "github.com/solo-io/kgateway-client/v2/external/extauth.solo.io/v1"
+	"github.com/solo-io/kgateway-client/v2/refgraph"
+)
+
+// authConfigsResource is the resource of AuthConfigs. They are read with the dynamic
+// client as unstructured objects, of which only the status is decoded: decoding their spec
+// panics unless the plugin is built with the kubernetes_protomessage_one_more_release tag.
+var authConfigsResource = extauthv1.SchemeGroupVersion.WithResource("authconfigs")
+
+// resource is a kind of resource shown by the plugin.
+type resource struct {
+	name      string
+	groupKind schema.GroupKind
+	aliases   []string
+	list      func(ctx context.Context, c *clients, namespace string) ([]metav1.Object, error)
+	get       func(ctx context.Context, c *clients, namespace, name string) (metav1.Object, error)
+	// waitUnsupported explains why wait cannot tell whether the resource was accepted, if
+	// it cannot.
+	waitUnsupported string
+}
+
+var resources = []resource{
+	{
+		name:      "trafficpolicies",
+		groupKind: refgraph.TrafficPolicyKind,
+		aliases:   []string{"trafficpolicy", "ekgtp", "enterprisekgatewaytrafficpolicies", "enterprisekgatewaytrafficpolicy"},
+		list: func(ctx context.Context, c *clients, namespace string) ([]metav1.Object, error) {
+			list, err := c.ekgw.EnterprisekgatewayEnterprisekgateway().EnterpriseKgatewayTrafficPolicies(namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			objs := make([]metav1.Object, len(list.Items))
+			for i := range list.Items {
+				objs[i] = &list.Items[i]
+			}
+			return objs, nil
+		},
+		get: func(ctx context.Context, c *clients, namespace, name string) (metav1.Object, error) {
+			return c.ekgw.EnterprisekgatewayEnterprisekgateway().EnterpriseKgatewayTrafficPolicies(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+	},
+	{
+		name:      "wafpolicies",
+		groupKind: refgraph.WAFPolicyKind,
+		aliases:   []string{"wafpolicy", "wafpol"},
+		list: func(ctx context.Context, c *clients, namespace string) ([]metav1.Object, error) {
+			list, err := c.ekgw.EnterprisekgatewayWaf().WAFPolicies(namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			objs := make([]metav1.Object, len(list.Items))
+			for i := range list.Items {
+				objs[i] = &list.Items[i]
+			}
+			return objs, nil
+		},
+		get: func(ctx context.Context, c *clients, namespace, name string) (metav1.Object, error) {
+			return c.ekgw.EnterprisekgatewayWaf().WAFPolicies(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+	},
+	{
+		name:      "listenersets",
+		groupKind: enterprisesolo.SchemeGroupVersion.WithKind("EnterpriseListenerSet").GroupKind(),
+		aliases:   []string{"listenerset", "enterpriselistenersets", "enterpriselistenerset"},
+		list: func(ctx context.Context, c *clients, namespace string) ([]metav1.Object, error) {
+			list, err := c.ekgw.EnterprisekgatewayEnterprisesolo().EnterpriseListenerSets(namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			objs := make([]metav1.Object, len(list.Items))
+			for i := range list.Items {
+				objs[i] = &list.Items[i]
+			}
+			return objs, nil
+		},
+		get: func(ctx context.Context, c *clients, namespace, name string) (metav1.Object, error) {
+			return c.ekgw.EnterprisekgatewayEnterprisesolo().EnterpriseListenerSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+	},
+	{
+		name:      "authconfigs",
+		groupKind: refgraph.AuthConfigKind,
+		aliases:   []string{"authconfig", "ac"},
+		list: func(ctx context.Context, c *clients, namespace string) ([]metav1.Object, error) {
+			list, err := c.dynamic.Resource(authConfigsResource).Namespace(namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			objs := make([]metav1.Object, len(list.Items))
+			for i := range list.Items {
+				objs[i] = &list.Items[i]
+			}
+			return objs, nil
+		},
+		get: func(ctx context.Context, c *clients, namespace, name string) (metav1.Object, error) {
+			return c.dynamic.Resource(authConfigsResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		waitUnsupported: "the AuthConfig status records no observed generation, so an accepted state may be for a previous spec",
+	},
+	{
+		name:      "ratelimitconfigs",
+		groupKind: refgraph.RateLimitConfigKind,
+		aliases:   []string{"ratelimitconfig", "rlc"},
+		list: func(ctx context.Context, c *clients, namespace string) ([]metav1.Object, error) {
+			list, err := c.ekgw.RatelimitV1alpha1().RateLimitConfigs(namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			objs := make([]metav1.Object, len(list.Items))
+			for i := range list.Items {
+				objs[i] = &list.Items[i]
+			}
+			return objs, nil
+		},
+		get: func(ctx context.Context, c *clients, namespace, name string) (metav1.Object, error) {
+			return c.ekgw.RatelimitV1alpha1().RateLimitConfigs(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+	},
+}
+
+// lookupResource returns the resource named by its plural name, kind or an alias, case
+// insensitively.
+func lookupResource(name string) (*resource, error) {
+	name = strings.ToLower(name)
+	for i, r := range resources {
+		if r.name == name || strings.ToLower(r.groupKind.Kind) == name {
+			return &resources[i], nil
+		}
+		for _, alias := range r.aliases {
+			if alias == name {
+				return &resources[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("unknown kind %q", name)
+}
+
+// resourceFor returns the resource of the given kind, or nil.
+func resourceFor(gk schema.GroupKind) *resource {
+	for i, r := range resources {
+		if r.groupKind == gk {
+			return &resources[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/waf"
+	extauthv1 "github.com/solo-io/kgateway-client/v2/external/extauth.solo.io/v1"
+	ratelimitv1alpha1 "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
+	"github.com/solo-io/kgateway-client/v2/refgraph"
+)
+
+// phase is the acceptance state of a resource.
+type phase string
+
+const (
+	phasePending  phase = "Pending"
+	phaseAccepted phase = "Accepted"
+	phaseWarning  phase = "Warning"
+	phaseRejected phase = "Rejected"
+)
+
+// accepted reports whether the resource was accepted, possibly with warnings.
+func (p phase) accepted() bool {
+	return p == phaseAccepted || p == phaseWarning
+}
+
+// acceptance is the acceptance state of a resource and a message explaining it.
+type acceptance struct {
+	phase   phase
+	message string
+}
+
+// acceptanceOf returns the acceptance state of obj. A status written for an older
+// generation of the resource is reported as pending.
+func acceptanceOf(obj metav1.Object) acceptance {
+	switch obj := obj.(type) {
+	case *enterprisekgateway.EnterpriseKgatewayTrafficPolicy:
+		return policyAcceptance(&obj.Status, obj.Generation)
+	case *waf.WAFPolicy:
+		return wafPolicyAcceptance(obj)
+	case *enterprisesolo.EnterpriseListenerSet:
+		return listenerSetAcceptance(obj)
+	case *unstructured.Unstructured:
+		if obj.GroupVersionKind().GroupKind() == refgraph.AuthConfigKind {
+			return authConfigAcceptance(obj)
+		}
+	case *ratelimitv1alpha1.RateLimitConfig:
+		return rateLimitConfigAcceptance(obj)
+	}
+	return acceptance{phase: phasePending}
+}
+
+// conditionAcceptance maps a condition to an acceptance state.
+func conditionAcceptance(c *metav1.Condition, generation int64) acceptance {
+	switch {
+	case c == nil:
+		return acceptance{phase: phasePending, message: "Waiting for controller"}
+	case c.ObservedGeneration != 0 && c.ObservedGeneration < generation:
+		return acceptance{phase: phasePending, message: fmt.Sprintf("status is for generation %d, want %d", c.ObservedGeneration, generation)}
+	case c.Status == metav1.ConditionTrue:
+		return acceptance{phase: phaseAccepted, message: c.Message}
+	case c.Status == metav1.ConditionFalse:
+		return acceptance{phase: phaseRejected, message: fmt.Sprintf("%s: %s", c.Reason, c.Message)}
+	}
+	return acceptance{phase: phasePending, message: c.Message}
+}
+
+// policyAcceptance aggregates the Accepted conditions of the ancestors of a policy. The
+// policy is rejected if any ancestor rejected it.
+func policyAcceptance(status *gwv1.PolicyStatus, generation int64) acceptance {
+	if len(status.Ancestors) == 0 {
+		return acceptance{phase: phasePending, message: "no ancestors"}
+	}
+	accepted := 0
+	for _, a := range status.Ancestors {
+		acc := conditionAcceptance(meta.FindStatusCondition(a.Conditions, string(gwv1.PolicyConditionAccepted)), generation)
+		switch acc.phase {
+		case phaseRejected:
+			return acceptance{phase: phaseRejected, message: fmt.Sprintf("%s: %s", ancestorName(a.AncestorRef), acc.message)}
+		case phasePending:
+			return acceptance{phase: phasePending, message: fmt.Sprintf("%s: %s", ancestorName(a.AncestorRef), acc.message)}
+		}
+		accepted++
+	}
+	return acceptance{phase: phaseAccepted, message: fmt.Sprintf("accepted by %d ancestor(s)", accepted)}
+}
+
+func ancestorName(ref gwv1.ParentReference) string {
+	kind := "Gateway"
+	if ref.Kind != nil {
+		kind = string(*ref.Kind)
+	}
+	name := string(ref.Name)
+	if ref.Namespace != nil {
+		name = string(*ref.Namespace) + "/" + name
+	}
+	if ref.SectionName != nil {
+		name += "/" + string(*ref.SectionName)
+	}
+	return kind + " " + name
+}
+
+// wafPolicyAcceptance reports the Ready condition set by the WAF server, then the
+// attachment to traffic policies.
+func wafPolicyAcceptance(p *waf.WAFPolicy) acceptance {
+	acc := conditionAcceptance(meta.FindStatusCondition(p.Status.Conditions, waf.WAFPolicyConditionReady), p.Generation)
+	if acc.phase != phaseAccepted || len(p.Status.Ancestors) == 0 {
+		return acc
+	}
+	for _, a := range p.Status.Ancestors {
+		if c := meta.FindStatusCondition(a.Conditions, string(gwv1.PolicyConditionAccepted)); c != nil && c.Status == metav1.ConditionFalse {
+			return acceptance{phase: phaseWarning, message: fmt.Sprintf("%s: %s: %s", ancestorName(a.AncestorRef), c.Reason, c.Message)}
+		}
+	}
+	return acc
+}
+
+// listenerSetAcceptance reports the Accepted condition of the listener set. An accepted
+// listener set with rejected listeners is reported as a warning.
+func listenerSetAcceptance(ls *enterprisesolo.EnterpriseListenerSet) acceptance {
+	acc := conditionAcceptance(meta.FindStatusCondition(ls.Status.Conditions, "Accepted"), ls.Generation)
+	if acc.phase != phaseAccepted {
+		return acc
+	}
+	accepted := 0
+	for _, l := range ls.Status.Listeners {
+		c := meta.FindStatusCondition(l.Conditions, "Accepted")
+		if c != nil && c.Status == metav1.ConditionFalse {
+			return acceptance{phase: phaseWarning, message: fmt.Sprintf("listener %s: %s: %s", l.Name, c.Reason, c.Message)}
+		}
+		if c != nil && c.Status == metav1.ConditionTrue {
+			accepted++
+		}
+	}
+	return acceptance{phase: phaseAccepted, message: fmt.Sprintf("%d/%d listener(s) accepted", accepted, len(ls.Status.Listeners))}
+}
+
+func authConfigAcceptance(u *unstructured.Unstructured) acceptance {
+	status, err := authConfigStatus(u)
+	if err != nil {
+		return acceptance{phase: phasePending, message: fmt.Sprintf("invalid status: %v", err)}
+	}
+	switch status.GetState() {
+	case extauthv1.AuthConfigStatus_Accepted:
+		return acceptance{phase: phaseAccepted}
+	case extauthv1.AuthConfigStatus_Warning:
+		return acceptance{phase: phaseWarning, message: status.GetReason()}
+	case extauthv1.AuthConfigStatus_Rejected:
+		return acceptance{phase: phaseRejected, message: status.GetReason()}
+	}
+	return acceptance{phase: phasePending, message: status.GetReason()}
+}
+
+// authConfigStatus decodes the status of an AuthConfig read with the dynamic client. The
+// status, unlike the spec, can be decoded without the
+// kubernetes_protomessage_one_more_release build tag.
+func authConfigStatus(u *unstructured.Unstructured) (*extauthv1.AuthConfigStatus, error) {
+	status := &extauthv1.AuthConfigStatus{}
+	m, ok, err := unstructured.NestedFieldNoCopy(u.Object, "status")
+	if err != nil || !ok || m == nil {
+		return status, err
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	if err := status.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func rateLimitConfigAcceptance(rlc *ratelimitv1alpha1.RateLimitConfig) acceptance {
+	if g := rlc.Status.GetObservedGeneration(); g != 0 && g < rlc.Generation {
+		return acceptance{phase: phasePending, message: fmt.Sprintf("status is for generation %d, want %d", g, rlc.Generation)}
+	}
+	switch rlc.Status.GetState() {
+	case ratelimitv1alpha1.RateLimitConfigStatus_ACCEPTED:
+		return acceptance{phase: phaseAccepted, message: rlc.Status.GetMessage()}
+	case ratelimitv1alpha1.RateLimitConfigStatus_REJECTED:
+		return acceptance{phase: phaseRejected, message: rlc.Status.GetMessage()}
+	}
+	return acceptance{phase: phasePending, message: rlc.Status.GetMessage()}
+}
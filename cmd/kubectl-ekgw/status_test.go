@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+	extauthv1 "github.com/solo-io/kgateway-client/v2/external/extauth.solo.io/v1"
+	ratelimitv1alpha1 "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
+)
+
+func condition(status metav1.ConditionStatus, reason, message string, generation int64) metav1.Condition {
+	return metav1.Condition{Type: "Accepted", Status: status, Reason: reason, Message: message, ObservedGeneration: generation}
+}
+
+func trafficPolicy(generation int64, ancestors ...gwv1.PolicyAncestorStatus) *enterprisekgateway.EnterpriseKgatewayTrafficPolicy {
+	p := &enterprisekgateway.EnterpriseKgatewayTrafficPolicy{}
+	p.Generation = generation
+	p.Status.Ancestors = ancestors
+	return p
+}
+
+func ancestor(name string, c metav1.Condition) gwv1.PolicyAncestorStatus {
+	return gwv1.PolicyAncestorStatus{
+		AncestorRef: gwv1.ParentReference{Name: gwv1.ObjectName(name)},
+		Conditions:  []metav1.Condition{c},
+	}
+}
+
+func authConfig(state string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{"state": state, "reason": "bad config"},
+	}}
+	u.SetGroupVersionKind(extauthv1.AuthConfigGVK)
+	return u
+}
+
+func TestAcceptanceOf(t *testing.T) {
+	listenerSet := &enterprisesolo.EnterpriseListenerSet{}
+	listenerSet.Status.Conditions = []metav1.Condition{condition(metav1.ConditionTrue, "Accepted", "", 0)}
+	listenerSet.Status.Listeners = []enterprisesolo.EnterpriseListenerEntryStatus{
+		{Name: "http", Conditions: []metav1.Condition{condition(metav1.ConditionTrue, "Accepted", "", 0)}},
+		{Name: "https", Conditions: []metav1.Condition{condition(metav1.ConditionFalse, "Invalid", "no certificate", 0)}},
+	}
+	staleRateLimitConfig := &ratelimitv1alpha1.RateLimitConfig{}
+	staleRateLimitConfig.Generation = 3
+	staleRateLimitConfig.Status.State = ratelimitv1alpha1.RateLimitConfigStatus_ACCEPTED
+	staleRateLimitConfig.Status.ObservedGeneration = 2
+
+	tests := []struct {
+		name string
+		obj  metav1.Object
+		want acceptance
+	}{
+		{
+			name: "policy without ancestors",
+			obj:  trafficPolicy(1),
+			want: acceptance{phase: phasePending, message: "no ancestors"},
+		},
+		{
+			name: "policy accepted by every ancestor",
+			obj: trafficPolicy(2,
+				ancestor("a", condition(metav1.ConditionTrue, "Accepted", "", 2)),
+				ancestor("b", condition(metav1.ConditionTrue, "Accepted", "", 2))),
+			want: acceptance{phase: phaseAccepted, message: "accepted by 2 ancestor(s)"},
+		},
+		{
+			name: "policy rejected by an ancestor",
+			obj: trafficPolicy(2,
+				ancestor("a", condition(metav1.ConditionTrue, "Accepted", "", 2)),
+				ancestor("b", condition(metav1.ConditionFalse, "Invalid", "bad target", 2))),
+			want: acceptance{phase: phaseRejected, message: "Gateway b: Invalid: bad target"},
+		},
+		{
+			name: "policy status of an older generation",
+			obj:  trafficPolicy(3, ancestor("a", condition(metav1.ConditionTrue, "Accepted", "", 2))),
+			want: acceptance{phase: phasePending, message: "Gateway a: status is for generation 2, want 3"},
+		},
+		{
+			name: "listener set with a rejected listener",
+			obj:  listenerSet,
+			want: acceptance{phase: phaseWarning, message: "listener https: Invalid: no certificate"},
+		},
+		{
+			name: "AuthConfig with warnings",
+			obj:  authConfig("Warning"),
+			want: acceptance{phase: phaseWarning, message: "bad config"},
+		},
+		{
+			name: "rejected AuthConfig",
+			obj:  authConfig("Rejected"),
+			want: acceptance{phase: phaseRejected, message: "bad config"},
+		},
+		{
+			name: "RateLimitConfig status of an older generation",
+			obj:  staleRateLimitConfig,
+			want: acceptance{phase: phasePending, message: "status is for generation 2, want 3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := acceptanceOf(tt.obj); got != tt.want {
+				t.Errorf("acceptanceOf() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// pollInterval is the interval at which wait polls the resources.
+const pollInterval = 2 * time.Second
+
+// runWait waits until the named resources are accepted. It fails as soon as one of them
+// is rejected for its current generation, since the controller will not accept it until
+// the resource changes. Kinds whose status does not tell which generation it is for, such
+// as AuthConfigs, are refused.
+func runWait(ctx context.Context, args []string, stdout io.Writer) error {
+	var opts options
+	var forCondition string
+	var all bool
+	var timeout time.Duration
+	fs := flag.NewFlagSet("wait", flag.ContinueOnError)
+	opts.addFlags(fs)
+	fs.StringVar(&forCondition, "for", "", "condition to wait for; only accepted is supported")
+	fs.BoolVar(&all, "all", false, "wait for all resources of the kind in the namespace")
+	fs.DurationVar(&timeout, "timeout", 30*time.Second, "how long to wait")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if forCondition != "accepted" {
+		return fmt.Errorf("--for=accepted is required")
+	}
+	if len(args) == 0 || (len(args) == 1) != all {
+		return fmt.Errorf("usage: wait --for=accepted KIND (NAME... | --all)")
+	}
+	r, err := lookupResource(args[0])
+	if err != nil {
+		return err
+	}
+	if r.waitUnsupported != "" {
+		return fmt.Errorf("cannot wait for %s: %s; check their state with get instead", r.name, r.waitUnsupported)
+	}
+	c, err := opts.clients()
+	if err != nil {
+		return err
+	}
+
+	names := args[1:]
+	if all {
+		objs, err := r.list(ctx, c, c.namespace)
+		if err != nil {
+			return err
+		}
+		if len(objs) == 0 {
+			return fmt.Errorf("no %s found in %s namespace", r.name, c.namespace)
+		}
+		for _, obj := range objs {
+			names = append(names, obj.GetName())
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for _, name := range names {
+		var last acceptance
+		err := wait.PollUntilContextCancel(ctx, pollInterval, true, func(ctx context.Context) (bool, error) {
+			obj, err := r.get(ctx, c, c.namespace, name)
+			if k8serrors.IsNotFound(err) {
+				last = acceptance{phase: phasePending, message: "not found"}
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+			last = acceptanceOf(obj)
+			if last.phase == phaseRejected {
+				return false, fmt.Errorf("%s/%s rejected: %s", r.groupKind.Kind, name, last.message)
+			}
+			return last.phase.accepted(), nil
+		})
+		if wait.Interrupted(err) {
+			return fmt.Errorf("timed out waiting for %s/%s to be accepted: %s: %s", r.groupKind.Kind, name, last.phase, last.message)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "%s/%s condition met\n", r.groupKind.Kind, name)
+	}
+	return nil
+}
@@ -0,0 +1,276 @@
+// Package effectivepolicy computes the enterprise configuration that applies to each rule
+// of each HTTPRoute, from the EnterpriseKgatewayTrafficPolicies attached along the policy
+// attachment hierarchy: Gateways, their listeners, ListenerSets, their listeners, HTTPRoutes
+// and route rules.
+//
+// Each enterprise field of the policy spec, such as entExtAuth or entWAF, is merged as a
+// whole: the policy attached at the most specific level provides it, and policies attached
+// at the same level are ordered by creation timestamp, then by namespace and name, as
+// Gateway API conflict resolution does. A field whose disable option is set is unset in the
+// effective configuration, cancelling policies attached at less specific levels. The
+// beforeExtAuth and afterExtAuth stages of entJWT are merged and disabled independently.
+//
+// Every field set or disabled in the effective configuration is explained by a Source,
+// naming the policy and level that provided it and the policies it overrode.
+//
+// Routes are attached to the listeners selected by their parentRefs; the hostnames and
+// allowedRoutes of listeners are not checked.
+package effectivepolicy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/refgraph"
+)
+
+// Level is a level of the policy attachment hierarchy, from the least to the most specific.
+type Level int
+
+const (
+	// LevelGateway is a policy targeting a Gateway without a section name.
+	LevelGateway Level = iota
+	// LevelGatewayListener is a policy targeting a listener of a Gateway.
+	LevelGatewayListener
+	// LevelListenerSet is a policy targeting a ListenerSet without a section name.
+	LevelListenerSet
+	// LevelListenerSetListener is a policy targeting a listener of a ListenerSet.
+	LevelListenerSetListener
+	// LevelRoute is a policy targeting an HTTPRoute without a section name.
+	LevelRoute
+	// LevelRouteRule is a policy targeting a named rule of an HTTPRoute.
+	LevelRouteRule
+)
+
+var levelNames = []string{"Gateway", "GatewayListener", "ListenerSet", "ListenerSetListener", "Route", "RouteRule"}
+
+func (l Level) String() string {
+	if l < 0 || int(l) >= len(levelNames) {
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+	return levelNames[l]
+}
+
+// Target is a rule of an HTTPRoute, as attached to a listener.
+type Target struct {
+	// Route is the HTTPRoute.
+	Route types.NamespacedName
+	// RuleIndex is the index of the rule in the route, and RuleName its name, if any.
+	RuleIndex int
+	RuleName  gwv1.SectionName
+	// Gateway is the Gateway the route is attached to, directly or through a ListenerSet.
+	// It is empty if the route is attached to a ListenerSet that is not known.
+	Gateway types.NamespacedName
+	// ListenerSet is the ListenerSet the route is attached to, if any.
+	ListenerSet *refgraph.ObjectKey
+	// Listener is the name of the listener of the Gateway or ListenerSet. It is the section
+	// name of the parentRef, possibly empty, if the parent is not known.
+	Listener gwv1.SectionName
+}
+
+func (t Target) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "HTTPRoute %s rule %d", t.Route, t.RuleIndex)
+	if t.RuleName != "" {
+		fmt.Fprintf(&b, " (%s)", t.RuleName)
+	}
+	if t.ListenerSet != nil {
+		fmt.Fprintf(&b, " on %s", t.ListenerSet)
+	} else {
+		fmt.Fprintf(&b, " on Gateway %s", t.Gateway)
+	}
+	if t.Listener != "" {
+		fmt.Fprintf(&b, " listener %s", t.Listener)
+	}
+	return b.String()
+}
+
+// Source explains a field of the effective configuration.
+type Source struct {
+	// Field is the path of the field in the policy spec, such as "spec.entJWT.beforeExtAuth".
+	Field *field.Path
+	// Policy is the traffic policy that provides the field, and Level the level it is
+	// attached at.
+	Policy types.NamespacedName
+	Level  Level
+	// Disabled is true if the policy disables the field, which is then unset in the
+	// effective configuration.
+	Disabled bool
+	// Overridden are the other attached policies that set the field, from the most to the
+	// least specific.
+	Overridden []Override
+}
+
+func (s Source) String() string {
+	verb := "set"
+	if s.Disabled {
+		verb = "disabled"
+	}
+	msg := fmt.Sprintf("%s: %s by %s at %s level", s.Field, verb, s.Policy, s.Level)
+	for _, o := range s.Overridden {
+		msg += fmt.Sprintf(", overriding %s at %s level", o.Policy, o.Level)
+	}
+	return msg
+}
+
+// Override is a policy whose field was overridden by a more specific or older policy.
+type Override struct {
+	Policy types.NamespacedName
+	Level  Level
+}
+
+// Effective is the enterprise configuration that applies to a target.
+type Effective struct {
+	Target Target
+	// Spec holds the merged enterprise fields. Its target references and upstream fields
+	// are not set.
+	Spec enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec
+	// Sources explain the fields set or disabled in Spec, in the order requests flow
+	// through their filters: WAF, JWT before ext auth, ext auth, JWT after ext auth, RBAC,
+	// rate limiting and transformations.
+	Sources []Source
+	// Warnings describe effective configurations that are unlikely to behave as intended,
+	// such as RBAC policies without JWT authentication.
+	Warnings []string
+}
+
+// Source returns the source of the field with the given path, such as
+// "spec.entJWT.afterExtAuth".
+func (e *Effective) Source(path string) (Source, bool) {
+	for _, s := range e.Sources {
+		if s.Field.String() == path {
+			return s, true
+		}
+	}
+	return Source{}, false
+}
+
+// All returns the effective configuration of every rule of every HTTPRoute, for each
+// listener it is attached to, sorted by route, Gateway, ListenerSet, listener and rule.
+func (r *Resolver) All() []Effective {
+	var all []Effective
+	for _, route := range r.routes {
+		all = append(all, r.route(route)...)
+	}
+	return all
+}
+
+// Route returns the effective configuration of the rules of the named HTTPRoute, for each
+// listener it is attached to. It returns nil if the route is not known or not attached.
+func (r *Resolver) Route(namespace, name string) []Effective {
+	for _, route := range r.routes {
+		if route.Namespace == namespace && route.Name == name {
+			return r.route(route)
+		}
+	}
+	return nil
+}
+
+func (r *Resolver) route(route *gwv1.HTTPRoute) []Effective {
+	var all []Effective
+	routeAttachment := attachment{
+		key:    objectKey(HTTPRouteKind, route.Namespace, route.Name),
+		labels: route.Labels,
+		level:  LevelRoute,
+	}
+	for _, chain := range r.parentChains(route) {
+		for i, rule := range route.Spec.Rules {
+			t := chain.target
+			t.Route = types.NamespacedName{Namespace: route.Namespace, Name: route.Name}
+			t.RuleIndex = i
+			levels := append(chain.attachments[:len(chain.attachments):len(chain.attachments)], routeAttachment)
+			if rule.Name != nil {
+				t.RuleName = *rule.Name
+				ruleAttachment := routeAttachment
+				ruleAttachment.section, ruleAttachment.level = *rule.Name, LevelRouteRule
+				levels = append(levels, ruleAttachment)
+			}
+			all = append(all, r.effective(t, levels))
+		}
+	}
+	return all
+}
+
+// effective merges the policies attached to levels, which are ordered from the least to
+// the most specific.
+func (r *Resolver) effective(t Target, levels []attachment) Effective {
+	var matches []match
+	for i := len(levels) - 1; i >= 0; i-- {
+		for _, p := range r.policies[levels[i].key.Namespace] {
+			if levels[i].selectedBy(p) {
+				matches = append(matches, match{p, levels[i].level})
+			}
+		}
+	}
+
+	e := Effective{Target: t}
+	for _, f := range features {
+		var src *Source
+		for _, m := range matches {
+			set, disabled := f.get(&m.policy.Spec)
+			if !set {
+				continue
+			}
+			key := types.NamespacedName{Namespace: m.policy.Namespace, Name: m.policy.Name}
+			if src != nil {
+				src.Overridden = append(src.Overridden, Override{Policy: key, Level: m.level})
+				continue
+			}
+			src = &Source{Field: f.path, Policy: key, Level: m.level, Disabled: disabled}
+			if !disabled {
+				f.copy(&e.Spec, &m.policy.Spec)
+			}
+		}
+		if src != nil {
+			e.Sources = append(e.Sources, *src)
+		}
+	}
+	e.Warnings = warnings(&e.Spec)
+	return e
+}
+
+type match struct {
+	policy *enterprisekgateway.EnterpriseKgatewayTrafficPolicy
+	level  Level
+}
+
+// warnings reports RBAC policies that cannot match, as their principals are JWT principals.
+func warnings(spec *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) []string {
+	if spec.EntRBAC == nil || len(spec.EntRBAC.Policies) == 0 {
+		return nil
+	}
+	providers := map[string]bool{}
+	if spec.EntJWT != nil {
+		for _, jwt := range []*enterprisekgateway.EntJWT{spec.EntJWT.BeforeExtAuth, spec.EntJWT.AfterExtAuth} {
+			if jwt == nil {
+				continue
+			}
+			for name := range jwt.Providers {
+				providers[name] = true
+			}
+		}
+	}
+	if len(providers) == 0 {
+		return []string{"entRBAC has no effect: no entJWT provider authenticates requests"}
+	}
+	var warnings []string
+	names := make([]string, 0, len(spec.EntRBAC.Policies))
+	for name := range spec.EntRBAC.Policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for i, p := range spec.EntRBAC.Policies[name].Principals {
+			if provider := p.JWTPrincipal.Provider; provider != nil && !providers[*provider] {
+				warnings = append(warnings, fmt.Sprintf("entRBAC.policies[%s].principals[%d] never matches: entJWT has no provider %q", name, i, *provider))
+			}
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,268 @@
+package effectivepolicy
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/solo-io/kgateway-client/v2/refgraph"
+)
+
+const gateway = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: gw
+  namespace: infra
+  labels:
+    tier: edge
+spec:
+  gatewayClassName: enterprise-kgateway
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+  - name: tcp
+    port: 9000
+    protocol: TCP
+`
+
+const listenerSet = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: ListenerSet
+metadata:
+  name: ls
+  namespace: infra
+spec:
+  parentRef:
+    name: gw
+  listeners:
+  - name: extra
+    port: 8080
+    protocol: HTTP
+`
+
+// route has a named and an unnamed rule, and is attached to every HTTP listener of the
+// Gateway.
+const route = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: api
+  namespace: infra
+spec:
+  parentRefs:
+  - name: gw
+  rules:
+  - name: login
+  - {}
+`
+
+const listenerSetRoute = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: api
+  namespace: infra
+spec:
+  parentRefs:
+  - group: gateway.networking.k8s.io
+    kind: ListenerSet
+    name: ls
+  rules:
+  - {}
+`
+
+// policy returns a traffic policy in namespace infra, created at second created of the
+// day, with the target and enterprise fields of spec, indented by two spaces.
+func policy(name string, created int, spec string) string {
+	return fmt.Sprintf(`
+apiVersion: enterprisekgateway.solo.io/v1alpha1
+kind: EnterpriseKgatewayTrafficPolicy
+metadata:
+  name: %s
+  namespace: infra
+  creationTimestamp: "2025-01-01T00:00:%02dZ"
+spec:
+%s`, name, created, spec)
+}
+
+func targetRef(kind, name, section string) string {
+	group := "gateway.networking.k8s.io"
+	ref := fmt.Sprintf("  targetRefs:\n  - group: %s\n    kind: %s\n    name: %s\n", group, kind, name)
+	if section != "" {
+		ref += "    sectionName: " + section + "\n"
+	}
+	return ref
+}
+
+func extAuth(name string) string {
+	return "  entExtAuth:\n    authConfigRef:\n      name: " + name + "\n"
+}
+
+const (
+	waf        = "  entWAF:\n    wafPolicyRef:\n      name: crs\n"
+	wafDisable = "  entWAF:\n    disable: {}\n"
+	jwtBefore  = "    beforeExtAuth:\n      providers:\n        idp:\n          jwks:\n            local:\n              key: k\n"
+	jwtAfter   = "    afterExtAuth:\n      providers:\n        idp:\n          jwks:\n            local:\n              key: k\n"
+)
+
+func TestResolverRoute(t *testing.T) {
+	tests := []struct {
+		name string
+		docs []string
+		// want are the sources of each effective configuration, prefixed by its target.
+		want []string
+	}{
+		{
+			name: "more specific levels win",
+			docs: []string{
+				gateway, route,
+				policy("gateway", 0, targetRef("Gateway", "gw", "")+extAuth("a")),
+				policy("listener", 1, targetRef("Gateway", "gw", "http")+extAuth("b")),
+				policy("route", 2, targetRef("HTTPRoute", "api", "")+extAuth("c")),
+				policy("rule", 3, targetRef("HTTPRoute", "api", "login")+extAuth("d")),
+			},
+			want: []string{
+				"HTTPRoute infra/api rule 0 (login) on Gateway infra/gw listener http: spec.entExtAuth: set by infra/rule at RouteRule level, overriding infra/route at Route level, overriding infra/listener at GatewayListener level, overriding infra/gateway at Gateway level",
+				"HTTPRoute infra/api rule 1 on Gateway infra/gw listener http: spec.entExtAuth: set by infra/route at Route level, overriding infra/listener at GatewayListener level, overriding infra/gateway at Gateway level",
+			},
+		},
+		{
+			name: "ListenerSet levels",
+			docs: []string{
+				gateway, listenerSet, listenerSetRoute,
+				policy("gateway", 0, targetRef("Gateway", "gw", "")+waf),
+				policy("gateway-listener", 1, targetRef("Gateway", "gw", "http")+extAuth("a")),
+				policy("listenerset", 2, targetRef("ListenerSet", "ls", "")+extAuth("b")),
+				policy("listenerset-listener", 3, targetRef("ListenerSet", "ls", "extra")+waf),
+			},
+			want: []string{
+				"HTTPRoute infra/api rule 0 on ListenerSet.gateway.networking.k8s.io infra/ls listener extra: spec.entWAF: set by infra/listenerset-listener at ListenerSetListener level, overriding infra/gateway at Gateway level",
+				"HTTPRoute infra/api rule 0 on ListenerSet.gateway.networking.k8s.io infra/ls listener extra: spec.entExtAuth: set by infra/listenerset at ListenerSet level",
+			},
+		},
+		{
+			name: "older policy wins at the same level",
+			docs: []string{
+				gateway, route,
+				policy("a", 2, targetRef("HTTPRoute", "api", "")+extAuth("a")),
+				policy("b", 1, targetRef("HTTPRoute", "api", "")+extAuth("b")),
+			},
+			want: []string{
+				"HTTPRoute infra/api rule 0 (login) on Gateway infra/gw listener http: spec.entExtAuth: set by infra/b at Route level, overriding infra/a at Route level",
+				"HTTPRoute infra/api rule 1 on Gateway infra/gw listener http: spec.entExtAuth: set by infra/b at Route level, overriding infra/a at Route level",
+			},
+		},
+		{
+			name: "name breaks creation timestamp ties",
+			docs: []string{
+				gateway, route,
+				policy("b", 1, targetRef("HTTPRoute", "api", "")+extAuth("b")),
+				policy("a", 1, targetRef("HTTPRoute", "api", "")+extAuth("a")),
+			},
+			want: []string{
+				"HTTPRoute infra/api rule 0 (login) on Gateway infra/gw listener http: spec.entExtAuth: set by infra/a at Route level, overriding infra/b at Route level",
+				"HTTPRoute infra/api rule 1 on Gateway infra/gw listener http: spec.entExtAuth: set by infra/a at Route level, overriding infra/b at Route level",
+			},
+		},
+		{
+			name: "disable cancels less specific levels",
+			docs: []string{
+				gateway, route,
+				policy("gateway", 0, targetRef("Gateway", "gw", "")+waf),
+				policy("rule", 1, targetRef("HTTPRoute", "api", "login")+wafDisable),
+			},
+			want: []string{
+				"HTTPRoute infra/api rule 0 (login) on Gateway infra/gw listener http: spec.entWAF: disabled by infra/rule at RouteRule level, overriding infra/gateway at Gateway level",
+				"HTTPRoute infra/api rule 1 on Gateway infra/gw listener http: spec.entWAF: set by infra/gateway at Gateway level",
+			},
+		},
+		{
+			name: "JWT stages are merged independently",
+			docs: []string{
+				gateway, route,
+				policy("gateway", 0, targetRef("Gateway", "gw", "")+"  entJWT:\n"+jwtBefore+jwtAfter),
+				policy("route", 1, targetRef("HTTPRoute", "api", "")+"  entJWT:\n"+jwtAfter),
+				policy("rule", 2, targetRef("HTTPRoute", "api", "login")+"  entJWT:\n    beforeExtAuth:\n      disable: {}\n"),
+			},
+			want: []string{
+				"HTTPRoute infra/api rule 0 (login) on Gateway infra/gw listener http: spec.entJWT.beforeExtAuth: disabled by infra/rule at RouteRule level, overriding infra/gateway at Gateway level",
+				"HTTPRoute infra/api rule 0 (login) on Gateway infra/gw listener http: spec.entJWT.afterExtAuth: set by infra/route at Route level, overriding infra/gateway at Gateway level",
+				"HTTPRoute infra/api rule 1 on Gateway infra/gw listener http: spec.entJWT.beforeExtAuth: set by infra/gateway at Gateway level",
+				"HTTPRoute infra/api rule 1 on Gateway infra/gw listener http: spec.entJWT.afterExtAuth: set by infra/route at Route level, overriding infra/gateway at Gateway level",
+			},
+		},
+		{
+			name: "target selectors",
+			docs: []string{
+				gateway, route,
+				policy("edge", 0, "  targetSelectors:\n  - group: gateway.networking.k8s.io\n    kind: Gateway\n    matchLabels:\n      tier: edge\n"+waf),
+				policy("other", 0, "  targetSelectors:\n  - group: gateway.networking.k8s.io\n    kind: Gateway\n    matchLabels:\n      tier: internal\n"+extAuth("a")),
+			},
+			want: []string{
+				"HTTPRoute infra/api rule 0 (login) on Gateway infra/gw listener http: spec.entWAF: set by infra/edge at Gateway level",
+				"HTTPRoute infra/api rule 1 on Gateway infra/gw listener http: spec.entWAF: set by infra/edge at Gateway level",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newResolver(t, tt.docs...)
+			var got []string
+			for _, e := range r.Route("infra", "api") {
+				for _, s := range e.Sources {
+					got = append(got, e.Target.String()+": "+s.String())
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Route() sources =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func newResolver(t *testing.T, docs ...string) *Resolver {
+	t.Helper()
+	objs, err := refgraph.Decode(strings.NewReader(strings.Join(docs, "---")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := New(objs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestResolverRouteSpec(t *testing.T) {
+	r := newResolver(t, gateway, route,
+		policy("gateway", 0, targetRef("Gateway", "gw", "")+waf+"  entJWT:\n"+jwtBefore),
+		policy("rule", 1, targetRef("HTTPRoute", "api", "login")+wafDisable+"  entJWT:\n"+jwtAfter),
+	)
+	effective := r.Route("infra", "api")
+	if len(effective) != 2 {
+		t.Fatalf("Route() returned %d configurations, want 2", len(effective))
+	}
+	login := effective[0].Spec
+	if login.EntWAF != nil {
+		t.Errorf("disabled entWAF = %+v, want nil", login.EntWAF)
+	}
+	if login.EntJWT == nil || login.EntJWT.BeforeExtAuth == nil || login.EntJWT.AfterExtAuth == nil {
+		t.Errorf("entJWT = %+v, want both stages", login.EntJWT)
+	}
+	if other := effective[1].Spec; other.EntWAF == nil || other.EntWAF.WAFPolicyRef.Name != "crs" || other.EntJWT.AfterExtAuth != nil {
+		t.Errorf("spec of the unnamed rule = %+v, want the Gateway policy", other)
+	}
+}
+
+func TestResolverRouteNotAttached(t *testing.T) {
+	r := newResolver(t, gateway, strings.Replace(route, "- name: gw", "- name: gw\n    sectionName: tcp", 1))
+	if got := r.Route("infra", "api"); got != nil {
+		t.Errorf("Route() = %v, want nil for a route attached to a TCP listener", got)
+	}
+	if got := r.Route("infra", "missing"); got != nil {
+		t.Errorf("Route() = %v, want nil for an unknown route", got)
+	}
+}
@@ -0,0 +1,107 @@
+package effectivepolicy
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// feature is a field of EnterpriseKgatewayTrafficPolicySpec that is merged as a whole:
+// the most specific policy that sets it provides its value for a route rule.
+type feature struct {
+	path *field.Path
+	// get reports whether spec sets the feature, and whether it disables it.
+	get func(spec *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) (set, disabled bool)
+	// copy sets the feature of dst to the one of src.
+	copy func(dst, src *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec)
+}
+
+var specPath = field.NewPath("spec")
+
+// features are the merged fields, in the order requests flow through their filters. The
+// two JWT stages are separate features, so that a policy that only configures one stage
+// does not override the other, and so that each stage can be disabled on its own.
+var features = []feature{
+	{
+		path: specPath.Child("entWAF"),
+		get: func(s *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) (bool, bool) {
+			return s.EntWAF != nil, s.EntWAF != nil && s.EntWAF.Disable != nil
+		},
+		copy: func(dst, src *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) {
+			dst.EntWAF = src.EntWAF.DeepCopy()
+		},
+	},
+	{
+		path: specPath.Child("entJWT", "beforeExtAuth"),
+		get: func(s *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) (bool, bool) {
+			jwt := jwtStage(s, false)
+			return jwt != nil, jwt != nil && jwt.Disable != nil
+		},
+		copy: func(dst, src *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) {
+			if dst.EntJWT == nil {
+				dst.EntJWT = &enterprisekgateway.StagedJWT{}
+			}
+			dst.EntJWT.BeforeExtAuth = src.EntJWT.BeforeExtAuth.DeepCopy()
+		},
+	},
+	{
+		path: specPath.Child("entExtAuth"),
+		get: func(s *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) (bool, bool) {
+			return s.EntExtAuth != nil, s.EntExtAuth != nil && s.EntExtAuth.Disable != nil
+		},
+		copy: func(dst, src *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) {
+			dst.EntExtAuth = src.EntExtAuth.DeepCopy()
+		},
+	},
+	{
+		path: specPath.Child("entJWT", "afterExtAuth"),
+		get: func(s *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) (bool, bool) {
+			jwt := jwtStage(s, true)
+			return jwt != nil, jwt != nil && jwt.Disable != nil
+		},
+		copy: func(dst, src *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) {
+			if dst.EntJWT == nil {
+				dst.EntJWT = &enterprisekgateway.StagedJWT{}
+			}
+			dst.EntJWT.AfterExtAuth = src.EntJWT.AfterExtAuth.DeepCopy()
+		},
+	},
+	{
+		path: specPath.Child("entRBAC"),
+		get: func(s *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) (bool, bool) {
+			return s.EntRBAC != nil, s.EntRBAC != nil && s.EntRBAC.Disable != nil
+		},
+		copy: func(dst, src *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) {
+			dst.EntRBAC = src.EntRBAC.DeepCopy()
+		},
+	},
+	{
+		path: specPath.Child("entRateLimit"),
+		get: func(s *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) (bool, bool) {
+			return s.EntRateLimit != nil, false
+		},
+		copy: func(dst, src *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) {
+			dst.EntRateLimit = src.EntRateLimit.DeepCopy()
+		},
+	},
+	{
+		path: specPath.Child("entTransformation"),
+		get: func(s *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) (bool, bool) {
+			return s.EntTransformation != nil, false
+		},
+		copy: func(dst, src *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec) {
+			dst.EntTransformation = src.EntTransformation.DeepCopy()
+		},
+	},
+}
+
+func jwtStage(s *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec, after bool) *enterprisekgateway.EntJWT {
+	switch {
+	case s.EntJWT == nil:
+		return nil
+	case after:
+		return s.EntJWT.AfterExtAuth
+	default:
+		return s.EntJWT.BeforeExtAuth
+	}
+}
@@ -0,0 +1,303 @@
+package effectivepolicy
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+	"github.com/solo-io/kgateway-client/v2/refgraph"
+)
+
+var (
+	// GatewayKind is the kind of Gateway.
+	GatewayKind = gwv1.SchemeGroupVersion.WithKind("Gateway").GroupKind()
+	// HTTPRouteKind is the kind of HTTPRoute.
+	HTTPRouteKind = gwv1.SchemeGroupVersion.WithKind("HTTPRoute").GroupKind()
+	// ListenerSetKind is the kind of the Gateway API ListenerSet.
+	ListenerSetKind = gwv1.SchemeGroupVersion.WithKind("ListenerSet").GroupKind()
+	// EnterpriseListenerSetKind is the kind of EnterpriseListenerSet.
+	EnterpriseListenerSetKind = schema.GroupKind{Group: enterprisesolo.GroupName, Kind: "EnterpriseListenerSet"}
+)
+
+// kinds are the kinds of the objects used by the resolver.
+var kinds = map[schema.GroupKind]bool{
+	refgraph.TrafficPolicyKind: true,
+	GatewayKind:                true,
+	HTTPRouteKind:              true,
+	ListenerSetKind:            true,
+	EnterpriseListenerSetKind:  true,
+}
+
+// Resolver computes effective configurations from a set of objects.
+type Resolver struct {
+	// parents are the Gateways and ListenerSets.
+	parents map[refgraph.ObjectKey]*parent
+	// routes are sorted by namespace and name.
+	routes []*gwv1.HTTPRoute
+	// policies are keyed by namespace, in order of precedence.
+	policies map[string][]*enterprisekgateway.EnterpriseKgatewayTrafficPolicy
+}
+
+// parent is a Gateway or ListenerSet that routes attach to.
+type parent struct {
+	key    refgraph.ObjectKey
+	labels map[string]string
+	// gateway is the Gateway of a ListenerSet.
+	gateway   types.NamespacedName
+	listeners []listener
+}
+
+type listener struct {
+	name     gwv1.SectionName
+	port     gwv1.PortNumber
+	protocol gwv1.ProtocolType
+}
+
+// New returns a resolver for the EnterpriseKgatewayTrafficPolicies, Gateways, ListenerSets,
+// EnterpriseListenerSets and HTTPRoutes in objs. Lists are added item by item, unstructured
+// objects are converted with refgraph.Scheme, and objects of other kinds are ignored.
+func New(objs ...runtime.Object) (*Resolver, error) {
+	r := &Resolver{
+		parents:  map[refgraph.ObjectKey]*parent{},
+		policies: map[string][]*enterprisekgateway.EnterpriseKgatewayTrafficPolicy{},
+	}
+	for _, obj := range objs {
+		if err := r.add(obj); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(r.routes, func(i, j int) bool {
+		a, b := r.routes[i], r.routes[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	for _, policies := range r.policies {
+		sort.SliceStable(policies, func(i, j int) bool {
+			a, b := policies[i], policies[j]
+			if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+				return a.CreationTimestamp.Before(&b.CreationTimestamp)
+			}
+			return a.Name < b.Name
+		})
+	}
+	return r, nil
+}
+
+func (r *Resolver) add(obj runtime.Object) error {
+	if meta.IsListType(obj) {
+		items, err := meta.ExtractList(obj)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := r.add(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		// Only the kinds used here are converted: the conversion of others, such as
+		// AuthConfigs, may fail on fields irrelevant to the resolver.
+		if !kinds[u.GroupVersionKind().GroupKind()] {
+			return nil
+		}
+		typed, err := refgraph.Scheme.New(u.GroupVersionKind())
+		if err != nil {
+			return err
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, typed); err != nil {
+			return fmt.Errorf("%s %s/%s: %w", u.GetKind(), u.GetNamespace(), u.GetName(), err)
+		}
+		obj = typed
+	}
+
+	switch obj := obj.(type) {
+	case *enterprisekgateway.EnterpriseKgatewayTrafficPolicy:
+		r.policies[obj.Namespace] = append(r.policies[obj.Namespace], obj)
+	case *gwv1.HTTPRoute:
+		r.routes = append(r.routes, obj)
+	case *gwv1.Gateway:
+		p := &parent{
+			key:     objectKey(GatewayKind, obj.Namespace, obj.Name),
+			labels:  obj.Labels,
+			gateway: types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name},
+		}
+		for _, l := range obj.Spec.Listeners {
+			p.listeners = append(p.listeners, listener{l.Name, l.Port, l.Protocol})
+		}
+		r.parents[p.key] = p
+	case *gwv1.ListenerSet:
+		p := &parent{
+			key:     objectKey(ListenerSetKind, obj.Namespace, obj.Name),
+			labels:  obj.Labels,
+			gateway: parentGateway(obj.Namespace, obj.Spec.ParentRef.Namespace, obj.Spec.ParentRef.Name),
+		}
+		for _, l := range obj.Spec.Listeners {
+			p.listeners = append(p.listeners, listener{l.Name, l.Port, l.Protocol})
+		}
+		r.parents[p.key] = p
+	case *enterprisesolo.EnterpriseListenerSet:
+		p := &parent{
+			key:     objectKey(EnterpriseListenerSetKind, obj.Namespace, obj.Name),
+			labels:  obj.Labels,
+			gateway: parentGateway(obj.Namespace, obj.Spec.ParentRef.Namespace, obj.Spec.ParentRef.Name),
+		}
+		for _, l := range obj.Spec.Listeners {
+			p.listeners = append(p.listeners, listener{l.Name, gwv1.PortNumber(l.Port), l.Protocol})
+		}
+		r.parents[p.key] = p
+	}
+	return nil
+}
+
+func parentGateway(namespace string, refNamespace *gwv1.Namespace, name gwv1.ObjectName) types.NamespacedName {
+	if refNamespace != nil {
+		namespace = string(*refNamespace)
+	}
+	return types.NamespacedName{Namespace: namespace, Name: string(name)}
+}
+
+func objectKey(gk schema.GroupKind, namespace, name string) refgraph.ObjectKey {
+	return refgraph.ObjectKey{GroupKind: gk, NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}
+}
+
+// attachment is an object or section that policies may target.
+type attachment struct {
+	key    refgraph.ObjectKey
+	labels map[string]string
+	// section is the listener or rule name, or empty for the whole object.
+	section gwv1.SectionName
+	level   Level
+}
+
+// selectedBy reports whether a target reference or selector of p selects a.
+func (a attachment) selectedBy(p *enterprisekgateway.EnterpriseKgatewayTrafficPolicy) bool {
+	if p.Namespace != a.key.Namespace {
+		return false
+	}
+	for _, ref := range p.Spec.TargetRefs {
+		if string(ref.Group) == a.key.Group && string(ref.Kind) == a.key.Kind && string(ref.Name) == a.key.Name &&
+			sectionMatches(ref.SectionName, a.section) {
+			return true
+		}
+	}
+	for _, sel := range p.Spec.TargetSelectors {
+		if string(sel.Group) == a.key.Group && string(sel.Kind) == a.key.Kind && a.labels != nil &&
+			labels.SelectorFromSet(sel.MatchLabels).Matches(labels.Set(a.labels)) &&
+			sectionMatches(sel.SectionName, a.section) {
+			return true
+		}
+	}
+	return false
+}
+
+func sectionMatches(ref *gwv1.SectionName, section gwv1.SectionName) bool {
+	if ref == nil {
+		return section == ""
+	}
+	return *ref == section
+}
+
+// parentChain is the attachments of a listener that a route is attached to, from the
+// Gateway to the listener.
+type parentChain struct {
+	target      Target
+	attachments []attachment
+}
+
+// parentChains returns the listeners the parentRefs of route attach it to.
+func (r *Resolver) parentChains(route *gwv1.HTTPRoute) []parentChain {
+	var chains []parentChain
+	for _, ref := range route.Spec.ParentRefs {
+		gk := GatewayKind
+		if ref.Group != nil {
+			gk.Group = string(*ref.Group)
+		}
+		if ref.Kind != nil {
+			gk.Kind = string(*ref.Kind)
+		}
+		namespace := route.Namespace
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+		key := objectKey(gk, namespace, string(ref.Name))
+		isGateway := gk == GatewayKind
+		if !isGateway && gk != ListenerSetKind && gk != EnterpriseListenerSetKind {
+			continue
+		}
+		objectLevel, listenerLevel := LevelGateway, LevelGatewayListener
+		if !isGateway {
+			objectLevel, listenerLevel = LevelListenerSet, LevelListenerSetListener
+		}
+
+		p, known := r.parents[key]
+		if !known {
+			// Policies may still target the parent by name.
+			c := parentChain{attachments: []attachment{{key: key, level: objectLevel}}}
+			if isGateway {
+				c.target.Gateway = key.NamespacedName
+			} else {
+				c.target.ListenerSet = &key
+			}
+			if ref.SectionName != nil {
+				c.target.Listener = *ref.SectionName
+				c.attachments = append(c.attachments, attachment{key: key, section: *ref.SectionName, level: listenerLevel})
+			}
+			chains = append(chains, c)
+			continue
+		}
+
+		var base []attachment
+		target := Target{Gateway: p.gateway}
+		if !isGateway {
+			ls := key
+			target.ListenerSet = &ls
+			gatewayKey := refgraph.ObjectKey{GroupKind: GatewayKind, NamespacedName: p.gateway}
+			gateway := attachment{key: gatewayKey, level: LevelGateway}
+			if g, ok := r.parents[gatewayKey]; ok {
+				gateway.labels = g.labels
+			}
+			base = append(base, gateway)
+		}
+		base = append(base, attachment{key: key, labels: p.labels, level: objectLevel})
+		for _, l := range p.listeners {
+			if l.protocol != gwv1.HTTPProtocolType && l.protocol != gwv1.HTTPSProtocolType {
+				continue
+			}
+			if (ref.SectionName != nil && *ref.SectionName != l.name) || (ref.Port != nil && *ref.Port != l.port) {
+				continue
+			}
+			c := parentChain{target: target}
+			c.target.Listener = l.name
+			c.attachments = append(append([]attachment(nil), base...),
+				attachment{key: key, labels: p.labels, section: l.name, level: listenerLevel})
+			chains = append(chains, c)
+		}
+	}
+	sort.SliceStable(chains, func(i, j int) bool {
+		a, b := chains[i].target, chains[j].target
+		if a.Gateway != b.Gateway {
+			return a.Gateway.String() < b.Gateway.String()
+		}
+		if (a.ListenerSet == nil) != (b.ListenerSet == nil) {
+			return a.ListenerSet == nil
+		}
+		if a.ListenerSet != nil && *a.ListenerSet != *b.ListenerSet {
+			return a.ListenerSet.String() < b.ListenerSet.String()
+		}
+		return a.Listener < b.Listener
+	})
+	return chains
+}
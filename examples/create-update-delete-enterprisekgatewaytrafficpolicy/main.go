@@ -23,9 +23,8 @@ import (
 	"path/filepath"
 	"time"
 
-	upstreamkgateway "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
-	upstreamshared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
 	enterprisekgatewayv1alpha1 "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	enterprisekgatewaybuilders "github.com/solo-io/kgateway-client/v2/builders/enterprisekgateway"
 	clientset "github.com/solo-io/kgateway-client/v2/clientset/versioned"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
@@ -140,32 +139,14 @@ func main() {
 }
 
 func newDemoEnterpriseKgatewayTrafficPolicy(namespace string) *enterprisekgatewayv1alpha1.EnterpriseKgatewayTrafficPolicy {
-	return &enterprisekgatewayv1alpha1.EnterpriseKgatewayTrafficPolicy{
-		TypeMeta: metav1.TypeMeta{
-			APIVersion: enterprisekgatewayv1alpha1.SchemeGroupVersion.String(),
-			Kind:       "EnterpriseKgatewayTrafficPolicy",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      resourceName,
-			Namespace: namespace,
-		},
-		Spec: enterprisekgatewayv1alpha1.EnterpriseKgatewayTrafficPolicySpec{
-			TrafficPolicySpec: upstreamkgateway.TrafficPolicySpec{
-				TargetRefs: []upstreamshared.LocalPolicyTargetReferenceWithSectionName{
-					{
-						LocalPolicyTargetReference: upstreamshared.LocalPolicyTargetReference{
-							Group: gwv1.Group("gateway.networking.k8s.io"),
-							Kind:  gwv1.Kind("Gateway"),
-							Name:  gwv1.ObjectName(initialGatewayName),
-						},
-					},
-				},
-			},
-			EntExtAuth: &enterprisekgatewayv1alpha1.EntExtAuth{
-				Disable: &upstreamshared.PolicyDisable{},
-			},
-		},
+	policy, err := enterprisekgatewaybuilders.NewTrafficPolicy(namespace, resourceName).
+		TargetRefs(enterprisekgatewaybuilders.GatewayTarget(initialGatewayName)).
+		DisableExtAuth().
+		Build()
+	if err != nil {
+		panic(err)
 	}
+	return policy
 }
 
 func isEntExtAuthDisabled(policy *enterprisekgatewayv1alpha1.EnterpriseKgatewayTrafficPolicy) bool {
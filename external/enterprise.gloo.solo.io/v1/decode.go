@@ -0,0 +1,295 @@
+package v1
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/solo-io/kgateway-client/v2/external/protojsonutil"
+)
+
+// Without the kubernetes_protomessage_one_more_release tag, the protobuf runtime panics on
+// the AuthConfig protos, as SecretReference is not a proto message. AuthConfigSpecDescriptor
+// and DecodeAuthConfigSpec work around it: the descriptor is built again from the raw
+// descriptor of auth_config.proto, with a stand-in for the core.solo.io.ResourceRef
+// messages that SecretReference represents, and the specs decoded with it are copied to the
+// Go types by the field numbers of their struct tags.
+
+// resourceRefFile declares the core.solo.io.ResourceRef message, whose file is not
+// registered.
+var resourceRefFile = &descriptorpb.FileDescriptorProto{
+	Name:    proto.String("github.com/solo-io/solo-kit/api/v1/ref.proto"),
+	Package: proto.String("core.solo.io"),
+	Syntax:  proto.String("proto3"),
+	MessageType: []*descriptorpb.DescriptorProto{{
+		Name: proto.String("ResourceRef"),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			stringField("name", 1),
+			stringField("namespace", 2),
+		},
+	}},
+}
+
+var (
+	authConfigFileOnce sync.Once
+	authConfigFile     protoreflect.FileDescriptor
+	authConfigFileErr  error
+)
+
+func stringField(name string, number int32) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+	}
+}
+
+// dynamicFile returns the descriptor of auth_config.proto built from its raw descriptor.
+func dynamicFile() (protoreflect.FileDescriptor, error) {
+	authConfigFileOnce.Do(func() {
+		authConfigFile, authConfigFileErr = buildFile()
+	})
+	return authConfigFile, authConfigFileErr
+}
+
+func buildFile() (protoreflect.FileDescriptor, error) {
+	ref, err := protodesc.NewFile(resourceRefFile, nil)
+	if err != nil {
+		return nil, err
+	}
+	files := &protoregistry.Files{}
+	if err := files.RegisterFile(ref); err != nil {
+		return nil, err
+	}
+	fdp := &descriptorpb.FileDescriptorProto{}
+	if err := proto.Unmarshal([]byte(file_github_com_solo_io_solo_apis_api_gloo_enterprise_gloo_v1_auth_config_proto_rawDesc), fdp); err != nil {
+		return nil, err
+	}
+	// The solo-kit imports other than ref.proto only declare options, so they are left
+	// unresolved.
+	return protodesc.FileOptions{AllowUnresolvable: true}.New(fdp, resolver{files})
+}
+
+// resolver resolves the files of local before those of the global registry.
+type resolver struct {
+	local *protoregistry.Files
+}
+
+func (r resolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := r.local.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (r resolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if d, err := r.local.FindDescriptorByName(name); err == nil {
+		return d, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+// AuthConfigSpecDescriptor returns the descriptor of AuthConfigSpec, for use with
+// protojsonutil.UnknownFields and protojsonutil.OneofConflicts. Unlike the descriptor of
+// the Go type, it can be used without the kubernetes_protomessage_one_more_release tag.
+func AuthConfigSpecDescriptor() (protoreflect.MessageDescriptor, error) {
+	if protojsonutil.AuthConfigSupported() {
+		return (&AuthConfigSpec{}).ProtoReflect().Descriptor(), nil
+	}
+	fd, err := dynamicFile()
+	if err != nil {
+		return nil, err
+	}
+	return fd.Messages().ByName("AuthConfigSpec"), nil
+}
+
+// DecodeAuthConfigSpec decodes the JSON encoding of an AuthConfig spec, ignoring unknown
+// fields, as UnmarshalJSON does with the kubernetes_protomessage_one_more_release tag. It
+// does not require the tag.
+func DecodeAuthConfigSpec(b []byte) (*AuthConfigSpec, error) {
+	s := &AuthConfigSpec{}
+	if protojsonutil.AuthConfigSupported() {
+		return s, protojsonutil.Unmarshal(b, s, false)
+	}
+	return s, decodeDynamic(b, s)
+}
+
+// decodeDynamic decodes b to s through a dynamic message of the descriptor of dynamicFile.
+func decodeDynamic(b []byte, s *AuthConfigSpec) error {
+	fd, err := dynamicFile()
+	if err != nil {
+		return err
+	}
+	m := dynamicpb.NewMessage(fd.Messages().ByName("AuthConfigSpec"))
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, m); err != nil {
+		return err
+	}
+	return setMessage(reflect.ValueOf(s), m)
+}
+
+var (
+	oneofWrappersOnce sync.Once
+	oneofWrappers     []reflect.Type
+)
+
+// wrapperFor returns the oneof wrapper type of the field number n implementing the
+// interface t of a oneof field.
+func wrapperFor(t reflect.Type, n protoreflect.FieldNumber) reflect.Type {
+	oneofWrappersOnce.Do(func() {
+		for i := range file_github_com_solo_io_solo_apis_api_gloo_enterprise_gloo_v1_auth_config_proto_msgTypes {
+			for _, w := range file_github_com_solo_io_solo_apis_api_gloo_enterprise_gloo_v1_auth_config_proto_msgTypes[i].OneofWrappers {
+				oneofWrappers = append(oneofWrappers, reflect.TypeOf(w))
+			}
+		}
+	})
+	for _, w := range oneofWrappers {
+		if w.Implements(t) && fieldNumber(w.Elem().Field(0)) == n {
+			return w
+		}
+	}
+	return nil
+}
+
+// fieldNumber returns the field number of the protobuf struct tag of f, or 0.
+func fieldNumber(f reflect.StructField) protoreflect.FieldNumber {
+	parts := strings.Split(f.Tag.Get("protobuf"), ",")
+	if len(parts) < 2 {
+		return 0
+	}
+	n, _ := strconv.Atoi(parts[1])
+	return protoreflect.FieldNumber(n)
+}
+
+// setMessage copies the populated fields of src to dst, a pointer to the Go type of the
+// message.
+func setMessage(dst reflect.Value, src protoreflect.Message) error {
+	var err error
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		err = setField(dst.Elem(), fd, v)
+		return err == nil
+	})
+	return err
+}
+
+func setField(s reflect.Value, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	t := s.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if fieldNumber(f) == fd.Number() {
+			return setValue(s.Field(i), fd, v)
+		}
+		if od := fd.ContainingOneof(); od != nil && f.Tag.Get("protobuf_oneof") == string(od.Name()) {
+			w := wrapperFor(f.Type, fd.Number())
+			if w == nil {
+				break
+			}
+			wv := reflect.New(w.Elem())
+			if err := setValue(wv.Elem().Field(0), fd, v); err != nil {
+				return err
+			}
+			s.Field(i).Set(wv)
+			return nil
+		}
+	}
+	return fmt.Errorf("%s has no field %s", t, fd.Name())
+}
+
+func setValue(dst reflect.Value, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch {
+	case fd.IsList():
+		l := v.List()
+		items := reflect.MakeSlice(dst.Type(), l.Len(), l.Len())
+		for i := 0; i < l.Len(); i++ {
+			if err := setSingular(items.Index(i), fd, l.Get(i)); err != nil {
+				return err
+			}
+		}
+		dst.Set(items)
+	case fd.IsMap():
+		entries := reflect.MakeMapWithSize(dst.Type(), v.Map().Len())
+		var err error
+		v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+			key := reflect.New(dst.Type().Key()).Elem()
+			value := reflect.New(dst.Type().Elem()).Elem()
+			if err = setSingular(key, fd.MapKey(), k.Value()); err != nil {
+				return false
+			}
+			if err = setSingular(value, fd.MapValue(), mv); err != nil {
+				return false
+			}
+			entries.SetMapIndex(key, value)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		dst.Set(entries)
+	default:
+		return setSingular(dst, fd, v)
+	}
+	return nil
+}
+
+func setSingular(dst reflect.Value, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return setMessageValue(dst, v.Message())
+	}
+	if dst.Kind() == reflect.Pointer {
+		// A proto3 optional field.
+		dst.Set(reflect.New(dst.Type().Elem()))
+		dst = dst.Elem()
+	}
+	if fd.Kind() == protoreflect.EnumKind {
+		dst.SetInt(int64(v.Enum()))
+		return nil
+	}
+	dst.Set(reflect.ValueOf(v.Interface()).Convert(dst.Type()))
+	return nil
+}
+
+// setMessageValue sets dst, a pointer to the Go type of a message, to a copy of m.
+func setMessageValue(dst reflect.Value, m protoreflect.Message) error {
+	p := reflect.New(dst.Type().Elem())
+	if ref, ok := p.Interface().(*corev1.SecretReference); ok {
+		fields := m.Descriptor().Fields()
+		ref.Name = m.Get(fields.ByName("name")).String()
+		ref.Namespace = m.Get(fields.ByName("namespace")).String()
+		dst.Set(p)
+		return nil
+	}
+	if dst.Type().Elem().PkgPath() == reflect.TypeOf(AuthConfigSpec{}).PkgPath() {
+		if err := setMessage(p, m); err != nil {
+			return err
+		}
+		dst.Set(p)
+		return nil
+	}
+	// The well-known types are proto messages in any build.
+	x, ok := p.Interface().(proto.Message)
+	if !ok {
+		return fmt.Errorf("unsupported message type %s", dst.Type())
+	}
+	b, err := proto.Marshal(m.Interface())
+	if err != nil {
+		return err
+	}
+	if err := proto.Unmarshal(b, x); err != nil {
+		return err
+	}
+	dst.Set(p)
+	return nil
+}
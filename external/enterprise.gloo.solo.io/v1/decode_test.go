@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/solo-io/kgateway-client/v2/external/protojsonutil"
+)
+
+const oidcSpec = `{
+	"configs": [{
+		"name": "oidc",
+		"oauth2": {
+			"oidcAuthorizationCode": {
+				"clientId": "client",
+				"clientSecretRef": {"name": "oauth", "namespace": "gloo-system"},
+				"issuerUrl": "https://idp.example.com/",
+				"authEndpointQueryParams": {"prompt": "login"},
+				"appUrl": "https://app.example.com",
+				"callback_path": "/callback",
+				"scopes": ["openid", "email"],
+				"discoveryPollInterval": "30s",
+				"session": {
+					"cookieOptions": {"maxAge": 3600, "httpOnly": true, "sameSite": "StrictMode"},
+					"redis": {"options": {"host": "redis:6379", "socketType": 1}, "preExpiryBuffer": "2s"}
+				},
+				"misspelled": true
+			}
+		}
+	}],
+	"booleanExpr": "oidc"
+}`
+
+func TestDecodeDynamic(t *testing.T) {
+	want := &AuthConfigSpec{
+		Configs: []*AuthConfigSpec_Config{{
+			Name: wrapperspb.String("oidc"),
+			AuthConfig: &AuthConfigSpec_Config_Oauth2{Oauth2: &OAuth2{
+				OauthType: &OAuth2_OidcAuthorizationCode{OidcAuthorizationCode: &OidcAuthorizationCode{
+					ClientId:                "client",
+					ClientSecretRef:         &corev1.SecretReference{Name: "oauth", Namespace: "gloo-system"},
+					IssuerUrl:               "https://idp.example.com/",
+					AuthEndpointQueryParams: map[string]string{"prompt": "login"},
+					AppUrl:                  "https://app.example.com",
+					CallbackPath:            "/callback",
+					Scopes:                  []string{"openid", "email"},
+					DiscoveryPollInterval:   durationpb.New(30e9),
+					Session: &UserSession{
+						CookieOptions: &UserSession_CookieOptions{
+							MaxAge:   wrapperspb.UInt32(3600),
+							HttpOnly: wrapperspb.Bool(true),
+							SameSite: UserSession_CookieOptions_StrictMode,
+						},
+						Session: &UserSession_Redis{Redis: &UserSession_RedisSession{
+							Options:         &RedisOptions{Host: "redis:6379", SocketType: RedisOptions_TLS},
+							PreExpiryBuffer: durationpb.New(2e9),
+						}},
+					},
+				}},
+			}},
+		}},
+		BooleanExpr: wrapperspb.String("oidc"),
+	}
+
+	got := &AuthConfigSpec{}
+	if err := decodeDynamic([]byte(oidcSpec), got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("decodeDynamic() = %v, want %v", got, want)
+	}
+	if protojsonutil.AuthConfigSupported() {
+		typed := &AuthConfigSpec{}
+		if err := protojsonutil.Unmarshal([]byte(oidcSpec), typed, false); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(typed) {
+			t.Errorf("decodeDynamic() = %v, protojson decodes %v", got, typed)
+		}
+	}
+
+	if err := decodeDynamic([]byte(`{"configs": [{"basicAuth": {}, "jwt": {}}]}`), &AuthConfigSpec{}); err == nil {
+		t.Error("decodeDynamic() succeeded with conflicting oneof fields")
+	}
+	if err := decodeDynamic([]byte(`{"configs": [{"name": 1}]}`), &AuthConfigSpec{}); err == nil {
+		t.Error("decodeDynamic() succeeded with a value of the wrong type")
+	}
+}
+
+func TestAuthConfigSpecDescriptor(t *testing.T) {
+	md, err := AuthConfigSpecDescriptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknown, err := protojsonutil.UnknownFields([]byte(oidcSpec), md, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unknown) != 1 || unknown[0].String() != "configs[0].oauth2.oidcAuthorizationCode.misspelled" {
+		t.Errorf("UnknownFields() = %v, want the misspelled field", unknown)
+	}
+}
@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/solo-io/kgateway-client/v2/external/protojsonutil"
+)
+
+func TestAuthConfigSpecJSON(t *testing.T) {
+	data := []byte(`{"configs": [], "bogus": 1}`)
+	spec := &AuthConfigSpec{}
+	strictErr := spec.UnmarshalJSONStrict(data)
+	if !protojsonutil.AuthConfigSupported() {
+		_, marshalErr := spec.MarshalJSON()
+		for name, err := range map[string]error{
+			"MarshalJSON":          marshalErr,
+			"UnmarshalJSON":        spec.UnmarshalJSON(data),
+			"UnmarshalJSONStrict":  strictErr,
+			"status UnmarshalJSON": (&AuthConfigStatus{}).UnmarshalJSON([]byte(`{}`)),
+		} {
+			if !errors.Is(err, protojsonutil.ErrAuthConfigUnsupported) {
+				t.Errorf("%s() error = %v, want ErrAuthConfigUnsupported", name, err)
+			}
+		}
+		return
+	}
+
+	var unknown *protojsonutil.UnknownFieldsError
+	if !errors.As(strictErr, &unknown) || unknown.Error() != `unknown field "bogus"` {
+		t.Errorf("UnmarshalJSONStrict() error = %v, want unknown field bogus", strictErr)
+	}
+	if err := spec.UnmarshalJSON(data); err != nil {
+		t.Errorf("UnmarshalJSON() error = %v", err)
+	}
+	if _, err := spec.MarshalJSON(); err != nil {
+		t.Errorf("MarshalJSON() error = %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ReferenceKind is the kind of object an AuthConfig refers to.
+type ReferenceKind string
+
+const (
+	ReferenceKindSecret    ReferenceKind = "Secret"
+	ReferenceKindConfigMap ReferenceKind = "ConfigMap"
+)
+
+// Reference is a reference of an AuthConfigSpec to a Secret or ConfigMap.
+type Reference struct {
+	Kind ReferenceKind
+	// NamespacedName is the referenced object. For references by label selector, Name is
+	// empty, as is Namespace: the ext-auth service looks up the selected Secrets in all the
+	// namespaces it watches.
+	types.NamespacedName
+	// LabelSelector selects the Secrets of references by label selector.
+	LabelSelector map[string]string
+	// Path is the path of the reference, such as
+	// "spec.configs[0].oauth2.oidcAuthorizationCode.clientSecretRef".
+	Path *field.Path
+}
+
+// References returns the Secrets and ConfigMaps referenced by x, in the order of the
+// fields of the spec, with paths under fldPath, the path of the spec. References without a
+// namespace are resolved in namespace, which is the namespace of the AuthConfig. fldPath
+// may be nil for paths relative to the spec.
+//
+// The spec is walked with its getters rather than with proto reflection, so References
+// does not require the kubernetes_protomessage_one_more_release build tag.
+func (x *AuthConfigSpec) References(namespace string, fldPath *field.Path) []Reference {
+	w := &referenceWalker{namespace: namespace}
+	for i, c := range x.GetConfigs() {
+		p := fldPath.Child("configs").Index(i)
+		if oauth := c.GetOauth(); oauth != nil {
+			w.secret(oauth.GetClientSecretRef(), p.Child("oauth", "clientSecretRef"))
+		}
+		if oauth2 := c.GetOauth2(); oauth2 != nil {
+			w.oauth2(oauth2, p.Child("oauth2"))
+		}
+		if apiKey := c.GetApiKeyAuth(); apiKey != nil {
+			w.apiKeyAuth(apiKey, p.Child("apiKeyAuth"))
+		}
+		for j, m := range c.GetOpaAuth().GetModules() {
+			w.add(ReferenceKindConfigMap, m, p.Child("opaAuth", "modules").Index(j))
+		}
+		w.secret(c.GetLdap().GetGroupLookupSettings().GetCredentialsSecretRef(), p.Child("ldap", "groupLookupSettings", "credentialsSecretRef"))
+		if pt := c.GetPassThroughAuth(); pt != nil {
+			w.secret(pt.GetGrpc().GetTlsConfig().GetSecretRef(), p.Child("passThroughAuth", "grpc", "tlsConfig", "secretRef"))
+			w.secret(pt.GetHttp().GetTlsConfig().GetSecretRef(), p.Child("passThroughAuth", "http", "tlsConfig", "secretRef"))
+		}
+		for j, ref := range c.GetHmacAuth().GetSecretRefs().GetSecretRefs() {
+			w.secret(ref, p.Child("hmacAuth", "secretRefs", "secretRefs").Index(j))
+		}
+	}
+	return w.refs
+}
+
+type referenceWalker struct {
+	namespace string
+	refs      []Reference
+}
+
+func (w *referenceWalker) oauth2(oauth2 *OAuth2, p *field.Path) {
+	if oidc := oauth2.GetOidcAuthorizationCode(); oidc != nil {
+		op := p.Child("oidcAuthorizationCode")
+		w.secret(oidc.GetClientSecretRef(), op.Child("clientSecretRef"))
+		w.session(oidc.GetSession(), op.Child("session"))
+		auth := oidc.GetClientAuthentication()
+		w.secret(auth.GetClientSecret().GetClientSecretRef(), op.Child("clientAuthentication", "clientSecret", "clientSecretRef"))
+		w.secret(auth.GetPrivateKeyJwt().GetSigningKeyRef(), op.Child("clientAuthentication", "privateKeyJwt", "signingKeyRef"))
+		w.secret(oidc.GetAzure().GetClientSecret(), op.Child("azure", "clientSecret"))
+	}
+	if atv := oauth2.GetAccessTokenValidation(); atv != nil {
+		ap := p.Child("accessTokenValidation")
+		w.secret(atv.GetIntrospection().GetClientSecretRef(), ap.Child("introspection", "clientSecretRef"))
+		w.secret(atv.GetAzure().GetClientSecret(), ap.Child("azure", "clientSecret"))
+	}
+	if plain := oauth2.GetOauth2(); plain != nil {
+		w.secret(plain.GetClientSecretRef(), p.Child("oauth2", "clientSecretRef"))
+		w.session(plain.GetSession(), p.Child("oauth2", "session"))
+	}
+}
+
+func (w *referenceWalker) session(session *UserSession, p *field.Path) {
+	w.secret(session.GetCipherConfig().GetKeyRef(), p.Child("cipherConfig", "keyRef"))
+}
+
+func (w *referenceWalker) apiKeyAuth(apiKey *ApiKeyAuth, p *field.Path) {
+	w.selector(apiKey.GetLabelSelector(), p.Child("labelSelector"))
+	for i, ref := range apiKey.GetApiKeySecretRefs() {
+		w.secret(ref, p.Child("apiKeySecretRefs").Index(i))
+	}
+	if storage := apiKey.GetK8SSecretApikeyStorage(); storage != nil {
+		sp := p.Child("k8sSecretApikeyStorage")
+		w.selector(storage.GetLabelSelector(), sp.Child("labelSelector"))
+		for i, ref := range storage.GetApiKeySecretRefs() {
+			w.secret(ref, sp.Child("apiKeySecretRefs").Index(i))
+		}
+	}
+	w.secret(apiKey.GetHmac().GetSharedSecretRef(), p.Child("hmac", "sharedSecretRef"))
+}
+
+func (w *referenceWalker) secret(ref *corev1.SecretReference, p *field.Path) {
+	w.add(ReferenceKindSecret, ref, p)
+}
+
+func (w *referenceWalker) add(kind ReferenceKind, ref *corev1.SecretReference, p *field.Path) {
+	if ref == nil {
+		return
+	}
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = w.namespace
+	}
+	w.refs = append(w.refs, Reference{
+		Kind:           kind,
+		NamespacedName: types.NamespacedName{Namespace: namespace, Name: ref.Name},
+		Path:           p,
+	})
+}
+
+func (w *referenceWalker) selector(selector map[string]string, p *field.Path) {
+	if len(selector) == 0 {
+		return
+	}
+	w.refs = append(w.refs, Reference{Kind: ReferenceKindSecret, LabelSelector: selector, Path: p})
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+//go:generate go run -tags kubernetes_protomessage_one_more_release ../../gen -proto github.com/solo-io/solo-apis/api/gloo/enterprise.gloo/v1/auth_config.proto
+//go:generate go run -tags kubernetes_protomessage_one_more_release ../../gen -proto github.com/solo-io/solo-apis/api/gloo/enterprise.gloo/v1/auth_config.proto -json gloo_json.gen.go -types AuthConfigSpec,AuthConfigStatus -enums-as-ints
+
+// The AuthConfig protos refer to secrets with core.solo.io.ResourceRef messages, which
+// this package represents with the Kubernetes SecretReference. That type is not a proto
+// message, so external/gen rewrites the generated Equal and Clone functions to compare it
+// with secretReferenceEqual and copy it with its DeepCopy method instead of the proto
+// package.
+
+// secretReferenceEqual reports whether a and b refer to the same secret.
+func secretReferenceEqual(a, b *corev1.SecretReference) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
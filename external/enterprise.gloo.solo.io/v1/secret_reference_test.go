@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"hash"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type generatedMessage interface {
+	Equal(that interface{}) bool
+	Hash(hasher hash.Hash64) (uint64, error)
+	Clone() proto.Message
+}
+
+func TestSecretReferenceFields(t *testing.T) {
+	tests := []struct {
+		name string
+		// build returns a message holding the secret reference ref.
+		build func(ref *corev1.SecretReference) generatedMessage
+		// ref returns the secret reference of a message returned by build.
+		ref func(m generatedMessage) *corev1.SecretReference
+	}{
+		{
+			name: "field",
+			build: func(ref *corev1.SecretReference) generatedMessage {
+				return &Settings{ExtauthzServerRef: ref}
+			},
+			ref: func(m generatedMessage) *corev1.SecretReference { return m.(*Settings).GetExtauthzServerRef() },
+		},
+		{
+			name: "oneof field",
+			build: func(ref *corev1.SecretReference) generatedMessage {
+				return &ExtAuthExtension{Spec: &ExtAuthExtension_ConfigRef{ConfigRef: ref}}
+			},
+			ref: func(m generatedMessage) *corev1.SecretReference { return m.(*ExtAuthExtension).GetConfigRef() },
+		},
+		{
+			name: "repeated field",
+			build: func(ref *corev1.SecretReference) generatedMessage {
+				return &SecretRefList{SecretRefs: []*corev1.SecretReference{{Name: "first"}, ref}}
+			},
+			ref: func(m generatedMessage) *corev1.SecretReference { return m.(*SecretRefList).GetSecretRefs()[1] },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.build(&corev1.SecretReference{Namespace: "ns", Name: "a"})
+			same := tt.build(&corev1.SecretReference{Namespace: "ns", Name: "a"})
+			other := tt.build(&corev1.SecretReference{Namespace: "ns", Name: "b"})
+			otherNamespace := tt.build(&corev1.SecretReference{Namespace: "other", Name: "a"})
+			unset := tt.build(nil)
+
+			if !m.Equal(same) {
+				t.Error("Equal() = false for the same secret")
+			}
+			for name, o := range map[string]generatedMessage{"name": other, "namespace": otherNamespace, "unset": unset} {
+				if m.Equal(o) || o.Equal(m) {
+					t.Errorf("Equal() = true for a different secret %s", name)
+				}
+			}
+
+			h, err := m.Hash(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hs, _ := same.Hash(nil); hs != h {
+				t.Errorf("Hash() = %d for the same secret, want %d", hs, h)
+			}
+			if ho, _ := other.Hash(nil); ho == h {
+				t.Error("Hash() is the same for a different secret")
+			}
+
+			c := m.Clone().(generatedMessage)
+			if !c.Equal(m) {
+				t.Fatal("Clone() is not equal to its source")
+			}
+			if tt.ref(c) == tt.ref(m) {
+				t.Fatal("Clone() shares the secret reference of its source")
+			}
+			tt.ref(m).Name = "changed"
+			if got := tt.ref(c).Name; got != "a" {
+				t.Errorf("clone secret name = %q after changing the source, want %q", got, "a")
+			}
+		})
+	}
+}
@@ -0,0 +1,390 @@
+package v1
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/solo-io/kgateway-client/v2/external/protojsonutil"
+)
+
+// The Validate methods in this file check an AuthConfigSpec without an API server or
+// ext-auth service. They mirror the Required and XValidation markers of the generated
+// types, with the messages of the CRD schema, and add the checks that ext-auth performs
+// when it translates the spec: URL formats, callback paths and the Aerospike TLS settings.
+//
+// Oneof fields can not conflict in Go, so Validate only checks them for being set.
+// ValidateAuthConfigSpecJSON also reports conflicting oneof fields in JSON, such as both a
+// cookie and a redis session, which can not be decoded.
+
+// The messages of the XValidation rules that are reported for more than one case.
+const (
+	oidcClientSecretMessage          = "If clientAuthentication is set, neither clientSecretRef nor disableClientSecret may be set. Otherwise, clientSecretRef must be set or disableClientSecret must be true."
+	introspectionClientSecretMessage = "If clientId is set, clientSecretRef must be set or disableClientSecret must be true. Otherwise, clientSecretRef must not be set."
+)
+
+// authConfigTypes are the JSON names of the fields of the auth_config oneof of
+// AuthConfigSpec_Config.
+var authConfigTypes = []string{
+	"basicAuth", "oauth", "oauth2", "apiKeyAuth", "pluginAuth", "opaAuth", "ldap", "jwt",
+	"passThroughAuth", "hmacAuth", "opaServerAuth", "portalAuth",
+}
+
+// aerospikeTLSVersions are the TLS versions supported by the Aerospike API key storage.
+var aerospikeTLSVersions = []string{"1.0", "1.1", "1.2", "1.3"}
+
+// Validate validates the spec, with paths under "spec". The spec is walked with its
+// getters, so Validate does not require the kubernetes_protomessage_one_more_release
+// build tag.
+func (x *AuthConfigSpec) Validate() field.ErrorList {
+	return x.validate(field.NewPath("spec"))
+}
+
+// ValidateAuthConfigSpecJSON validates the JSON encoding of an AuthConfig spec, such as the
+// spec of an unstructured AuthConfig, with paths under "spec". Objects that set more than
+// one field of a oneof are reported, as the spec can not be decoded then; otherwise the
+// decoded spec is validated with Validate. Unknown fields are ignored. It does not require
+// the kubernetes_protomessage_one_more_release build tag.
+func ValidateAuthConfigSpecJSON(b []byte) (field.ErrorList, error) {
+	md, err := AuthConfigSpecDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	specPath := field.NewPath("spec")
+	conflicts, err := protojsonutil.OneofConflicts(b, md, specPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 {
+		return conflicts, nil
+	}
+	s, err := DecodeAuthConfigSpec(b)
+	if err != nil {
+		return nil, err
+	}
+	return s.validate(specPath), nil
+}
+
+func (x *AuthConfigSpec) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	configsPath := fldPath.Child("configs")
+	if len(x.GetConfigs()) == 0 {
+		allErrs = append(allErrs, field.Required(configsPath, ""))
+	}
+	for i, c := range x.GetConfigs() {
+		allErrs = append(allErrs, c.validate(configsPath.Index(i))...)
+	}
+	return allErrs
+}
+
+func (x *AuthConfigSpec_Config) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch {
+	case x.GetBasicAuth() != nil:
+		allErrs = append(allErrs, x.GetBasicAuth().validate(fldPath.Child("basicAuth"))...)
+	case x.GetOauth() != nil:
+		allErrs = append(allErrs, x.GetOauth().validate(fldPath.Child("oauth"))...)
+	case x.GetOauth2() != nil:
+		allErrs = append(allErrs, x.GetOauth2().validate(fldPath.Child("oauth2"))...)
+	case x.GetApiKeyAuth() != nil:
+		allErrs = append(allErrs, x.GetApiKeyAuth().validate(fldPath.Child("apiKeyAuth"))...)
+	case x.GetPluginAuth() != nil:
+		allErrs = append(allErrs, x.GetPluginAuth().validate(fldPath.Child("pluginAuth"))...)
+	case x.GetOpaAuth() != nil:
+		allErrs = append(allErrs, requiredString(fldPath.Child("opaAuth", "query"), x.GetOpaAuth().GetQuery())...)
+	case x.GetLdap() != nil:
+		allErrs = append(allErrs, x.GetLdap().validate(fldPath.Child("ldap"))...)
+	case x.GetJwt() != nil:
+		// The jwt config has no settings.
+	case x.GetPassThroughAuth() != nil:
+		allErrs = append(allErrs, x.GetPassThroughAuth().validate(fldPath.Child("passThroughAuth"))...)
+	case x.GetHmacAuth() != nil:
+		allErrs = append(allErrs, x.GetHmacAuth().validate(fldPath.Child("hmacAuth"))...)
+	case x.GetOpaServerAuth() != nil:
+		allErrs = append(allErrs, requiredString(fldPath.Child("opaServerAuth", "package"), x.GetOpaServerAuth().GetPackage())...)
+	case x.GetPortalAuth() != nil:
+		allErrs = append(allErrs, validateURL(fldPath.Child("portalAuth", "url"), x.GetPortalAuth().GetUrl())...)
+		allErrs = append(allErrs, x.GetPortalAuth().GetRedisOptions().validate(fldPath.Child("portalAuth", "redisOptions"))...)
+	default:
+		allErrs = append(allErrs, field.Invalid(fldPath, "object", fmt.Sprintf("exactly one of the fields in [%s] must be set", strings.Join(authConfigTypes, " "))))
+	}
+	return allErrs
+}
+
+func (x *BasicAuth) validate(fldPath *field.Path) field.ErrorList {
+	apr, encryption, userList := x.GetApr() != nil, x.GetEncryption() != nil, x.GetUserList() != nil
+	if (apr && (encryption || userList)) || (!apr && !(encryption && userList)) {
+		return field.ErrorList{field.Invalid(fldPath, "object", "Either apr or both encryption and userSource must be set; apr may not be set alongside either encryption or userSource")}
+	}
+	return nil
+}
+
+func (x *OAuth) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateURL(fldPath.Child("issuerUrl"), x.GetIssuerUrl())...)
+	allErrs = append(allErrs, requiredURL(fldPath.Child("appUrl"), x.GetAppUrl())...)
+	allErrs = append(allErrs, validatePath(fldPath.Child("callbackPath"), x.GetCallbackPath())...)
+	return allErrs
+}
+
+func (x *OAuth2) validate(fldPath *field.Path) field.ErrorList {
+	switch {
+	case x.GetOidcAuthorizationCode() != nil:
+		return x.GetOidcAuthorizationCode().validate(fldPath.Child("oidcAuthorizationCode"))
+	case x.GetAccessTokenValidation() != nil:
+		return x.GetAccessTokenValidation().validate(fldPath.Child("accessTokenValidation"))
+	case x.GetOauth2() != nil:
+		return x.GetOauth2().validate(fldPath.Child("oauth2"))
+	default:
+		return field.ErrorList{field.Invalid(fldPath, "object", "exactly one of the fields in [oidcAuthorizationCode accessTokenValidation oauth2] must be set")}
+	}
+}
+
+func (x *OidcAuthorizationCode) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, requiredString(fldPath.Child("clientId"), x.GetClientId())...)
+	allErrs = append(allErrs, requiredURL(fldPath.Child("issuerUrl"), x.GetIssuerUrl())...)
+	allErrs = append(allErrs, requiredURL(fldPath.Child("appUrl"), x.GetAppUrl())...)
+	if x.GetCallbackPath() == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("callbackPath"), ""))
+	} else if !x.GetParseCallbackPathAsRegex() {
+		allErrs = append(allErrs, validatePath(fldPath.Child("callbackPath"), x.GetCallbackPath())...)
+	}
+	allErrs = append(allErrs, validatePath(fldPath.Child("logoutPath"), x.GetLogoutPath())...)
+	allErrs = append(allErrs, validateURL(fldPath.Child("afterLogoutUrl"), x.GetAfterLogoutUrl())...)
+	allErrs = append(allErrs, validatePath(fldPath.Child("frontChannelLogout", "path"), x.GetFrontChannelLogout().GetPath())...)
+
+	if auth := x.GetClientAuthentication(); auth != nil {
+		if x.GetClientSecretRef() != nil || x.GetDisableClientSecret() != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, "object", oidcClientSecretMessage))
+		}
+		allErrs = append(allErrs, auth.validate(fldPath.Child("clientAuthentication"))...)
+	} else if x.GetClientSecretRef() == nil && !x.GetDisableClientSecret().GetValue() {
+		allErrs = append(allErrs, field.Invalid(fldPath, "object", oidcClientSecretMessage))
+	}
+
+	if o := x.GetDiscoveryOverride(); o != nil {
+		p := fldPath.Child("discoveryOverride")
+		allErrs = append(allErrs, validateURL(p.Child("authEndpoint"), o.GetAuthEndpoint())...)
+		allErrs = append(allErrs, validateURL(p.Child("tokenEndpoint"), o.GetTokenEndpoint())...)
+		allErrs = append(allErrs, validateURL(p.Child("jwksUri"), o.GetJwksUri())...)
+		allErrs = append(allErrs, validateURL(p.Child("revocationEndpoint"), o.GetRevocationEndpoint())...)
+		allErrs = append(allErrs, validateURL(p.Child("endSessionEndpoint"), o.GetEndSessionEndpoint())...)
+	}
+	allErrs = append(allErrs, x.GetSession().validate(fldPath.Child("session"))...)
+	return allErrs
+}
+
+func (x *OidcAuthorizationCode_ClientAuthentication) validate(fldPath *field.Path) field.ErrorList {
+	switch {
+	case x.GetClientSecret() != nil:
+		secret := x.GetClientSecret()
+		if secret.GetClientSecretRef() == nil && !secret.GetDisableClientSecret().GetValue() {
+			return field.ErrorList{field.Invalid(fldPath.Child("clientSecret"), "object", "Either clientSecretRef must be set or disableClientSecret must be true")}
+		}
+	case x.GetPrivateKeyJwt() != nil:
+		if x.GetPrivateKeyJwt().GetSigningKeyRef() == nil {
+			return field.ErrorList{field.Required(fldPath.Child("privateKeyJwt", "signingKeyRef"), "")}
+		}
+	default:
+		return field.ErrorList{field.Invalid(fldPath, "object", "Must specify clientSecret or privateKeyJwt")}
+	}
+	return nil
+}
+
+func (x *PlainOAuth2) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, requiredString(fldPath.Child("clientId"), x.GetClientId())...)
+	if x.GetClientSecretRef() == nil && !x.GetDisableClientSecret().GetValue() {
+		allErrs = append(allErrs, field.Invalid(fldPath, "object", "Either clientSecretRef must be set or disableClientSecret must be true"))
+	}
+	allErrs = append(allErrs, requiredURL(fldPath.Child("appUrl"), x.GetAppUrl())...)
+	if x.GetCallbackPath() == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("callbackPath"), ""))
+	} else {
+		allErrs = append(allErrs, validatePath(fldPath.Child("callbackPath"), x.GetCallbackPath())...)
+	}
+	allErrs = append(allErrs, validatePath(fldPath.Child("logoutPath"), x.GetLogoutPath())...)
+	allErrs = append(allErrs, validateURL(fldPath.Child("afterLogoutUrl"), x.GetAfterLogoutUrl())...)
+	allErrs = append(allErrs, requiredURL(fldPath.Child("authEndpoint"), x.GetAuthEndpoint())...)
+	allErrs = append(allErrs, requiredURL(fldPath.Child("tokenEndpoint"), x.GetTokenEndpoint())...)
+	allErrs = append(allErrs, validateURL(fldPath.Child("revocationEndpoint"), x.GetRevocationEndpoint())...)
+	allErrs = append(allErrs, x.GetSession().validate(fldPath.Child("session"))...)
+	return allErrs
+}
+
+func (x *AccessTokenValidation) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch t := x.GetValidationType().(type) {
+	case *AccessTokenValidation_IntrospectionUrl:
+		allErrs = append(allErrs, requiredURL(fldPath.Child("introspectionUrl"), t.IntrospectionUrl)...)
+	case *AccessTokenValidation_Jwt:
+		allErrs = append(allErrs, t.Jwt.validate(fldPath.Child("jwt"))...)
+	case *AccessTokenValidation_Introspection:
+		allErrs = append(allErrs, t.Introspection.validate(fldPath.Child("introspection"))...)
+	default:
+		allErrs = append(allErrs, field.Invalid(fldPath, "object", "exactly one of the fields in [introspectionUrl jwt introspection] must be set"))
+	}
+	allErrs = append(allErrs, validateURL(fldPath.Child("userinfoUrl"), x.GetUserinfoUrl())...)
+	return allErrs
+}
+
+func (x *JwtValidation) validate(fldPath *field.Path) field.ErrorList {
+	switch {
+	case x.GetRemoteJwks() != nil:
+		return requiredURL(fldPath.Child("remoteJwks", "url"), x.GetRemoteJwks().GetUrl())
+	case x.GetLocalJwks() != nil:
+		return requiredString(fldPath.Child("localJwks", "inlineString"), x.GetLocalJwks().GetInlineString())
+	default:
+		return field.ErrorList{field.Invalid(fldPath, "object", "exactly one of the fields in [remoteJwks localJwks] must be set")}
+	}
+}
+
+func (x *IntrospectionValidation) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, requiredURL(fldPath.Child("introspectionUrl"), x.GetIntrospectionUrl())...)
+	if x.GetClientId() != "" {
+		if x.GetClientSecretRef() == nil && !x.GetDisableClientSecret().GetValue() {
+			allErrs = append(allErrs, field.Invalid(fldPath, "object", introspectionClientSecretMessage))
+		}
+	} else if x.GetClientSecretRef() != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, "object", introspectionClientSecretMessage))
+	}
+	return allErrs
+}
+
+func (x *UserSession) validate(fldPath *field.Path) field.ErrorList {
+	if t, ok := x.GetSession().(*UserSession_Redis); ok {
+		return requiredString(fldPath.Child("redis", "options", "host"), t.Redis.GetOptions().GetHost())
+	}
+	return nil
+}
+
+func (x *RedisOptions) validate(fldPath *field.Path) field.ErrorList {
+	if x == nil {
+		return nil
+	}
+	return requiredString(fldPath.Child("host"), x.GetHost())
+}
+
+func (x *ApiKeyAuth) validate(fldPath *field.Path) field.ErrorList {
+	if storage := x.GetAerospikeApikeyStorage(); storage != nil {
+		return storage.validate(fldPath.Child("aerospikeApikeyStorage"))
+	}
+	return nil
+}
+
+// validate checks the Aerospike connection settings. The certificate and key are loaded
+// as a pair, the TLS settings only apply to nodes with a TLS name, and a root CA is not
+// used to verify the server when allowInsecure is set.
+func (x *AerospikeApiKeyStorage) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, requiredString(fldPath.Child("hostname"), x.GetHostname())...)
+	if port := x.GetPort(); port < 0 || port > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), port, "must be between 0 and 65535, inclusive"))
+	}
+
+	switch {
+	case x.GetCertPath() != "" && x.GetKeyPath() == "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("keyPath"), "must be set when certPath is set"))
+	case x.GetCertPath() == "" && x.GetKeyPath() != "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("certPath"), "must be set when keyPath is set"))
+	}
+	if x.GetAllowInsecure() && x.GetRootCaPath() != "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("allowInsecure"), true, "may not be set alongside rootCaPath"))
+	}
+	if v := x.GetTlsVersion(); v != "" {
+		supported := false
+		for _, s := range aerospikeTLSVersions {
+			supported = supported || v == s
+		}
+		if !supported {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("tlsVersion"), v, aerospikeTLSVersions))
+		}
+	}
+	tls := x.GetCertPath() != "" || x.GetKeyPath() != "" || x.GetRootCaPath() != "" || x.GetAllowInsecure() ||
+		x.GetTlsVersion() != "" || len(x.GetTlsCurveGroups()) > 0
+	if tls && x.GetNodeTlsName() == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("nodeTlsName"), "must be set when TLS settings are set"))
+	}
+	return allErrs
+}
+
+func (x *AuthPlugin) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, requiredString(fldPath.Child("name"), x.GetName())...)
+	if x.GetConfig() == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("config"), ""))
+	}
+	return allErrs
+}
+
+func (x *Ldap) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, requiredString(fldPath.Child("address"), x.GetAddress())...)
+	if t := x.GetUserDnTemplate(); t != "" && strings.Count(t, "%s") != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("userDnTemplate"), t, `must contain a single occurrence of the "%s" placeholder`))
+	}
+	return allErrs
+}
+
+func (x *PassThroughAuth) validate(fldPath *field.Path) field.ErrorList {
+	switch {
+	case x.GetGrpc() != nil:
+		return requiredString(fldPath.Child("grpc", "address"), x.GetGrpc().GetAddress())
+	case x.GetHttp() != nil:
+		return requiredURL(fldPath.Child("http", "url"), x.GetHttp().GetUrl())
+	default:
+		return field.ErrorList{field.Invalid(fldPath, "object", "Must specify grpc or http")}
+	}
+}
+
+func (x *HmacAuth) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	refsPath := fldPath.Child("secretRefs")
+	if x.GetSecretRefs() == nil {
+		allErrs = append(allErrs, field.Required(refsPath, ""))
+	} else if len(x.GetSecretRefs().GetSecretRefs()) == 0 {
+		allErrs = append(allErrs, field.Required(refsPath.Child("secretRefs"), ""))
+	}
+	if x.GetImplementationType() == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, "object", "exactly one of the fields in [parametersInHeaders] must be set"))
+	}
+	return allErrs
+}
+
+func requiredString(fldPath *field.Path, value string) field.ErrorList {
+	if value == "" {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+	return nil
+}
+
+func requiredURL(fldPath *field.Path, value string) field.ErrorList {
+	if value == "" {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+	return validateURL(fldPath, value)
+}
+
+// validateURL checks that value, if set, is an absolute http or https URL.
+func validateURL(fldPath *field.Path, value string) field.ErrorList {
+	if value == "" {
+		return nil
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be an absolute http or https URL")}
+	}
+	return nil
+}
+
+// validatePath checks that value, if set, is an absolute path.
+func validatePath(fldPath *field.Path, value string) field.ErrorList {
+	if value != "" && !strings.HasPrefix(value, "/") {
+		return field.ErrorList{field.Invalid(fldPath, value, `must start with "/"`)}
+	}
+	return nil
+}
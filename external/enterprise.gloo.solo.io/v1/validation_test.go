@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// oidc returns an AuthConfig spec with an oidcAuthorizationCode config, with the JSON
+// fields of extra added to it.
+func oidc(extra string) string {
+	return `{"configs": [{"oauth2": {"oidcAuthorizationCode": {
+		"clientId": "client",
+		"clientSecretRef": {"name": "oauth", "namespace": "gloo-system"},
+		"issuerUrl": "https://idp.example.com/",
+		"appUrl": "https://app.example.com",
+		"callbackPath": "/callback"` + extra + `
+	}}}]}`
+}
+
+// accessToken returns an AuthConfig spec with an accessTokenValidation config of the JSON
+// fields fields.
+func accessToken(fields string) string {
+	return `{"configs": [{"oauth2": {"accessTokenValidation": {` + fields + `}}}]}`
+}
+
+func TestValidateAuthConfigSpecJSON(t *testing.T) {
+	const oidcPath = "spec.configs[0].oauth2.oidcAuthorizationCode"
+	const accessTokenPath = "spec.configs[0].oauth2.accessTokenValidation"
+	tests := []struct {
+		name    string
+		json    string
+		want    []string
+		wantErr string
+	}{
+		{name: "valid", json: oidc("")},
+		{name: "unknown fields ignored", json: oidc(`, "clientSecretRefs": {}`)},
+		{name: "no configs", json: `{}`, want: []string{"spec.configs: Required value"}},
+		{
+			name: "no config type",
+			json: `{"configs": [{"name": "empty"}]}`,
+			want: []string{`spec.configs[0]: Invalid value: "object": exactly one of the fields in [basicAuth oauth oauth2 apiKeyAuth pluginAuth opaAuth ldap jwt passThroughAuth hmacAuth opaServerAuth portalAuth] must be set`},
+		},
+		{
+			name: "conflicting config types",
+			json: `{"configs": [{"jwt": {}, "opaAuth": {"query": "data.allow"}}]}`,
+			want: []string{`spec.configs[0]: Invalid value: "object": at most one of the fields in [jwt opaAuth] may be set`},
+		},
+		{
+			name: "invalid issuer URL",
+			json: strings.Replace(oidc(""), "https://idp.example.com/", "not a url", 1),
+			want: []string{oidcPath + `.issuerUrl: Invalid value: "not a url": must be an absolute http or https URL`},
+		},
+		{
+			name: "no client secret",
+			json: strings.Replace(oidc(""), `"clientSecretRef"`, `"clientSecretRefs"`, 1),
+			want: []string{oidcPath + `: Invalid value: "object": ` + oidcClientSecretMessage},
+		},
+		{name: "cookie session", json: oidc(`, "session": {"cookie": {"keyPrefix": "oidc"}}`)},
+		{name: "redis session", json: oidc(`, "session": {"redis": {"options": {"host": "redis:6379"}}}`)},
+		{
+			name: "redis session without host",
+			json: oidc(`, "session": {"redis": {"options": {}}}`),
+			want: []string{oidcPath + ".session.redis.options.host: Required value"},
+		},
+		{
+			name: "cookie and redis sessions",
+			json: oidc(`, "session": {"cookie": {}, "redis": {"options": {}}}`),
+			want: []string{oidcPath + `.session: Invalid value: "object": at most one of the fields in [cookie redis] may be set`},
+		},
+		{name: "null session ignored", json: oidc(`, "session": {"cookie": {}, "redis": null}`)},
+		{name: "introspection URL", json: accessToken(`"introspectionUrl": "https://idp.example.com/introspect"`)},
+		{
+			name: "empty introspection URL",
+			json: accessToken(`"introspectionUrl": ""`),
+			want: []string{accessTokenPath + ".introspectionUrl: Required value"},
+		},
+		{
+			name: "invalid introspection URL",
+			json: accessToken(`"introspectionUrl": "/introspect"`),
+			want: []string{accessTokenPath + `.introspectionUrl: Invalid value: "/introspect": must be an absolute http or https URL`},
+		},
+		{
+			name: "no validation type",
+			json: accessToken(`"userinfoUrl": "https://idp.example.com/userinfo"`),
+			want: []string{accessTokenPath + `: Invalid value: "object": exactly one of the fields in [introspectionUrl jwt introspection] must be set`},
+		},
+		{
+			name: "introspection URL and JWT",
+			json: accessToken(`"introspectionUrl": "https://idp.example.com/introspect", "jwt": {"remoteJwks": {"url": "https://idp.example.com/jwks"}}`),
+			want: []string{accessTokenPath + `: Invalid value: "object": at most one of the fields in [introspectionUrl jwt] may be set`},
+		},
+		{
+			name: "conflicts in several configs",
+			json: `{"configs": [{"jwt": {}, "ldap": {}}, {"oauth2": {"accessTokenValidation": {"jwt": {}, "introspection": {}}}}]}`,
+			want: []string{
+				`spec.configs[0]: Invalid value: "object": at most one of the fields in [jwt ldap] may be set`,
+				`spec.configs[1].oauth2.accessTokenValidation: Invalid value: "object": at most one of the fields in [introspection jwt] may be set`,
+			},
+		},
+		{name: "value of the wrong type", json: `{"configs": [{"name": 1}]}`, wantErr: "invalid value for string field"},
+		{name: "invalid JSON", json: `{"configs": `, wantErr: "unexpected EOF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := ValidateAuthConfigSpecJSON([]byte(tt.json))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ValidateAuthConfigSpecJSON() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateAuthConfigSpecJSON() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccessTokenValidationOneofs(t *testing.T) {
+	tests := []struct {
+		name string
+		v    *AccessTokenValidation
+		want []string
+	}{
+		{
+			name: "empty introspection URL",
+			v:    &AccessTokenValidation{ValidationType: &AccessTokenValidation_IntrospectionUrl{}},
+			want: []string{"introspectionUrl: Required value"},
+		},
+		{
+			name: "unset JWT",
+			v:    &AccessTokenValidation{ValidationType: &AccessTokenValidation_Jwt{}},
+			want: []string{`jwt: Invalid value: "object": exactly one of the fields in [remoteJwks localJwks] must be set`},
+		},
+		{
+			name: "unset introspection",
+			v:    &AccessTokenValidation{ValidationType: &AccessTokenValidation_Introspection{}},
+			want: []string{"introspection.introspectionUrl: Required value"},
+		},
+		{
+			name: "local JWKS",
+			v: &AccessTokenValidation{ValidationType: &AccessTokenValidation_Jwt{Jwt: &JwtValidation{
+				JwksSourceSpecifier: &JwtValidation_LocalJwks_{LocalJwks: &JwtValidation_LocalJwks{InlineString: "{}"}},
+			}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, e := range tt.v.validate(nil) {
+				got = append(got, e.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
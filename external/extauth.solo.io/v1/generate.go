@@ -0,0 +1,4 @@
+package v1
+
+//go:generate go run -tags kubernetes_protomessage_one_more_release ../../gen -proto github.com/solo-io/ext-auth-service/api/extauth.solo.io/v1/auth_config.proto
+//go:generate go run -tags kubernetes_protomessage_one_more_release ../../gen -proto github.com/solo-io/ext-auth-service/api/extauth.solo.io/v1/auth_config.proto -json json.gen.go -types AuthConfigStatus
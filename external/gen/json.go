@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// jsonType is a message type for which JSON marshalers are rendered.
+type jsonType struct {
+	Name string
+	// Strict is set for spec types, which also get an UnmarshalJSONStrict method.
+	Strict bool
+	// NamespacedStatuses is the name of the message holding the statuses of the type by
+	// namespace, which UnmarshalJSON accepts too, or empty.
+	NamespacedStatuses string
+}
+
+var jsonTemplate = template.Must(template.New("json").Parse(`// Code generated by external/gen. DO NOT EDIT.
+
+// Generated json marshal and unmarshal functions
+
+package {{ .Package }}
+
+import (
+	protojson "google.golang.org/protobuf/encoding/protojson"
+
+	protojsonutil "github.com/solo-io/kgateway-client/v2/external/protojsonutil"
+)
+
+var marshaller = protojson.MarshalOptions{ {{- if .EnumsAsInts }}UseEnumNumbers: true{{ end -}} }
+{{ range .Types }}
+// MarshalJSON is a custom marshaler for {{ .Name }}
+func (this *{{ .Name }}) MarshalJSON() ([]byte, error) {
+	{{- if $.Guard }}
+	if !protojsonutil.AuthConfigSupported() {
+		return nil, protojsonutil.ErrAuthConfigUnsupported
+	}
+	{{- end }}
+	return marshaller.Marshal(this)
+}
+
+// UnmarshalJSON is a custom unmarshaler for {{ .Name }}{{ if .Strict }}. Unknown fields are ignored.{{ end }}
+func (this *{{ .Name }}) UnmarshalJSON(b []byte) error {
+	{{- if $.Guard }}
+	if !protojsonutil.AuthConfigSupported() {
+		return protojsonutil.ErrAuthConfigUnsupported
+	}
+	{{- end }}
+	{{- if .NamespacedStatuses }}
+	namespacedStatuses := {{ .NamespacedStatuses }}{}
+	if err := protojsonutil.Unmarshal(b, &namespacedStatuses, false); err != nil {
+		return protojsonutil.Unmarshal(b, this, false)
+	}
+
+	for _, status := range namespacedStatuses.GetStatuses() {
+		// take the first status
+		if status != nil {
+			status.DeepCopyInto(this)
+			return nil
+		}
+	}
+	return nil
+	{{- else }}
+	return protojsonutil.Unmarshal(b, this, false)
+	{{- end }}
+}
+{{ if .Strict }}
+// UnmarshalJSONStrict is like UnmarshalJSON, but fails with a
+// *protojsonutil.UnknownFieldsError listing the JSON paths of the unknown fields.
+func (this *{{ .Name }}) UnmarshalJSONStrict(b []byte) error {
+	{{- if $.Guard }}
+	if !protojsonutil.AuthConfigSupported() {
+		return protojsonutil.ErrAuthConfigUnsupported
+	}
+	{{- end }}
+	return protojsonutil.Unmarshal(b, this, true)
+}
+{{ end }}
+{{- end }}`))
+
+// renderJSON renders the JSON marshalers of the messages of fd named by types, which use
+// protojsonutil. The marshalers of a file with SecretReference fields return
+// protojsonutil.ErrAuthConfigUnsupported when its descriptors can not be resolved.
+func renderJSON(fd protoreflect.FileDescriptor, goPackage string, types []string, enumsAsInts bool) ([]byte, error) {
+	data := struct {
+		Package     string
+		EnumsAsInts bool
+		Guard       bool
+		Types       []jsonType
+	}{
+		Package:     goPackage,
+		EnumsAsInts: enumsAsInts,
+		Guard:       len(secretReferenceGetters(fd)) > 0,
+	}
+	for _, name := range types {
+		if fd.Messages().ByName(protoreflect.Name(name)) == nil {
+			return nil, fmt.Errorf("%s has no message %s", fd.Path(), name)
+		}
+		t := jsonType{Name: name, Strict: strings.HasSuffix(name, "Spec")}
+		if base, ok := strings.CutSuffix(name, "Status"); ok {
+			statuses := base + "NamespacedStatuses"
+			if _, err := protoregistry.GlobalTypes.FindMessageByName(fd.Package().Append(protoreflect.Name(statuses))); err == nil {
+				t.NamespacedStatuses = statuses
+			}
+		}
+		data.Types = append(data.Types, t)
+	}
+	var buf bytes.Buffer
+	if err := jsonTemplate.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
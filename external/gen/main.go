@@ -0,0 +1,290 @@
+// Command gen runs protoc-gen-ext on a proto file whose descriptor is registered by the
+// external packages, and writes the generated Equal, Hash, HashUnique and Clone files to
+// the output directory. With -json, it renders the protojson marshalers of the -types
+// messages of the file instead.
+//
+// The proto sources are not part of this repository, so the CodeGeneratorRequest is built
+// from the descriptors compiled into the .pb.go files. The AuthConfig protos refer to
+// secrets with core.solo.io.ResourceRef messages, which the .pb.go files represent with
+// the Kubernetes SecretReference. That type is not a proto message in the default build,
+// so the comparisons and copies protoc-gen-ext generates for it are rewritten to call
+// secretReferenceEqual and DeepCopy instead.
+//
+// It is run through go generate from the package directories, with the
+// kubernetes_protomessage_one_more_release tag, without which the AuthConfig descriptors
+// can not be resolved, and, except with -json, protoc-gen-ext v0.1.0 on the PATH:
+//
+//	go install github.com/solo-io/protoc-gen-ext@v0.1.0
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+	corev1 "k8s.io/api/core/v1"
+
+	_ "github.com/solo-io/kgateway-client/v2/external/enterprise.gloo.solo.io/v1"
+	_ "github.com/solo-io/kgateway-client/v2/external/extauth.solo.io/v1"
+	"github.com/solo-io/kgateway-client/v2/external/protojsonutil"
+	_ "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
+)
+
+// secretReferenceFile declares the message the .pb.go files resolve SecretReference
+// fields to, which has no registered file of its own.
+var secretReferenceFile = &descriptorpb.FileDescriptorProto{
+	Name:    proto.String("k8s.io/api/core/v1/secret_reference.proto"),
+	Package: proto.String("k8s_io.api.core.v1"),
+	Syntax:  proto.String("proto3"),
+	Options: &descriptorpb.FileOptions{GoPackage: proto.String("k8s.io/api/core/v1")},
+	MessageType: []*descriptorpb.DescriptorProto{{
+		Name: proto.String("SecretReference"),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			stringField("name", 1),
+			stringField("namespace", 2),
+		},
+	}},
+}
+
+var (
+	secretReferenceType  = reflect.TypeOf(&corev1.SecretReference{})
+	secretReferencesType = reflect.TypeOf([]*corev1.SecretReference{})
+	// secretReferenceClone matches the copies of SecretReference fields in Clone.
+	secretReferenceClone = regexp.MustCompile(`proto\.Clone\((.+?)\)\.\(\*k8s_io_api_core_v1\.SecretReference\)`)
+	targetGetter         = regexp.MustCompile(`^target\.(Get\w+)\(\)`)
+)
+
+func stringField(name string, number int32) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+	}
+}
+
+func main() {
+	protoPath := flag.String("proto", "", "path of the registered proto file to generate for")
+	plugin := flag.String("plugin", "protoc-gen-ext", "protoc-gen-ext binary")
+	out := flag.String("out", ".", "output directory")
+	jsonFile := flag.String("json", "", "render the JSON marshalers to this file instead")
+	types := flag.String("types", "", "comma-separated messages to render JSON marshalers for")
+	enumsAsInts := flag.Bool("enums-as-ints", false, "marshal enums as numbers")
+	flag.Parse()
+
+	if !protojsonutil.AuthConfigSupported() {
+		log.Fatal("the AuthConfig descriptors can not be resolved: run with -tags kubernetes_protomessage_one_more_release")
+	}
+	fd, err := protoregistry.GlobalFiles.FindFileByPath(*protoPath)
+	if err != nil {
+		log.Fatalf("finding %s: %v", *protoPath, err)
+	}
+	if *jsonFile != "" {
+		src, err := renderJSON(fd, os.Getenv("GOPACKAGE"), strings.Split(*types, ","), *enumsAsInts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(*out, *jsonFile), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	req, err := proto.Marshal(request(fd))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cmd := exec.Command(*plugin)
+	cmd.Stdin = bytes.NewReader(req)
+	cmd.Stderr = os.Stderr
+	data, err := cmd.Output()
+	if err != nil {
+		log.Fatalf("running %s: %v", *plugin, err)
+	}
+	resp := &pluginpb.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(data, resp); err != nil {
+		log.Fatalf("decoding the %s response: %v", *plugin, err)
+	}
+	if resp.Error != nil {
+		log.Fatalf("%s: %s", *plugin, resp.GetError())
+	}
+
+	getters := secretReferenceGetters(fd)
+	for _, f := range resp.File {
+		src, err := rewrite(f.GetContent(), getters)
+		if err != nil {
+			log.Fatalf("rewriting %s: %v", f.GetName(), err)
+		}
+		if err := os.WriteFile(filepath.Join(*out, filepath.Base(f.GetName())), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// request returns the CodeGeneratorRequest for fd and its dependencies. Unresolved
+// imports are dropped, and secretReferenceFile is added as a dependency of fd.
+func request(fd protoreflect.FileDescriptor) *pluginpb.CodeGeneratorRequest {
+	files := []*descriptorpb.FileDescriptorProto{secretReferenceFile}
+	seen := map[string]bool{}
+	var add func(f protoreflect.FileDescriptor)
+	add = func(f protoreflect.FileDescriptor) {
+		if seen[f.Path()] {
+			return
+		}
+		seen[f.Path()] = true
+		var deps []string
+		for i := 0; i < f.Imports().Len(); i++ {
+			imp := f.Imports().Get(i)
+			if imp.IsPlaceholder() {
+				continue
+			}
+			add(imp.FileDescriptor)
+			deps = append(deps, imp.Path())
+		}
+		if f == fd {
+			deps = append(deps, secretReferenceFile.GetName())
+		}
+		p := protodesc.ToFileDescriptorProto(f)
+		p.Dependency, p.PublicDependency, p.WeakDependency = deps, nil, nil
+		files = append(files, p)
+	}
+	add(fd)
+	return &pluginpb.CodeGeneratorRequest{FileToGenerate: []string{fd.Path()}, ProtoFile: files}
+}
+
+// secretReferenceGetters returns the getters of the SecretReference fields of the
+// messages of fd, as "Message.GetField".
+func secretReferenceGetters(fd protoreflect.FileDescriptor) map[string]bool {
+	getters := map[string]bool{}
+	var visit func(msgs protoreflect.MessageDescriptors)
+	visit = func(msgs protoreflect.MessageDescriptors) {
+		for i := 0; i < msgs.Len(); i++ {
+			md := msgs.Get(i)
+			visit(md.Messages())
+			mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+			if err != nil {
+				continue
+			}
+			t := reflect.TypeOf(mt.Zero().Interface())
+			for j := 0; j < t.NumMethod(); j++ {
+				m := t.Method(j)
+				if !strings.HasPrefix(m.Name, "Get") || m.Type.NumIn() != 1 || m.Type.NumOut() != 1 {
+					continue
+				}
+				if out := m.Type.Out(0); out == secretReferenceType || out == secretReferencesType {
+					getters[t.Elem().Name()+"."+m.Name] = true
+				}
+			}
+		}
+	}
+	visit(fd.Messages())
+	return getters
+}
+
+// replacement replaces the source between offsets start and end.
+type replacement struct {
+	start, end int
+	text       string
+}
+
+// rewrite rewrites the Equalizer and Cloner branches protoc-gen-ext generates for the
+// SecretReference fields identified by getters, which do not compile as SecretReference
+// is not a proto message.
+func rewrite(content string, getters map[string]bool) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", content, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	text := func(n ast.Node) string {
+		return content[fset.Position(n.Pos()).Offset:fset.Position(n.End()).Offset]
+	}
+	var repl []replacement
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || fn.Body == nil {
+			continue
+		}
+		recv := text(fn.Recv.List[0].Type)
+		recv = strings.TrimPrefix(recv, "*")
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			stmt, ok := n.(*ast.IfStmt)
+			if !ok || stmt.Init == nil || stmt.Else == nil {
+				return true
+			}
+			init, elseBody := text(stmt.Init), text(stmt.Else)
+			var r string
+			switch {
+			case strings.HasSuffix(init, ".(equality.Equalizer)"):
+				eq := equalCall(stmt.Else)
+				if eq == nil {
+					return true
+				}
+				x, y := text(eq.Args[0]), text(eq.Args[1])
+				g := targetGetter.FindStringSubmatch(y)
+				if g == nil || !getters[recv+"."+g[1]] {
+					return true
+				}
+				r = fmt.Sprintf("if !secretReferenceEqual(%s, %s) {\n\treturn false\n}", x, y)
+			case strings.HasSuffix(init, ".(clone.Cloner)") && secretReferenceClone.MatchString(elseBody):
+				body := stmt.Else.(*ast.BlockStmt)
+				r = strings.TrimSpace(content[fset.Position(body.Lbrace).Offset+1 : fset.Position(body.Rbrace).Offset])
+				r = secretReferenceClone.ReplaceAllString(r, "$1.DeepCopy()")
+			default:
+				return true
+			}
+			repl = append(repl, replacement{fset.Position(stmt.Pos()).Offset, fset.Position(stmt.End()).Offset, r})
+			return false
+		})
+	}
+
+	sort.Slice(repl, func(i, j int) bool { return repl[i].start > repl[j].start })
+	for _, r := range repl {
+		content = content[:r.start] + r.text + content[r.end:]
+	}
+	return format.Source([]byte(content))
+}
+
+// equalCall returns the proto.Equal call of the else branch of an Equalizer check.
+func equalCall(elseStmt ast.Stmt) *ast.CallExpr {
+	block, ok := elseStmt.(*ast.BlockStmt)
+	if !ok || len(block.List) != 1 {
+		return nil
+	}
+	stmt, ok := block.List[0].(*ast.IfStmt)
+	if !ok {
+		return nil
+	}
+	not, ok := stmt.Cond.(*ast.UnaryExpr)
+	if !ok || not.Op != token.NOT {
+		return nil
+	}
+	call, ok := not.X.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return nil
+	}
+	if sel, ok := call.Fun.(*ast.SelectorExpr); !ok || sel.Sel.Name != "Equal" {
+		return nil
+	}
+	return call
+}
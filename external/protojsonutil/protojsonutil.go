@@ -0,0 +1,233 @@
+// Package protojsonutil decodes the JSON encoding of the external proto-based API types,
+// optionally rejecting unknown fields.
+//
+// The JSON marshalers of the external packages ignore unknown fields, as the API server
+// prunes them anyway. Unmarshal with strict set reports them instead, with the JSON path
+// of each, so that misspelled fields in manifests can be found before they are applied.
+//
+// The AuthConfig protos of enterprise.gloo.solo.io/v1 refer to the Kubernetes
+// SecretReference type, which only implements the legacy proto message interface when
+// building with the kubernetes_protomessage_one_more_release tag with k8s.io/api v0.35.
+// Without it, the JSON marshalers of the AuthConfig protos return ErrAuthConfigUnsupported.
+package protojsonutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// AuthConfigSupported reports whether the AuthConfig specs of enterprise.gloo.solo.io/v1 can
+// be marshaled and unmarshaled, which requires the kubernetes_protomessage_one_more_release
+// build tag. Callers that also build without it should check it first, rather than recover
+// from the panic of the protobuf runtime.
+func AuthConfigSupported() bool {
+	_, ok := interface{}(&corev1.SecretReference{}).(interface{ ProtoMessage() })
+	return ok
+}
+
+// ErrAuthConfigUnsupported is returned by the JSON marshalers of the AuthConfig protos of
+// enterprise.gloo.solo.io/v1 when AuthConfigSupported is false.
+var ErrAuthConfigUnsupported = errors.New("AuthConfig specs can only be marshaled and unmarshaled when built with the kubernetes_protomessage_one_more_release tag")
+
+// UnknownFieldsError is returned by Unmarshal in strict mode if the JSON object has fields
+// that are not fields of the message.
+type UnknownFieldsError struct {
+	// Fields are the paths of the unknown fields, such as
+	// "configs[0].oauth2.oidcAuthorizationCode.clientSecretRefs".
+	Fields []*field.Path
+}
+
+func (e *UnknownFieldsError) Error() string {
+	paths := make([]string, len(e.Fields))
+	for i, p := range e.Fields {
+		paths[i] = fmt.Sprintf("%q", p.String())
+	}
+	if len(paths) == 1 {
+		return "unknown field " + paths[0]
+	}
+	return "unknown fields " + strings.Join(paths, ", ")
+}
+
+// Unmarshal decodes the JSON encoding of m from b. Unknown fields are ignored unless
+// strict is set, in which case all of them are reported by an *UnknownFieldsError and m
+// is not modified.
+func Unmarshal(b []byte, m proto.Message, strict bool) error {
+	if !strict {
+		return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(b, m)
+	}
+	if unknown, err := UnknownFields(b, m.ProtoReflect().Descriptor(), nil); err != nil {
+		return err
+	} else if len(unknown) > 0 {
+		return &UnknownFieldsError{Fields: unknown}
+	}
+	return protojson.Unmarshal(b, m)
+}
+
+// UnknownFields returns the paths of the fields of the JSON object b that are not fields of
+// messages of type md, relative to fldPath, which may be nil. For example, the unknown
+// fields of an AuthConfig spec may be reported relative to field.NewPath("spec").
+//
+// Fields may be named by their JSON or proto name, as protojson accepts both. Values of
+// the wrong type are not reported; they are left to protojson.
+func UnknownFields(b []byte, md protoreflect.MessageDescriptor, fldPath *field.Path) ([]*field.Path, error) {
+	v, err := decode(b)
+	if err != nil {
+		return nil, err
+	}
+	var unknown []*field.Path
+	visitor{
+		field: func(p *field.Path, fd protoreflect.FieldDescriptor) {
+			if fd == nil {
+				unknown = append(unknown, p)
+			}
+		},
+	}.visit(v, md, fldPath)
+	return unknown, nil
+}
+
+// OneofConflicts returns an error for each JSON object of b, a message of type md, that
+// sets more than one field of a oneof, such as both the cookie and redis fields of an
+// AuthConfig UserSession. Paths are relative to fldPath, which may be nil.
+//
+// protojson rejects such objects too, but with an error that does not tell where they are.
+// Fields set to null do not count, and unknown fields are ignored.
+func OneofConflicts(b []byte, md protoreflect.MessageDescriptor, fldPath *field.Path) (field.ErrorList, error) {
+	v, err := decode(b)
+	if err != nil {
+		return nil, err
+	}
+	var errs field.ErrorList
+	visitor{
+		object: func(p *field.Path, md protoreflect.MessageDescriptor, obj map[string]interface{}) {
+			set := map[protoreflect.Name][]string{}
+			for _, k := range slices.Sorted(maps.Keys(obj)) {
+				fd := fieldByName(md, k)
+				if fd == nil || obj[k] == nil {
+					continue
+				}
+				if od := fd.ContainingOneof(); od != nil && !od.IsSynthetic() {
+					set[od.Name()] = append(set[od.Name()], k)
+				}
+			}
+			oneofs := md.Oneofs()
+			for i := 0; i < oneofs.Len(); i++ {
+				if names := set[oneofs.Get(i).Name()]; len(names) > 1 {
+					if p == nil {
+						p = field.NewPath("")
+					}
+					errs = append(errs, field.Invalid(p, "object", fmt.Sprintf("at most one of the fields in [%s] may be set", strings.Join(names, " "))))
+				}
+			}
+		},
+	}.visit(v, md, fldPath)
+	return errs, nil
+}
+
+func decode(b []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// opaque are the well-known types whose JSON encoding is not an object of their fields.
+var opaque = map[protoreflect.FullName]bool{
+	"google.protobuf.Any":         true,
+	"google.protobuf.Struct":      true,
+	"google.protobuf.Value":       true,
+	"google.protobuf.ListValue":   true,
+	"google.protobuf.Duration":    true,
+	"google.protobuf.Timestamp":   true,
+	"google.protobuf.FieldMask":   true,
+	"google.protobuf.DoubleValue": true,
+	"google.protobuf.FloatValue":  true,
+	"google.protobuf.Int64Value":  true,
+	"google.protobuf.UInt64Value": true,
+	"google.protobuf.Int32Value":  true,
+	"google.protobuf.UInt32Value": true,
+	"google.protobuf.BoolValue":   true,
+	"google.protobuf.StringValue": true,
+	"google.protobuf.BytesValue":  true,
+}
+
+// visitor walks a decoded JSON value alongside the descriptor of its message type. Either
+// function may be nil.
+type visitor struct {
+	// field is called for each field of an object, in name order, before its value is
+	// visited. fd is nil if the field is unknown.
+	field func(p *field.Path, fd protoreflect.FieldDescriptor)
+	// object is called for each object of a message type md, after its fields. p is nil for
+	// the root object if the walk started at a nil path.
+	object func(p *field.Path, md protoreflect.MessageDescriptor, obj map[string]interface{})
+}
+
+func (w visitor) visit(v interface{}, md protoreflect.MessageDescriptor, fldPath *field.Path) {
+	if opaque[md.FullName()] {
+		return
+	}
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, k := range slices.Sorted(maps.Keys(obj)) {
+		p := child(fldPath, k)
+		fd := fieldByName(md, k)
+		value := obj[k]
+		if w.field != nil {
+			w.field(p, fd)
+		}
+		switch {
+		case fd == nil:
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				continue
+			}
+			entries, _ := value.(map[string]interface{})
+			for _, key := range slices.Sorted(maps.Keys(entries)) {
+				w.visit(entries[key], fd.MapValue().Message(), p.Key(key))
+			}
+		case fd.IsList():
+			if fd.Message() == nil {
+				continue
+			}
+			items, _ := value.([]interface{})
+			for i, item := range items {
+				w.visit(item, fd.Message(), p.Index(i))
+			}
+		case fd.Message() != nil:
+			w.visit(value, fd.Message(), p)
+		}
+	}
+	if w.object != nil {
+		w.object(fldPath, md, obj)
+	}
+}
+
+// fieldByName returns the field of md named k by its JSON or proto name, or nil.
+func fieldByName(md protoreflect.MessageDescriptor, k string) protoreflect.FieldDescriptor {
+	if fd := md.Fields().ByJSONName(k); fd != nil {
+		return fd
+	}
+	return md.Fields().ByTextName(k)
+}
+
+func child(fldPath *field.Path, name string) *field.Path {
+	if fldPath == nil {
+		return field.NewPath(name)
+	}
+	return fldPath.Child(name)
+}
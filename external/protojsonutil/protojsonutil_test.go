@@ -0,0 +1,162 @@
+package protojsonutil_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/solo-io/kgateway-client/v2/external/protojsonutil"
+	ratelimitv1alpha1 "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
+)
+
+const validSpec = `{
+	"raw": {
+		"descriptors": [{"key": "generic_key", "value": "per-ip", "rateLimit": {"unit": "MINUTE", "requestsPerUnit": 10}}],
+		"rate_limits": [{"actions": [{"genericKey": {"descriptorValue": "per-ip"}}]}]
+	}
+}`
+
+func paths(ps []*field.Path) []string {
+	var out []string
+	for _, p := range ps {
+		out = append(out, p.String())
+	}
+	return out
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		strict  bool
+		unknown []string
+		wantErr string
+	}{
+		{name: "valid", json: validSpec},
+		{name: "valid strict", json: validSpec, strict: true},
+		{name: "unknown fields ignored", json: `{"raw": {"descriptors": [{"key": "k", "vaule": "v"}]}, "extra": 1}`},
+		{
+			name:    "unknown fields reported",
+			json:    `{"raw": {"descriptors": [{"key": "k", "vaule": "v", "descriptors": [{"kye": "x"}]}]}, "extra": 1}`,
+			strict:  true,
+			unknown: []string{"extra", "raw.descriptors[0].descriptors[0].kye", "raw.descriptors[0].vaule"},
+		},
+		{name: "invalid value", json: `{"raw": {"descriptors": [{"key": 1}]}}`, strict: true, wantErr: "invalid value for string field key"},
+		{name: "invalid JSON", json: `{"raw": `, strict: true, wantErr: "unexpected EOF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &ratelimitv1alpha1.RateLimitConfigSpec{}
+			err := protojsonutil.Unmarshal([]byte(tt.json), spec, tt.strict)
+			var unknownErr *protojsonutil.UnknownFieldsError
+			switch {
+			case tt.unknown != nil:
+				if !errors.As(err, &unknownErr) {
+					t.Fatalf("Unmarshal() error = %v, want an UnknownFieldsError", err)
+				}
+				if got := paths(unknownErr.Fields); !reflect.DeepEqual(got, tt.unknown) {
+					t.Errorf("unknown fields = %q, want %q", got, tt.unknown)
+				}
+				if spec.GetRaw() != nil {
+					t.Error("Unmarshal() modified the message")
+				}
+			case tt.wantErr != "":
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Unmarshal() error = %v, want error containing %q", err, tt.wantErr)
+				}
+			case err != nil:
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONStrict(t *testing.T) {
+	spec := &ratelimitv1alpha1.RateLimitConfigSpec{}
+	if err := spec.UnmarshalJSONStrict([]byte(validSpec)); err != nil {
+		t.Fatal(err)
+	}
+	if got := spec.GetRaw().GetDescriptors()[0].GetRateLimit().GetRequestsPerUnit(); got != 10 {
+		t.Errorf("requestsPerUnit = %d, want 10", got)
+	}
+	err := spec.UnmarshalJSONStrict([]byte(`{"raw": {"setDescriptor": []}}`))
+	if want := `unknown field "raw.setDescriptor"`; err == nil || err.Error() != want {
+		t.Errorf("UnmarshalJSONStrict() error = %v, want %s", err, want)
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	md := (&ratelimitv1alpha1.RateLimitConfigSpec{}).ProtoReflect().Descriptor()
+	tests := []struct {
+		name    string
+		json    string
+		fldPath *field.Path
+		want    []string
+	}{
+		{name: "none", json: validSpec},
+		{name: "proto names", json: `{"raw": {"set_descriptors": [{"always_apply": true}]}}`},
+		{name: "root path", json: `{"raw": {"rateLimit": []}}`, want: []string{"raw.rateLimit"}},
+		{name: "relative path", json: `{"raw": {"rateLimit": []}}`, fldPath: field.NewPath("spec"), want: []string{"spec.raw.rateLimit"}},
+		{
+			name: "nested in lists",
+			json: `{"raw": {"rateLimits": [{"actions": [{"genericKey": {"descriptorValue": "v", "value": "v"}}]}]}}`,
+			want: []string{"raw.rateLimits[0].actions[0].genericKey.value"},
+		},
+		{name: "fields of unknown fields not reported", json: `{"raws": {"descriptor": []}}`, want: []string{"raws"}},
+		{name: "not an object", json: `[]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := protojsonutil.UnknownFields([]byte(tt.json), md, tt.fldPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(paths(got), tt.want) {
+				t.Errorf("UnknownFields() = %q, want %q", paths(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestOneofConflicts(t *testing.T) {
+	md := (&ratelimitv1alpha1.RateLimitConfigSpec{}).ProtoReflect().Descriptor()
+	tests := []struct {
+		name    string
+		json    string
+		fldPath *field.Path
+		want    []string
+	}{
+		{name: "none", json: validSpec},
+		{
+			name: "conflict",
+			json: `{"raw": {"rateLimits": [{"actions": [{"genericKey": {"descriptorValue": "v"}}, {"genericKey": {}, "remoteAddress": {}}]}]}}`,
+			want: []string{`raw.rateLimits[0].actions[1]: Invalid value: "object": at most one of the fields in [genericKey remoteAddress] may be set`},
+		},
+		{
+			name:    "relative path",
+			json:    `{"raw": {"rateLimits": [{"actions": [{"generic_key": {}, "sourceCluster": {}}]}]}}`,
+			fldPath: field.NewPath("spec"),
+			want:    []string{`spec.raw.rateLimits[0].actions[0]: Invalid value: "object": at most one of the fields in [generic_key sourceCluster] may be set`},
+		},
+		{name: "null fields ignored", json: `{"raw": {"rateLimits": [{"actions": [{"genericKey": {}, "remoteAddress": null}]}]}}`},
+		{name: "unknown fields ignored", json: `{"raw": {"rateLimits": [{"actions": [{"genericKey": {}, "remoteAdress": {}}]}]}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := protojsonutil.OneofConflicts([]byte(tt.json), md, tt.fldPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OneofConflicts() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
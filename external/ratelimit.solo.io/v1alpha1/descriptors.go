@@ -0,0 +1,338 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+
+	"github.com/solo-io/kgateway-client/v2/internal/fullmatch"
+)
+
+// RateLimitRequest is a sample request from which descriptors are generated.
+type RateLimitRequest struct {
+	// Headers of the request. Actions can refer to the :method, :path and :authority
+	// pseudo-headers.
+	Headers http.Header
+
+	// RemoteAddress is the trusted client address used by the remote address action.
+	RemoteAddress string
+
+	// SourceCluster is the local service cluster used by the source cluster action.
+	SourceCluster string
+
+	// DestinationCluster is the upstream cluster used by the destination cluster action.
+	DestinationCluster string
+
+	// DynamicMetadata holds the dynamic metadata, keyed by filter namespace.
+	DynamicMetadata map[string]interface{}
+
+	// RouteMetadata holds the route entry metadata, keyed by filter namespace.
+	RouteMetadata map[string]interface{}
+
+	// Hits is the number of hits the request adds to each counter. Defaults to 1.
+	// For limits of type TOKEN it is the number of tokens consumed.
+	Hits uint32
+}
+
+// NewRateLimitRequest returns the RateLimitRequest for req, with its pseudo-headers and
+// remote address.
+func NewRateLimitRequest(req *http.Request) *RateLimitRequest {
+	headers := req.Header.Clone()
+	if headers == nil {
+		headers = http.Header{}
+	}
+	path := req.RequestURI
+	if path == "" && req.URL != nil {
+		path = req.URL.RequestURI()
+	}
+	headers[":method"] = []string{req.Method}
+	headers[":path"] = []string{path}
+	headers[":authority"] = []string{req.Host}
+
+	remote := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+	return &RateLimitRequest{Headers: headers, RemoteAddress: remote}
+}
+
+// DescriptorEntry is a key/value pair of a descriptor sent to the rate limit server.
+type DescriptorEntry struct {
+	Key   string
+	Value string
+}
+
+// RequestDescriptor is a descriptor generated by a RateLimitActions for a request.
+type RequestDescriptor struct {
+	// Entries are the generated entries, in action order.
+	Entries []DescriptorEntry
+
+	// Set is true for descriptors generated by set actions, which are matched against
+	// the set descriptors of the config regardless of order.
+	Set bool
+
+	// Limit is the limit override resolved from dynamic metadata, if any.
+	Limit *RateLimit
+
+	// Type is the limit type of the actions.
+	Type LimitType
+}
+
+func (d RequestDescriptor) String() string {
+	parts := make([]string, len(d.Entries))
+	for i, e := range d.Entries {
+		parts[i] = fmt.Sprintf("(%s, %s)", e.Key, e.Value)
+	}
+	if d.Set {
+		return "{" + strings.Join(parts, ", ") + "}"
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// GenerateDescriptors applies the rate limit actions of the config to req, as Envoy does.
+// Each RateLimitActions produces a descriptor from its actions and one from its set actions.
+// As in Envoy, no descriptor is produced if one of its actions cannot produce an entry,
+// for example because a header is missing or a header value match does not match.
+func (x *RateLimitConfigSpec_Raw) GenerateDescriptors(req *RateLimitRequest) ([]RequestDescriptor, error) {
+	var descriptors []RequestDescriptor
+	for i, rl := range x.GetRateLimits() {
+		for _, set := range []bool{false, true} {
+			actions := rl.GetActions()
+			if set {
+				actions = rl.GetSetActions()
+			}
+			if len(actions) == 0 {
+				continue
+			}
+			d, ok, err := generateDescriptor(actions, req)
+			if err != nil {
+				return nil, fmt.Errorf("rateLimits[%d]: %w", i, err)
+			}
+			if !ok {
+				continue
+			}
+			d.Set = set
+			d.Type = rl.GetType()
+			d.Limit = resolveOverride(rl.GetLimit(), req)
+			descriptors = append(descriptors, d)
+		}
+	}
+	return descriptors, nil
+}
+
+func generateDescriptor(actions []*Action, req *RateLimitRequest) (RequestDescriptor, bool, error) {
+	var d RequestDescriptor
+	for _, action := range actions {
+		entry, ok, err := action.descriptorEntry(req)
+		if err != nil || !ok {
+			return d, false, err
+		}
+		d.Entries = append(d.Entries, entry)
+	}
+	return d, true, nil
+}
+
+// descriptorEntry returns the entry produced by the action, and false if it produces none.
+func (x *Action) descriptorEntry(req *RateLimitRequest) (DescriptorEntry, bool, error) {
+	switch a := x.GetActionSpecifier().(type) {
+	case *Action_SourceCluster_:
+		return DescriptorEntry{Key: "source_cluster", Value: req.SourceCluster}, req.SourceCluster != "", nil
+	case *Action_DestinationCluster_:
+		return DescriptorEntry{Key: "destination_cluster", Value: req.DestinationCluster}, req.DestinationCluster != "", nil
+	case *Action_RequestHeaders_:
+		values := req.Headers.Values(a.RequestHeaders.GetHeaderName())
+		if len(values) == 0 {
+			return DescriptorEntry{}, false, nil
+		}
+		return DescriptorEntry{Key: a.RequestHeaders.GetDescriptorKey(), Value: values[0]}, true, nil
+	case *Action_RemoteAddress_:
+		return DescriptorEntry{Key: "remote_address", Value: req.RemoteAddress}, req.RemoteAddress != "", nil
+	case *Action_GenericKey_:
+		return DescriptorEntry{Key: "generic_key", Value: a.GenericKey.GetDescriptorValue()}, true, nil
+	case *Action_HeaderValueMatch_:
+		hvm := a.HeaderValueMatch
+		matched := true
+		for _, h := range hvm.GetHeaders() {
+			ok, err := h.matches(req.Headers)
+			if err != nil {
+				return DescriptorEntry{}, false, err
+			}
+			if !ok {
+				matched = false
+				break
+			}
+		}
+		expect := hvm.GetExpectMatch() == nil || hvm.GetExpectMatch().GetValue()
+		return DescriptorEntry{Key: "header_match", Value: hvm.GetDescriptorValue()}, matched == expect, nil
+	case *Action_Metadata:
+		md := a.Metadata
+		source := req.DynamicMetadata
+		if md.GetSource() == MetaData_ROUTE_ENTRY {
+			source = req.RouteMetadata
+		}
+		value, ok := lookupMetadata(source, md.GetMetadataKey()).(string)
+		if !ok || value == "" {
+			value = md.GetDefaultValue()
+		}
+		return DescriptorEntry{Key: md.GetDescriptorKey(), Value: value}, value != "", nil
+	case *Action_Cel:
+		value, ok, err := evaluateCEL(a.Cel.GetExpression(), req)
+		return DescriptorEntry{Key: a.Cel.GetKey(), Value: value}, ok, err
+	default:
+		return DescriptorEntry{}, false, fmt.Errorf("action has no action specifier")
+	}
+}
+
+// matches reports whether the request headers satisfy the matcher, with Envoy header
+// matcher semantics. Multiple values of a header are joined with a comma.
+func (x *Action_HeaderValueMatch_HeaderMatcher) matches(headers http.Header) (bool, error) {
+	values := headers.Values(x.GetName())
+	present := len(values) > 0
+	value := strings.Join(values, ",")
+
+	var matched bool
+	switch m := x.GetHeaderMatchSpecifier().(type) {
+	case *Action_HeaderValueMatch_HeaderMatcher_ExactMatch:
+		matched = present && value == m.ExactMatch
+	case *Action_HeaderValueMatch_HeaderMatcher_RegexMatch:
+		re, err := fullmatch.Compile(m.RegexMatch)
+		if err != nil {
+			return false, fmt.Errorf("header %s: invalid regex: %v", x.GetName(), err)
+		}
+		matched = present && re.MatchString(value)
+	case *Action_HeaderValueMatch_HeaderMatcher_RangeMatch:
+		i, err := strconv.ParseInt(value, 10, 64)
+		matched = present && err == nil && i >= m.RangeMatch.GetStart() && i < m.RangeMatch.GetEnd()
+	case *Action_HeaderValueMatch_HeaderMatcher_PresentMatch:
+		matched = present == m.PresentMatch
+	case *Action_HeaderValueMatch_HeaderMatcher_PrefixMatch:
+		matched = present && strings.HasPrefix(value, m.PrefixMatch)
+	case *Action_HeaderValueMatch_HeaderMatcher_SuffixMatch:
+		matched = present && strings.HasSuffix(value, m.SuffixMatch)
+	default:
+		matched = present
+	}
+	return matched != x.GetInvertMatch(), nil
+}
+
+// lookupMetadata returns the value at the metadata key, or nil.
+func lookupMetadata(metadata map[string]interface{}, key *MetaData_MetadataKey) interface{} {
+	var v interface{} = metadata[key.GetKey()]
+	for _, segment := range key.GetPath() {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[segment.GetKey()]
+	}
+	return v
+}
+
+// resolveOverride returns the limit override read from dynamic metadata, or nil if it is
+// absent or invalid.
+func resolveOverride(o *Override, req *RateLimitRequest) *RateLimit {
+	dm := o.GetDynamicMetadata()
+	if dm == nil {
+		return nil
+	}
+	s, ok := lookupMetadata(req.DynamicMetadata, dm.GetMetadataKey()).(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	unitName, _ := s["unit"].(string)
+	unit, ok := RateLimit_Unit_value[strings.ToUpper(unitName)]
+	if !ok || RateLimit_Unit(unit) == RateLimit_UNKNOWN {
+		return nil
+	}
+	var requests float64
+	switch n := s["requests_per_unit"].(type) {
+	case float64:
+		requests = n
+	case int:
+		requests = float64(n)
+	case int64:
+		requests = float64(n)
+	case uint32:
+		requests = float64(n)
+	default:
+		return nil
+	}
+	if requests < 0 || requests != float64(uint32(requests)) {
+		return nil
+	}
+	return &RateLimit{Unit: RateLimit_Unit(unit), RequestsPerUnit: uint32(requests)}
+}
+
+var (
+	celEnvOnce sync.Once
+	celEnv     *cel.Env
+	celEnvErr  error
+	celCache   sync.Map
+)
+
+// evaluateCEL evaluates a CEL action with the request available as `request`, with the
+// method, path, host and headers (lower case names, comma joined values) fields, and the
+// client as `source`, with the address field. A descriptor entry is only produced when
+// the expression evaluates without error to a non-empty string, number or boolean.
+func evaluateCEL(expr string, req *RateLimitRequest) (string, bool, error) {
+	celEnvOnce.Do(func() {
+		celEnv, celEnvErr = cel.NewEnv(cel.Variable("request", cel.DynType), cel.Variable("source", cel.DynType))
+	})
+	if celEnvErr != nil {
+		return "", false, celEnvErr
+	}
+
+	var prg cel.Program
+	if p, ok := celCache.Load(expr); ok {
+		prg = p.(cel.Program)
+	} else {
+		ast, iss := celEnv.Compile(expr)
+		if iss.Err() != nil {
+			return "", false, fmt.Errorf("cel expression %q: %v", expr, iss.Err())
+		}
+		var err error
+		if prg, err = celEnv.Program(ast); err != nil {
+			return "", false, fmt.Errorf("cel expression %q: %v", expr, err)
+		}
+		celCache.Store(expr, prg)
+	}
+
+	headers := map[string]string{}
+	for name, values := range req.Headers {
+		if !strings.HasPrefix(name, ":") {
+			headers[strings.ToLower(name)] = strings.Join(values, ",")
+		}
+	}
+	first := func(name string) string {
+		if v := req.Headers.Values(name); len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+	out, _, err := prg.Eval(map[string]interface{}{
+		"request": map[string]interface{}{
+			"method":  first(":method"),
+			"path":    first(":path"),
+			"host":    first(":authority"),
+			"headers": headers,
+		},
+		"source": map[string]interface{}{
+			"address": req.RemoteAddress,
+		},
+	})
+	if err != nil {
+		return "", false, nil
+	}
+	switch v := out.Value().(type) {
+	case string:
+		return v, v != "", nil
+	case bool, int64, uint64, float64:
+		return fmt.Sprint(v), true, nil
+	}
+	return "", false, nil
+}
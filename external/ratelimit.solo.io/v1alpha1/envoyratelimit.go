@@ -0,0 +1,204 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// EnvoyRateLimitConfig is a config file of the envoyproxy/ratelimit service, which limits
+// the descriptors of a domain.
+type EnvoyRateLimitConfig struct {
+	Domain      string                     `json:"domain"`
+	Descriptors []EnvoyRateLimitDescriptor `json:"descriptors,omitempty"`
+}
+
+// EnvoyRateLimitDescriptor is a descriptor of an envoyproxy/ratelimit config.
+type EnvoyRateLimitDescriptor struct {
+	Key            string                     `json:"key"`
+	Value          string                     `json:"value,omitempty"`
+	RateLimit      *EnvoyRateLimitPolicy      `json:"rate_limit,omitempty"`
+	Descriptors    []EnvoyRateLimitDescriptor `json:"descriptors,omitempty"`
+	ShadowMode     bool                       `json:"shadow_mode,omitempty"`
+	DetailedMetric bool                       `json:"detailed_metric,omitempty"`
+	ValueToMetric  bool                       `json:"value_to_metric,omitempty"`
+	ShareThreshold bool                       `json:"share_threshold,omitempty"`
+	QuotaMode      bool                       `json:"quota_mode,omitempty"`
+}
+
+// EnvoyRateLimitPolicy is the rate limit of an envoyproxy/ratelimit descriptor.
+type EnvoyRateLimitPolicy struct {
+	Name            string                  `json:"name,omitempty"`
+	Replaces        []EnvoyRateLimitReplace `json:"replaces,omitempty"`
+	Unit            string                  `json:"unit,omitempty"`
+	RequestsPerUnit uint32                  `json:"requests_per_unit"`
+	Unlimited       bool                    `json:"unlimited,omitempty"`
+}
+
+// EnvoyRateLimitReplace names a rate limit replaced by the one declaring it.
+type EnvoyRateLimitReplace struct {
+	Name string `json:"name"`
+}
+
+// ParseEnvoyRateLimitConfig parses an envoyproxy/ratelimit config file. Unknown fields are
+// errors, as they are for the service.
+func ParseEnvoyRateLimitConfig(data []byte) (*EnvoyRateLimitConfig, error) {
+	cfg := &EnvoyRateLimitConfig{}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("parse envoyproxy/ratelimit config: %w", err)
+	}
+	return cfg, nil
+}
+
+// YAML returns the config file of c.
+func (c *EnvoyRateLimitConfig) YAML() ([]byte, error) {
+	return yaml.Marshal(c)
+}
+
+// Unconverted is a construct that the target format of a conversion can not represent,
+// and that the conversion dropped or converted into one with a different meaning.
+type Unconverted struct {
+	// Path is the path of the construct in the source, such as "setDescriptors[0]" or
+	// "descriptors[1].rate_limit.name".
+	Path    string
+	Message string
+}
+
+func (u Unconverted) String() string {
+	return u.Path + ": " + u.Message
+}
+
+// ToEnvoyRateLimitConfig converts the descriptors of raw into an envoyproxy/ratelimit
+// config for domain, which is the domain of the descriptors generated by Envoy.
+//
+// The service has no equivalent of set descriptors, weights and alwaysApply, which are
+// reported as unconverted, and the rate limit actions of raw are configured on the Envoy
+// routes rather than in the service, so they are reported as well. Values ending in "*"
+// are reported too, as the service matches them as prefixes.
+func ToEnvoyRateLimitConfig(domain string, raw *RateLimitConfigSpec_Raw) (*EnvoyRateLimitConfig, []Unconverted) {
+	c := &envoyConverter{}
+	cfg := &EnvoyRateLimitConfig{Domain: domain, Descriptors: c.toEnvoyDescriptors(raw.GetDescriptors(), "descriptors")}
+	for i, rl := range raw.GetRateLimits() {
+		msg := "rate limit actions are configured on the Envoy routes, not in the rate limit service"
+		for _, a := range append(rl.GetActions(), rl.GetSetActions()...) {
+			if a.GetCel() != nil {
+				msg += "; CEL actions have no Envoy equivalent"
+				break
+			}
+		}
+		c.report(fmt.Sprintf("rateLimits[%d]", i), msg)
+	}
+	for i := range raw.GetSetDescriptors() {
+		c.report(fmt.Sprintf("setDescriptors[%d]", i), "set descriptors are not supported by envoyproxy/ratelimit")
+	}
+	return cfg, c.unconverted
+}
+
+// FromEnvoyRateLimitConfig converts the descriptors of cfg into a raw RateLimitConfig
+// spec, without rate limit actions. The domain of cfg is not part of the spec.
+//
+// Rate limit names and replacements, shadow mode, detailed metrics, values to metrics,
+// shared thresholds, quota mode and units other than second, minute, hour and day are
+// reported as unconverted. So are wildcard values, which RateLimitConfig matches exactly
+// rather than as prefixes, and unlimited rate limits, which are converted into
+// descriptors without a rate limit.
+func FromEnvoyRateLimitConfig(cfg *EnvoyRateLimitConfig) (*RateLimitConfigSpec_Raw, []Unconverted) {
+	c := &envoyConverter{}
+	raw := &RateLimitConfigSpec_Raw{Descriptors: c.fromEnvoyDescriptors(cfg.Descriptors, "descriptors")}
+	return raw, c.unconverted
+}
+
+type envoyConverter struct {
+	unconverted []Unconverted
+}
+
+func (c *envoyConverter) report(path, msg string) {
+	c.unconverted = append(c.unconverted, Unconverted{Path: path, Message: msg})
+}
+
+func (c *envoyConverter) toEnvoyDescriptors(descriptors []*Descriptor, path string) []EnvoyRateLimitDescriptor {
+	var out []EnvoyRateLimitDescriptor
+	for i, d := range descriptors {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		ed := EnvoyRateLimitDescriptor{
+			Key:         d.GetKey(),
+			Value:       d.GetValue(),
+			Descriptors: c.toEnvoyDescriptors(d.GetDescriptors(), p+".descriptors"),
+		}
+		if strings.HasSuffix(d.GetValue(), "*") {
+			c.report(p+".value", "values ending in * match as prefixes in envoyproxy/ratelimit")
+		}
+		if rl := d.GetRateLimit(); rl != nil {
+			if _, ok := unitDuration(rl.GetUnit()); ok {
+				ed.RateLimit = &EnvoyRateLimitPolicy{
+					Unit:            strings.ToLower(rl.GetUnit().String()),
+					RequestsPerUnit: rl.GetRequestsPerUnit(),
+				}
+			} else {
+				c.report(p+".rateLimit.unit", fmt.Sprintf("unsupported unit %s", rl.GetUnit()))
+			}
+		}
+		if d.GetWeight() != 0 {
+			c.report(p+".weight", "weights are not supported by envoyproxy/ratelimit")
+		}
+		if d.GetAlwaysApply() {
+			c.report(p+".alwaysApply", "alwaysApply is not supported by envoyproxy/ratelimit")
+		}
+		out = append(out, ed)
+	}
+	return out
+}
+
+func (c *envoyConverter) fromEnvoyDescriptors(descriptors []EnvoyRateLimitDescriptor, path string) []*Descriptor {
+	var out []*Descriptor
+	for i, ed := range descriptors {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		d := &Descriptor{
+			Key:         ed.Key,
+			Value:       ed.Value,
+			Descriptors: c.fromEnvoyDescriptors(ed.Descriptors, p+".descriptors"),
+		}
+		if strings.HasSuffix(ed.Value, "*") {
+			c.report(p+".value", "wildcard values are matched exactly by RateLimitConfig")
+		}
+		if rl := ed.RateLimit; rl != nil {
+			d.RateLimit = c.fromEnvoyRateLimit(rl, p+".rate_limit")
+		}
+		for _, f := range []struct {
+			name string
+			set  bool
+		}{
+			{"shadow_mode", ed.ShadowMode},
+			{"detailed_metric", ed.DetailedMetric},
+			{"value_to_metric", ed.ValueToMetric},
+			{"share_threshold", ed.ShareThreshold},
+			{"quota_mode", ed.QuotaMode},
+		} {
+			if f.set {
+				c.report(p+"."+f.name, f.name+" is not supported by RateLimitConfig")
+			}
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+func (c *envoyConverter) fromEnvoyRateLimit(rl *EnvoyRateLimitPolicy, path string) *RateLimit {
+	if rl.Name != "" {
+		c.report(path+".name", "rate limit names are not supported by RateLimitConfig")
+	}
+	if len(rl.Replaces) > 0 {
+		c.report(path+".replaces", "rate limit replacements are not supported by RateLimitConfig")
+	}
+	if rl.Unlimited {
+		c.report(path+".unlimited", "unlimited rate limits are converted into a descriptor without a rate limit")
+		return nil
+	}
+	unit := RateLimit_Unit(RateLimit_Unit_value[strings.ToUpper(rl.Unit)])
+	if _, ok := unitDuration(unit); !ok {
+		c.report(path+".unit", fmt.Sprintf("unsupported unit %q", rl.Unit))
+		return nil
+	}
+	return &RateLimit{Unit: unit, RequestsPerUnit: rl.RequestsPerUnit}
+}
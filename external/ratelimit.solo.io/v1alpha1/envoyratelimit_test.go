@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+func readTestdata(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func readSpec(t *testing.T, name string) *RateLimitConfigSpec {
+	t.Helper()
+	data, err := yaml.YAMLToJSON(readTestdata(t, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := &RateLimitConfigSpec{}
+	if err := spec.UnmarshalJSONStrict(data); err != nil {
+		t.Fatal(err)
+	}
+	return spec
+}
+
+func checkUnconverted(t *testing.T, name string, got []Unconverted) {
+	t.Helper()
+	var lines []string
+	for _, u := range got {
+		lines = append(lines, u.String())
+	}
+	want := strings.Split(strings.TrimSpace(string(readTestdata(t, name))), "\n")
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf("unconverted =\n%s\nwant\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestFromEnvoyRateLimitConfig(t *testing.T) {
+	cfg, err := ParseEnvoyRateLimitConfig(readTestdata(t, "from-envoy.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, unconverted := FromEnvoyRateLimitConfig(cfg)
+	got := &RateLimitConfigSpec{ConfigType: &RateLimitConfigSpec_Raw_{Raw: raw}}
+	if want := readSpec(t, "from-envoy.spec.yaml"); !proto.Equal(got, want) {
+		t.Errorf("FromEnvoyRateLimitConfig() =\n%v\nwant\n%v", got, want)
+	}
+	checkUnconverted(t, "from-envoy.unconverted", unconverted)
+}
+
+func TestToEnvoyRateLimitConfig(t *testing.T) {
+	cfg, unconverted := ToEnvoyRateLimitConfig("apps", readSpec(t, "to-envoy.spec.yaml").GetRaw())
+	got, err := cfg.YAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := readTestdata(t, "to-envoy.yaml"); string(got) != string(want) {
+		t.Errorf("ToEnvoyRateLimitConfig() =\n%s\nwant\n%s", got, want)
+	}
+	checkUnconverted(t, "to-envoy.unconverted", unconverted)
+}
+
+func TestParseEnvoyRateLimitConfigRejectsUnknownFields(t *testing.T) {
+	_, err := ParseEnvoyRateLimitConfig([]byte("domain: apps\ndescriptors:\n- key: user\n  shadow: true\n"))
+	if err == nil || !strings.Contains(err.Error(), `unknown field "shadow"`) {
+		t.Errorf("ParseEnvoyRateLimitConfig() error = %v, want unknown field", err)
+	}
+}
@@ -0,0 +1,3 @@
+package v1alpha1
+
+//go:generate go run -tags kubernetes_protomessage_one_more_release ../../gen -proto github.com/solo-io/rate-limiter/api/v1alpha1/ratelimit.proto -json json.gen.go -types RateLimitConfigSpec,RateLimitConfigStatus
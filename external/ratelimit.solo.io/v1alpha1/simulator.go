@@ -0,0 +1,295 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AppliedLimit is a limit of the config that applies to a request descriptor.
+type AppliedLimit struct {
+	// Descriptor is the request descriptor that matched the limit.
+	Descriptor RequestDescriptor
+
+	// RateLimit is the applied limit, either configured or overridden from dynamic metadata.
+	RateLimit *RateLimit
+
+	// Key identifies the counter of the limit.
+	Key string
+
+	// Weight and AlwaysApply are those of the matched descriptor.
+	Weight      uint32
+	AlwaysApply bool
+
+	// Remaining is the number of requests left in the current unit after this request.
+	Remaining uint32
+
+	// OverLimit is true if the request exceeded the limit.
+	OverLimit bool
+}
+
+// RateLimitResult is the outcome of a request in the simulator.
+type RateLimitResult struct {
+	// Descriptors are the descriptors generated for the request.
+	Descriptors []RequestDescriptor
+
+	// Limits are the limits applied to the request, after weights were resolved.
+	Limits []AppliedLimit
+
+	// OverLimit is true if the request is throttled by any of the limits.
+	OverLimit bool
+}
+
+// RateLimitSimulator evaluates requests against a raw rate limit config the way Envoy and
+// the rate limit server do, keeping the counters of each limit in memory.
+//
+// Descriptors are matched against the descriptor tree by key and value first, then by key
+// alone. Only the limits with the highest weight apply, along with those that are always
+// applied. Set descriptors match when all of their entries are present in a set descriptor
+// of the request; the first matching rule applies, along with those that are always
+// applied.
+//
+// Each counter is a token bucket holding RequestsPerUnit tokens and refilled at the rate of
+// RequestsPerUnit per unit. A throttled request does not consume tokens.
+type RateLimitSimulator struct {
+	// Clock returns the current time. Defaults to time.Now.
+	Clock func() time.Time
+
+	raw *RateLimitConfigSpec_Raw
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// NewRateLimitSimulator returns a simulator for raw. It returns an error if raw is not a
+// valid config, such as when a limit has no unit.
+func NewRateLimitSimulator(raw *RateLimitConfigSpec_Raw) (*RateLimitSimulator, error) {
+	if errs := raw.validate(nil); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	return &RateLimitSimulator{
+		Clock:   time.Now,
+		raw:     raw,
+		buckets: map[string]*tokenBucket{},
+	}, nil
+}
+
+func unitDuration(unit RateLimit_Unit) (time.Duration, bool) {
+	switch unit {
+	case RateLimit_SECOND:
+		return time.Second, true
+	case RateLimit_MINUTE:
+		return time.Minute, true
+	case RateLimit_HOUR:
+		return time.Hour, true
+	case RateLimit_DAY:
+		return 24 * time.Hour, true
+	}
+	return 0, false
+}
+
+// Do generates the descriptors of req, resolves the limits that apply to them and consumes
+// req.Hits from each of their counters.
+func (s *RateLimitSimulator) Do(req *RateLimitRequest) (*RateLimitResult, error) {
+	descriptors, err := s.raw.GenerateDescriptors(req)
+	if err != nil {
+		return nil, err
+	}
+	limits := s.resolve(descriptors)
+	hits := req.Hits
+	if hits == 0 {
+		hits = 1
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.Clock()
+	result := &RateLimitResult{Descriptors: descriptors}
+	for _, l := range limits {
+		b, ok := s.buckets[l.Key]
+		if !ok || b.unit != l.RateLimit.GetUnit() || b.requestsPerUnit != l.RateLimit.GetRequestsPerUnit() {
+			b = newTokenBucket(l.RateLimit, now)
+			s.buckets[l.Key] = b
+		}
+		l.Remaining, l.OverLimit = b.take(hits, now)
+		result.OverLimit = result.OverLimit || l.OverLimit
+		result.Limits = append(result.Limits, l)
+	}
+	return result, nil
+}
+
+// FirstThrottled sends req up to calls times and returns the 1-based index of the first
+// throttled call, or 0 if none of them is throttled.
+func (s *RateLimitSimulator) FirstThrottled(req *RateLimitRequest, calls int) (int, error) {
+	for i := 1; i <= calls; i++ {
+		result, err := s.Do(req)
+		if err != nil {
+			return 0, err
+		}
+		if result.OverLimit {
+			return i, nil
+		}
+	}
+	return 0, nil
+}
+
+// Reset clears all counters.
+func (s *RateLimitSimulator) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.buckets = map[string]*tokenBucket{}
+}
+
+// resolve returns the limits that apply to the descriptors.
+func (s *RateLimitSimulator) resolve(descriptors []RequestDescriptor) []AppliedLimit {
+	var treeLimits, setLimits []AppliedLimit
+	for _, d := range descriptors {
+		if d.Set {
+			setLimits = append(setLimits, s.matchSet(d)...)
+			continue
+		}
+		if l, ok := s.matchTree(d); ok {
+			treeLimits = append(treeLimits, l)
+		}
+	}
+
+	var maxWeight uint32
+	for _, l := range treeLimits {
+		maxWeight = max(maxWeight, l.Weight)
+	}
+	var limits []AppliedLimit
+	for _, l := range treeLimits {
+		if l.Weight == maxWeight || l.AlwaysApply {
+			limits = append(limits, l)
+		}
+	}
+	return append(limits, setLimits...)
+}
+
+// matchTree walks the descriptor tree along the entries of d. Only the node matched by the
+// last entry provides the limit; a matching node without a limit is unlimited.
+func (s *RateLimitSimulator) matchTree(d RequestDescriptor) (AppliedLimit, bool) {
+	nodes := s.raw.GetDescriptors()
+	var node *Descriptor
+	for _, e := range d.Entries {
+		if node = findDescriptor(nodes, e); node == nil {
+			return AppliedLimit{}, false
+		}
+		nodes = node.GetDescriptors()
+	}
+	if node == nil || node.GetRateLimit() == nil {
+		return AppliedLimit{}, false
+	}
+	limit := node.GetRateLimit()
+	if d.Limit != nil {
+		limit = d.Limit
+	}
+	// As in the rate limit server, entries matched by key alone get a counter per value.
+	keys := make([]string, len(d.Entries))
+	for i, e := range d.Entries {
+		keys[i] = e.Key + "_" + e.Value
+	}
+	return AppliedLimit{
+		Descriptor:  d,
+		RateLimit:   limit,
+		Key:         strings.Join(keys, "|"),
+		Weight:      node.GetWeight(),
+		AlwaysApply: node.GetAlwaysApply(),
+	}, true
+}
+
+func findDescriptor(nodes []*Descriptor, e DescriptorEntry) *Descriptor {
+	for _, n := range nodes {
+		if n.GetKey() == e.Key && n.GetValue() == e.Value {
+			return n
+		}
+	}
+	for _, n := range nodes {
+		if n.GetKey() == e.Key && n.GetValue() == "" {
+			return n
+		}
+	}
+	return nil
+}
+
+// matchSet returns the limits of the set descriptors matched by d.
+func (s *RateLimitSimulator) matchSet(d RequestDescriptor) []AppliedLimit {
+	var limits []AppliedLimit
+	matched := false
+	for i, sd := range s.raw.GetSetDescriptors() {
+		if sd.GetRateLimit() == nil || (matched && !sd.GetAlwaysApply()) {
+			continue
+		}
+		values, ok := matchSimpleDescriptors(sd.GetSimpleDescriptors(), d.Entries)
+		if !ok {
+			continue
+		}
+		// The first match applies even if it is always applied, and ends the search for
+		// the rules that are not.
+		matched = true
+		limit := sd.GetRateLimit()
+		if d.Limit != nil {
+			limit = d.Limit
+		}
+		limits = append(limits, AppliedLimit{
+			Descriptor:  d,
+			RateLimit:   limit,
+			Key:         fmt.Sprintf("set[%d]|%s", i, strings.Join(values, "|")),
+			AlwaysApply: sd.GetAlwaysApply(),
+		})
+	}
+	return limits
+}
+
+// matchSimpleDescriptors reports whether every simple descriptor has a matching entry and
+// returns the matched key/value pairs.
+func matchSimpleDescriptors(simple []*SimpleDescriptor, entries []DescriptorEntry) ([]string, bool) {
+	values := make([]string, 0, len(simple))
+	for _, sd := range simple {
+		found := false
+		for _, e := range entries {
+			if e.Key == sd.GetKey() && (sd.GetValue() == "" || sd.GetValue() == e.Value) {
+				values = append(values, e.Key+"_"+e.Value)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	return values, true
+}
+
+type tokenBucket struct {
+	unit            RateLimit_Unit
+	requestsPerUnit uint32
+	tokens          float64
+	last            time.Time
+}
+
+func newTokenBucket(rl *RateLimit, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		unit:            rl.GetUnit(),
+		requestsPerUnit: rl.GetRequestsPerUnit(),
+		tokens:          float64(rl.GetRequestsPerUnit()),
+		last:            now,
+	}
+}
+
+// take refills the bucket up to now and consumes hits tokens if available. It returns the
+// remaining whole tokens and whether the request is over the limit.
+func (b *tokenBucket) take(hits uint32, now time.Time) (uint32, bool) {
+	capacity := float64(b.requestsPerUnit)
+	if unit, ok := unitDuration(b.unit); ok && now.After(b.last) {
+		b.tokens = math.Min(capacity, b.tokens+capacity*float64(now.Sub(b.last))/float64(unit))
+		b.last = now
+	}
+	if b.tokens < float64(hits) {
+		return uint32(b.tokens), true
+	}
+	b.tokens -= float64(hits)
+	return uint32(b.tokens), false
+}
@@ -0,0 +1,208 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func header(name, key string) *Action {
+	return &Action{ActionSpecifier: &Action_RequestHeaders_{RequestHeaders: &Action_RequestHeaders{HeaderName: name, DescriptorKey: key}}}
+}
+
+func genericKey(value string) *Action {
+	return &Action{ActionSpecifier: &Action_GenericKey_{GenericKey: &Action_GenericKey{DescriptorValue: value}}}
+}
+
+func perMinute(n uint32) *RateLimit {
+	return &RateLimit{Unit: RateLimit_MINUTE, RequestsPerUnit: n}
+}
+
+func simple(key, value string) *SimpleDescriptor {
+	return &SimpleDescriptor{Key: key, Value: value}
+}
+
+func TestRateLimitSimulatorLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     *RateLimitConfigSpec_Raw
+		headers http.Header
+		// keys are the keys of the applied limits, in order.
+		keys []string
+	}{
+		{
+			name: "value match before key match",
+			raw: &RateLimitConfigSpec_Raw{
+				Descriptors: []*Descriptor{
+					{Key: "user", RateLimit: perMinute(10)},
+					{Key: "user", Value: "alice", RateLimit: perMinute(100)},
+				},
+				RateLimits: []*RateLimitActions{{Actions: []*Action{header("x-user", "user")}}},
+			},
+			headers: http.Header{"X-User": {"alice"}},
+			keys:    []string{"user_alice"},
+		},
+		{
+			name: "missing header produces no descriptor",
+			raw: &RateLimitConfigSpec_Raw{
+				Descriptors: []*Descriptor{{Key: "user", RateLimit: perMinute(10)}},
+				RateLimits:  []*RateLimitActions{{Actions: []*Action{header("x-user", "user")}}},
+			},
+			keys: nil,
+		},
+		{
+			name: "nested descriptor",
+			raw: &RateLimitConfigSpec_Raw{
+				Descriptors: []*Descriptor{{
+					Key: "generic_key", Value: "api",
+					Descriptors: []*Descriptor{{Key: "user", RateLimit: perMinute(5)}},
+				}},
+				RateLimits: []*RateLimitActions{{Actions: []*Action{genericKey("api"), header("x-user", "user")}}},
+			},
+			headers: http.Header{"X-User": {"bob"}},
+			keys:    []string{"generic_key_api|user_bob"},
+		},
+		{
+			name: "node without a limit is unlimited",
+			raw: &RateLimitConfigSpec_Raw{
+				Descriptors: []*Descriptor{{
+					Key: "generic_key", Value: "api", RateLimit: perMinute(1),
+					Descriptors: []*Descriptor{{Key: "user"}},
+				}},
+				RateLimits: []*RateLimitActions{{Actions: []*Action{genericKey("api"), header("x-user", "user")}}},
+			},
+			headers: http.Header{"X-User": {"bob"}},
+			keys:    nil,
+		},
+		{
+			name: "highest weight wins, always applied kept",
+			raw: &RateLimitConfigSpec_Raw{
+				Descriptors: []*Descriptor{
+					{Key: "generic_key", Value: "low", RateLimit: perMinute(1)},
+					{Key: "generic_key", Value: "high", RateLimit: perMinute(1), Weight: 2},
+					{Key: "generic_key", Value: "always", RateLimit: perMinute(1), AlwaysApply: true},
+				},
+				RateLimits: []*RateLimitActions{
+					{Actions: []*Action{genericKey("low")}},
+					{Actions: []*Action{genericKey("high")}},
+					{Actions: []*Action{genericKey("always")}},
+				},
+			},
+			keys: []string{"generic_key_high", "generic_key_always"},
+		},
+		{
+			name: "first matching set descriptor",
+			raw: &RateLimitConfigSpec_Raw{
+				SetDescriptors: []*SetDescriptor{
+					{SimpleDescriptors: []*SimpleDescriptor{simple("user", "carol")}, RateLimit: perMinute(1)},
+					{SimpleDescriptors: []*SimpleDescriptor{simple("user", "")}, RateLimit: perMinute(1)},
+					{SimpleDescriptors: []*SimpleDescriptor{simple("user", "")}, RateLimit: perMinute(1), AlwaysApply: true},
+				},
+				RateLimits: []*RateLimitActions{{SetActions: []*Action{header("x-user", "user")}}},
+			},
+			headers: http.Header{"X-User": {"dave"}},
+			keys:    []string{"set[1]|user_dave", "set[2]|user_dave"},
+		},
+		{
+			name: "first matching set descriptor is always applied",
+			raw: &RateLimitConfigSpec_Raw{
+				SetDescriptors: []*SetDescriptor{
+					{SimpleDescriptors: []*SimpleDescriptor{simple("user", "")}, RateLimit: perMinute(1), AlwaysApply: true},
+					{SimpleDescriptors: []*SimpleDescriptor{simple("user", "")}, RateLimit: perMinute(1)},
+					{SimpleDescriptors: []*SimpleDescriptor{simple("user", "")}, RateLimit: perMinute(1), AlwaysApply: true},
+				},
+				RateLimits: []*RateLimitActions{{SetActions: []*Action{header("x-user", "user")}}},
+			},
+			headers: http.Header{"X-User": {"dave"}},
+			keys:    []string{"set[0]|user_dave", "set[2]|user_dave"},
+		},
+		{
+			name: "set descriptor entries in any order",
+			raw: &RateLimitConfigSpec_Raw{
+				SetDescriptors: []*SetDescriptor{
+					{SimpleDescriptors: []*SimpleDescriptor{simple("user", ""), simple("generic_key", "api")}, RateLimit: perMinute(1)},
+				},
+				RateLimits: []*RateLimitActions{{SetActions: []*Action{genericKey("api"), header("x-user", "user")}}},
+			},
+			headers: http.Header{"X-User": {"erin"}},
+			keys:    []string{"set[0]|user_erin|generic_key_api"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sim, err := NewRateLimitSimulator(tt.raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			headers := tt.headers
+			if headers == nil {
+				headers = http.Header{}
+			}
+			result, err := sim.Do(&RateLimitRequest{Headers: headers})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var keys []string
+			for _, l := range result.Limits {
+				keys = append(keys, l.Key)
+			}
+			if !reflect.DeepEqual(keys, tt.keys) {
+				t.Errorf("applied limits = %q, want %q", keys, tt.keys)
+			}
+		})
+	}
+}
+
+func TestRateLimitSimulatorTokenBucket(t *testing.T) {
+	raw := &RateLimitConfigSpec_Raw{
+		Descriptors: []*Descriptor{{Key: "generic_key", Value: "api", RateLimit: perMinute(3)}},
+		RateLimits:  []*RateLimitActions{{Actions: []*Action{genericKey("api")}}},
+	}
+	sim, err := NewRateLimitSimulator(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(0, 0)
+	sim.Clock = func() time.Time { return now }
+	req := &RateLimitRequest{Headers: http.Header{}}
+
+	first, err := sim.FirstThrottled(req, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != 4 {
+		t.Errorf("FirstThrottled() = %d, want 4", first)
+	}
+
+	// A third of a minute refills one token.
+	now = now.Add(20 * time.Second)
+	steps := []struct {
+		overLimit bool
+		remaining uint32
+	}{{false, 0}, {true, 0}}
+	for i, step := range steps {
+		result, err := sim.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.OverLimit != step.overLimit || result.Limits[0].Remaining != step.remaining {
+			t.Errorf("request %d after refill: OverLimit = %v, Remaining = %d, want %v, %d",
+				i, result.OverLimit, result.Limits[0].Remaining, step.overLimit, step.remaining)
+		}
+	}
+
+	sim.Reset()
+	if result, _ := sim.Do(req); result.OverLimit || result.Limits[0].Remaining != 2 {
+		t.Errorf("after Reset: OverLimit = %v, Remaining = %d, want false, 2", result.OverLimit, result.Limits[0].Remaining)
+	}
+}
+
+func TestNewRateLimitSimulatorRejectsUnknownUnit(t *testing.T) {
+	raw := &RateLimitConfigSpec_Raw{
+		Descriptors: []*Descriptor{{Key: "k", Descriptors: []*Descriptor{{Key: "n", RateLimit: &RateLimit{RequestsPerUnit: 1}}}}},
+	}
+	if _, err := NewRateLimitSimulator(raw); err == nil {
+		t.Error("NewRateLimitSimulator() error = nil, want unsupported unit")
+	}
+}
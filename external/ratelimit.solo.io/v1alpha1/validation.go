@@ -0,0 +1,129 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// The Validate methods in this file mirror the required fields and the validate.rules of
+// ratelimit.proto, which the rate limit server enforces when it loads a RateLimitConfig,
+// so that manifests can be checked without a cluster.
+
+var supportedUnits = []string{"SECOND", "MINUTE", "HOUR", "DAY"}
+
+// Validate validates the spec.
+func (s *RateLimitConfigSpec) Validate() field.ErrorList {
+	return s.validate(field.NewPath("spec"))
+}
+
+func (s *RateLimitConfigSpec) validate(fldPath *field.Path) field.ErrorList {
+	raw := s.GetRaw()
+	if raw == nil {
+		return field.ErrorList{field.Required(fldPath.Child("raw"), "")}
+	}
+	return raw.validate(fldPath.Child("raw"))
+}
+
+func (r *RateLimitConfigSpec_Raw) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateDescriptors(r.GetDescriptors(), fldPath.Child("descriptors"))...)
+	for i, rl := range r.GetRateLimits() {
+		allErrs = append(allErrs, rl.validate(fldPath.Child("rateLimits").Index(i))...)
+	}
+	for i, sd := range r.GetSetDescriptors() {
+		sp := fldPath.Child("setDescriptors").Index(i)
+		for j, d := range sd.GetSimpleDescriptors() {
+			if d.GetKey() == "" {
+				allErrs = append(allErrs, field.Required(sp.Child("simpleDescriptors").Index(j).Child("key"), ""))
+			}
+		}
+		allErrs = append(allErrs, sd.GetRateLimit().validate(sp.Child("rateLimit"))...)
+	}
+	return allErrs
+}
+
+func validateDescriptors(descriptors []*Descriptor, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, d := range descriptors {
+		p := fldPath.Index(i)
+		if d.GetKey() == "" {
+			allErrs = append(allErrs, field.Required(p.Child("key"), ""))
+		}
+		allErrs = append(allErrs, d.GetRateLimit().validate(p.Child("rateLimit"))...)
+		allErrs = append(allErrs, validateDescriptors(d.GetDescriptors(), p.Child("descriptors"))...)
+	}
+	return allErrs
+}
+
+func (rl *RateLimit) validate(fldPath *field.Path) field.ErrorList {
+	if rl == nil {
+		return nil
+	}
+	if _, ok := unitDuration(rl.GetUnit()); !ok {
+		return field.ErrorList{field.NotSupported(fldPath.Child("unit"), rl.GetUnit().String(), supportedUnits)}
+	}
+	return nil
+}
+
+func (r *RateLimitActions) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, a := range r.GetActions() {
+		allErrs = append(allErrs, a.validate(fldPath.Child("actions").Index(i))...)
+	}
+	for i, a := range r.GetSetActions() {
+		allErrs = append(allErrs, a.validate(fldPath.Child("setActions").Index(i))...)
+	}
+	return allErrs
+}
+
+func (a *Action) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	required := func(name, value string) {
+		if value == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child(name), ""))
+		}
+	}
+	switch x := a.GetActionSpecifier().(type) {
+	case nil:
+		return field.ErrorList{field.Invalid(fldPath, "object", "exactly one of the fields in [sourceCluster destinationCluster requestHeaders remoteAddress genericKey headerValueMatch metadata cel] must be set")}
+	case *Action_RequestHeaders_:
+		fldPath = fldPath.Child("requestHeaders")
+		required("headerName", x.RequestHeaders.GetHeaderName())
+		required("descriptorKey", x.RequestHeaders.GetDescriptorKey())
+	case *Action_GenericKey_:
+		fldPath = fldPath.Child("genericKey")
+		required("descriptorValue", x.GenericKey.GetDescriptorValue())
+	case *Action_HeaderValueMatch_:
+		fldPath = fldPath.Child("headerValueMatch")
+		required("descriptorValue", x.HeaderValueMatch.GetDescriptorValue())
+		headers := x.HeaderValueMatch.GetHeaders()
+		if len(headers) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("headers"), headers, fmt.Sprintf("%s should have at least 1 items", fldPath.Child("headers"))))
+		}
+		for i, h := range headers {
+			if h.GetName() == "" {
+				allErrs = append(allErrs, field.Required(fldPath.Child("headers").Index(i).Child("name"), ""))
+			}
+		}
+	case *Action_Metadata:
+		fldPath = fldPath.Child("metadata")
+		required("descriptorKey", x.Metadata.GetDescriptorKey())
+		if k := x.Metadata.GetMetadataKey(); k == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("metadataKey"), ""))
+		} else {
+			if k.GetKey() == "" {
+				allErrs = append(allErrs, field.Required(fldPath.Child("metadataKey", "key"), ""))
+			}
+			if len(k.GetPath()) == 0 {
+				p := fldPath.Child("metadataKey", "path")
+				allErrs = append(allErrs, field.Invalid(p, k.GetPath(), fmt.Sprintf("%s should have at least 1 items", p)))
+			}
+		}
+	case *Action_Cel:
+		fldPath = fldPath.Child("cel")
+		required("expression", x.Cel.GetExpression())
+		required("key", x.Cel.GetKey())
+	}
+	return allErrs
+}
@@ -0,0 +1,79 @@
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRateLimitConfigSpecValidate(t *testing.T) {
+	raw := func(r *RateLimitConfigSpec_Raw) *RateLimitConfigSpec {
+		return &RateLimitConfigSpec{ConfigType: &RateLimitConfigSpec_Raw_{Raw: r}}
+	}
+	tests := []struct {
+		name string
+		spec *RateLimitConfigSpec
+		want []string
+	}{
+		{
+			name: "valid",
+			spec: raw(&RateLimitConfigSpec_Raw{
+				Descriptors:    []*Descriptor{{Key: "generic_key", Value: "api", RateLimit: perMinute(1)}},
+				RateLimits:     []*RateLimitActions{{Actions: []*Action{genericKey("api"), header("x-user", "user")}}},
+				SetDescriptors: []*SetDescriptor{{SimpleDescriptors: []*SimpleDescriptor{simple("user", "")}, RateLimit: perMinute(1)}},
+			}),
+		},
+		{name: "no raw config", spec: &RateLimitConfigSpec{}, want: []string{"spec.raw: Required value"}},
+		{
+			name: "nested descriptor without key or unit",
+			spec: raw(&RateLimitConfigSpec_Raw{
+				Descriptors: []*Descriptor{{Key: "k", Descriptors: []*Descriptor{{RateLimit: &RateLimit{RequestsPerUnit: 1}}}}},
+			}),
+			want: []string{
+				"spec.raw.descriptors[0].descriptors[0].key: Required value",
+				`spec.raw.descriptors[0].descriptors[0].rateLimit.unit: Unsupported value: "UNKNOWN": supported values: "SECOND", "MINUTE", "HOUR", "DAY"`,
+			},
+		},
+		{
+			name: "simple descriptor without key",
+			spec: raw(&RateLimitConfigSpec_Raw{
+				SetDescriptors: []*SetDescriptor{{SimpleDescriptors: []*SimpleDescriptor{simple("", "v")}}},
+			}),
+			want: []string{"spec.raw.setDescriptors[0].simpleDescriptors[0].key: Required value"},
+		},
+		{
+			name: "invalid actions",
+			spec: raw(&RateLimitConfigSpec_Raw{
+				RateLimits: []*RateLimitActions{{
+					Actions:    []*Action{{}, header("", "user")},
+					SetActions: []*Action{{ActionSpecifier: &Action_HeaderValueMatch_{HeaderValueMatch: &Action_HeaderValueMatch{DescriptorValue: "v"}}}},
+				}},
+			}),
+			want: []string{
+				`spec.raw.rateLimits[0].actions[0]: Invalid value: "object": exactly one of the fields in [sourceCluster destinationCluster requestHeaders remoteAddress genericKey headerValueMatch metadata cel] must be set`,
+				"spec.raw.rateLimits[0].actions[1].requestHeaders.headerName: Required value",
+				"spec.raw.rateLimits[0].setActions[0].headerValueMatch.headers: Invalid value: null: spec.raw.rateLimits[0].setActions[0].headerValueMatch.headers should have at least 1 items",
+			},
+		},
+		{
+			name: "metadata action without path",
+			spec: raw(&RateLimitConfigSpec_Raw{
+				RateLimits: []*RateLimitActions{{Actions: []*Action{{ActionSpecifier: &Action_Metadata{Metadata: &MetaData{
+					DescriptorKey: "tier",
+					MetadataKey:   &MetaData_MetadataKey{Key: "envoy.filters.http.jwt_authn"},
+				}}}}}},
+			}),
+			want: []string{"spec.raw.rateLimits[0].actions[0].metadata.metadataKey.path: Invalid value: null: spec.raw.rateLimits[0].actions[0].metadata.metadataKey.path should have at least 1 items"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, e := range tt.spec.Validate() {
+				got = append(got, e.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package externalversions
+
+import (
+	fmt "fmt"
+
+	enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	enterprisesolo "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+	waf "github.com/solo-io/kgateway-client/v2/api/v1alpha1/waf"
+	v1 "github.com/solo-io/kgateway-client/v2/external/extauth.solo.io/v1"
+	v1alpha1 "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// GenericInformer is type of SharedIndexInformer which will locate and delegate to other
+// sharedInformers based on type
+type GenericInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() cache.GenericLister
+}
+
+type genericInformer struct {
+	informer cache.SharedIndexInformer
+	resource schema.GroupResource
+}
+
+// Informer returns the SharedIndexInformer.
+func (f *genericInformer) Informer() cache.SharedIndexInformer {
+	return f.informer
+}
+
+// Lister returns the GenericLister.
+func (f *genericInformer) Lister() cache.GenericLister {
+	return cache.NewGenericLister(f.Informer().GetIndexer(), f.resource)
+}
+
+// ForResource gives generic access to a shared informer of the matching type
+// TODO extend this to unknown resources with a client pool
+func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
+	switch resource {
+	// Group=enterprise.solo.io, Version=enterprisesolo
+	case enterprisesolo.SchemeGroupVersion.WithResource("enterpriselistenersets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Enterprisekgateway().Enterprisesolo().EnterpriseListenerSets().Informer()}, nil
+
+		// Group=enterprisekgateway.solo.io, Version=enterprisekgateway
+	case enterprisekgateway.SchemeGroupVersion.WithResource("enterprisekgatewayparameters"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Enterprisekgateway().Enterprisekgateway().EnterpriseKgatewayParameters().Informer()}, nil
+	case enterprisekgateway.SchemeGroupVersion.WithResource("enterprisekgatewaytrafficpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Enterprisekgateway().Enterprisekgateway().EnterpriseKgatewayTrafficPolicies().Informer()}, nil
+
+		// Group=extauth.solo.io, Version=v1
+	case v1.SchemeGroupVersion.WithResource("authconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Extauth().V1().AuthConfigs().Informer()}, nil
+
+		// Group=ratelimit.solo.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("ratelimitconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ratelimit().V1alpha1().RateLimitConfigs().Informer()}, nil
+
+		// Group=waf.solo.io, Version=waf
+	case waf.SchemeGroupVersion.WithResource("wafpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Enterprisekgateway().Waf().WAFPolicies().Informer()}, nil
+
+	}
+
+	return nil, fmt.Errorf("no informer found for %v", resource)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	context "context"
+	time "time"
+
+	apiv1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	versioned "github.com/solo-io/kgateway-client/v2/clientset/versioned"
+	internalinterfaces "github.com/solo-io/kgateway-client/v2/informers/externalversions/internalinterfaces"
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/listers/v1alpha1/enterprisekgateway"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// EnterpriseKgatewayTrafficPolicyInformer provides access to a shared informer and lister for
+// EnterpriseKgatewayTrafficPolicies.
+type EnterpriseKgatewayTrafficPolicyInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyLister
+}
+
+type enterpriseKgatewayTrafficPolicyInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewEnterpriseKgatewayTrafficPolicyInformer constructs a new informer for EnterpriseKgatewayTrafficPolicy type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewEnterpriseKgatewayTrafficPolicyInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredEnterpriseKgatewayTrafficPolicyInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredEnterpriseKgatewayTrafficPolicyInformer constructs a new informer for EnterpriseKgatewayTrafficPolicy type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredEnterpriseKgatewayTrafficPolicyInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.EnterprisekgatewayEnterprisekgateway().EnterpriseKgatewayTrafficPolicies(namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.EnterprisekgatewayEnterprisekgateway().EnterpriseKgatewayTrafficPolicies(namespace).Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.EnterprisekgatewayEnterprisekgateway().EnterpriseKgatewayTrafficPolicies(namespace).List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.EnterprisekgatewayEnterprisekgateway().EnterpriseKgatewayTrafficPolicies(namespace).Watch(ctx, options)
+			},
+		}, client),
+		&apiv1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *enterpriseKgatewayTrafficPolicyInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredEnterpriseKgatewayTrafficPolicyInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *enterpriseKgatewayTrafficPolicyInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apiv1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy{}, f.defaultInformer)
+}
+
+func (f *enterpriseKgatewayTrafficPolicyInformer) Lister() v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicyLister {
+	return v1alpha1enterprisekgateway.NewEnterpriseKgatewayTrafficPolicyLister(f.Informer().GetIndexer())
+}
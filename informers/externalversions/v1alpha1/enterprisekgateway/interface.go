@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	internalinterfaces "github.com/solo-io/kgateway-client/v2/informers/externalversions/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// EnterpriseKgatewayParameters returns a EnterpriseKgatewayParametersInformer.
+	EnterpriseKgatewayParameters() EnterpriseKgatewayParametersInformer
+	// EnterpriseKgatewayTrafficPolicies returns a EnterpriseKgatewayTrafficPolicyInformer.
+	EnterpriseKgatewayTrafficPolicies() EnterpriseKgatewayTrafficPolicyInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// EnterpriseKgatewayParameters returns a EnterpriseKgatewayParametersInformer.
+func (v *version) EnterpriseKgatewayParameters() EnterpriseKgatewayParametersInformer {
+	return &enterpriseKgatewayParametersInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// EnterpriseKgatewayTrafficPolicies returns a EnterpriseKgatewayTrafficPolicyInformer.
+func (v *version) EnterpriseKgatewayTrafficPolicies() EnterpriseKgatewayTrafficPolicyInformer {
+	return &enterpriseKgatewayTrafficPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
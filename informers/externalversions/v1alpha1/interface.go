@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	internalinterfaces "github.com/solo-io/kgateway-client/v2/informers/externalversions/internalinterfaces"
+	enterprisekgateway "github.com/solo-io/kgateway-client/v2/informers/externalversions/v1alpha1/enterprisekgateway"
+	enterprisesolo "github.com/solo-io/kgateway-client/v2/informers/externalversions/v1alpha1/enterprisesolo"
+	waf "github.com/solo-io/kgateway-client/v2/informers/externalversions/v1alpha1/waf"
+)
+
+// Interface provides access to each of this group's versions.
+type Interface interface {
+	// Enterprisekgateway provides access to shared informers for resources in Enterprisekgateway.
+	Enterprisekgateway() enterprisekgateway.Interface
+	// Enterprisesolo provides access to shared informers for resources in Enterprisesolo.
+	Enterprisesolo() enterprisesolo.Interface
+	// Waf provides access to shared informers for resources in Waf.
+	Waf() waf.Interface
+}
+
+type group struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &group{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// Enterprisekgateway returns a new enterprisekgateway.Interface.
+func (g *group) Enterprisekgateway() enterprisekgateway.Interface {
+	return enterprisekgateway.New(g.factory, g.namespace, g.tweakListOptions)
+}
+
+// Enterprisesolo returns a new enterprisesolo.Interface.
+func (g *group) Enterprisesolo() enterprisesolo.Interface {
+	return enterprisesolo.New(g.factory, g.namespace, g.tweakListOptions)
+}
+
+// Waf returns a new waf.Interface.
+func (g *group) Waf() waf.Interface {
+	return waf.New(g.factory, g.namespace, g.tweakListOptions)
+}
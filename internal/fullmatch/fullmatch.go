@@ -0,0 +1,44 @@
+// Package fullmatch compiles RE2 regexes that must match all of their input, as the Envoy
+// safe_regex matchers do. The compiled regexes are cached in a bounded cache shared by
+// the matchers of this module.
+package fullmatch
+
+import (
+	"regexp"
+	"sync"
+)
+
+// maxEntries bounds the cache. It is cleared when full, so that evaluating configs with
+// many distinct regexes does not grow it without bound.
+const maxEntries = 1024
+
+type entry struct {
+	re  *regexp.Regexp
+	err error
+}
+
+var (
+	mu    sync.Mutex
+	cache = map[string]entry{}
+)
+
+// Compile compiles regex anchored at both ends. Compilation errors are cached too.
+func Compile(regex string) (*regexp.Regexp, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if e, ok := cache[regex]; ok {
+		return e.re, e.err
+	}
+	re, err := regexp.Compile(`^(?:` + regex + `)$`)
+	if len(cache) >= maxEntries {
+		clear(cache)
+	}
+	cache[regex] = entry{re, err}
+	return re, err
+}
+
+// MatchString reports whether regex matches all of s. Invalid regexes never match.
+func MatchString(regex, s string) bool {
+	re, err := Compile(regex)
+	return err == nil && re.MatchString(s)
+}
@@ -0,0 +1,37 @@
+package fullmatch
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestMatchString(t *testing.T) {
+	tests := []struct {
+		regex, s string
+		want     bool
+	}{
+		{"/api/.*", "/api/v1", true},
+		{"/api", "/api/v1", false},
+		{"a|b", "ab", false},
+		{"a|b", "b", true},
+		{"(", "(", false},
+	}
+	for _, tt := range tests {
+		if got := MatchString(tt.regex, tt.s); got != tt.want {
+			t.Errorf("MatchString(%q, %q) = %v, want %v", tt.regex, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestCompileBoundsCache(t *testing.T) {
+	for i := 0; i < 2*maxEntries; i++ {
+		if _, err := Compile(strconv.Itoa(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(cache) > maxEntries {
+		t.Errorf("cache holds %d regexes, want at most %d", len(cache), maxEntries)
+	}
+}
@@ -0,0 +1,361 @@
+// Package listenerset merges the listeners of a Gateway with the listeners of the
+// EnterpriseListenerSets attached to it, as the controller does, and computes the status
+// of each listener set, so that a listener set can be checked before it is applied.
+//
+// A listener set is attached to a Gateway when its parentRef selects the Gateway and the
+// allowedListeners of the Gateway allow listener sets from its namespace. The merged
+// listeners are ordered by precedence: the listeners of the Gateway first, then those of
+// the attached listener sets, ordered by creation timestamp, then by "{namespace}/{name}".
+//
+// Listeners without a port are assigned the lowest free port of Options.DynamicPorts.
+// A listener conflicts with a listener of higher precedence on the same port when their
+// protocols can not share the port, or when they match the same hostname; conflicted
+// listeners are not programmed.
+//
+// TLS certificate references of a listener set are resolved from its namespace: the
+// ReferenceGrants that allow the Gateway to reference Secrets are not inherited, and
+// cross-namespace references require a ReferenceGrant from the EnterpriseListenerSet.
+package listenerset
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+)
+
+// GatewayConditionAttachedListenerSets is the Gateway condition reporting whether listener
+// sets are attached to it.
+const GatewayConditionAttachedListenerSets = "AttachedListenerSets"
+
+// Reasons of the AttachedListenerSets Gateway condition.
+const (
+	GatewayReasonListenerSetsAttached   = "ListenerSetsAttached"
+	GatewayReasonNoListenerSetsAttached = "NoListenerSetsAttached"
+	GatewayReasonListenerSetsNotAllowed = "ListenerSetsNotAllowed"
+	GatewayReasonPending                = "Pending"
+)
+
+// PortRange is an inclusive range of ports.
+type PortRange struct {
+	Min, Max gwv1.PortNumber
+}
+
+// DefaultDynamicPorts are the ports assigned to listeners without a port.
+var DefaultDynamicPorts = PortRange{Min: 1024, Max: 65535}
+
+// DefaultSupportedProtocols are the listener protocols supported by the gateway.
+var DefaultSupportedProtocols = []gwv1.ProtocolType{
+	gwv1.HTTPProtocolType,
+	gwv1.HTTPSProtocolType,
+	gwv1.TLSProtocolType,
+	gwv1.TCPProtocolType,
+}
+
+// Options configures the merge.
+type Options struct {
+	// NamespaceLabels returns the labels of a namespace. It is required to attach listener
+	// sets to Gateways that select namespaces by labels.
+	NamespaceLabels func(namespace string) map[string]string
+	// SupportedProtocols defaults to DefaultSupportedProtocols.
+	SupportedProtocols []gwv1.ProtocolType
+	// DynamicPorts defaults to DefaultDynamicPorts.
+	DynamicPorts *PortRange
+	// ReferenceGrants are the ReferenceGrants that may allow the certificate references of
+	// listener sets.
+	ReferenceGrants []*gwv1.ReferenceGrant
+	// AttachedRoutes returns the number of routes attached to a listener of a listener set.
+	// AttachedRoutes are 0 when it is nil.
+	AttachedRoutes func(listenerSet types.NamespacedName, listener gwv1.SectionName) int32
+	// Now is the last transition time of the conditions. It defaults to the current time.
+	Now metav1.Time
+}
+
+// Listener is a listener of the merged listener list.
+type Listener struct {
+	gwv1.Listener
+	// ListenerSet is the listener set defining the listener, or nil for the listeners of
+	// the Gateway.
+	ListenerSet *types.NamespacedName
+	// Accepted is false when the listener is not supported or has no port.
+	Accepted bool
+	// Conflicted is the reason the listener conflicts with a listener of higher precedence,
+	// or empty. ConflictsWith describes that listener.
+	Conflicted    gwv1.ListenerEntryConditionReason
+	ConflictsWith string
+}
+
+// Programmed reports whether the listener is accepted and does not conflict.
+func (l *Listener) Programmed() bool {
+	return l.Accepted && l.Conflicted == ""
+}
+
+func (l *Listener) String() string {
+	if l.ListenerSet == nil {
+		return fmt.Sprintf("Gateway listener %s", l.Name)
+	}
+	return fmt.Sprintf("EnterpriseListenerSet %s listener %s", l.ListenerSet, l.Name)
+}
+
+// Result is the outcome of a merge.
+type Result struct {
+	// Listeners are the listeners of the Gateway and of the attached listener sets, by
+	// precedence.
+	Listeners []Listener
+	// ListenerSets are the statuses of the listener sets referencing the Gateway.
+	ListenerSets map[types.NamespacedName]*enterprisesolo.EnterpriseListenerSetStatus
+	// AttachedListenerSets is the number of accepted listener sets, and Condition the
+	// AttachedListenerSets condition of the Gateway.
+	AttachedListenerSets int32
+	Condition            metav1.Condition
+}
+
+// Merge merges the listeners of gateway and of the listener sets of sets that reference
+// it. Listener sets referencing other Gateways are ignored.
+func Merge(gateway *gwv1.Gateway, sets []*enterprisesolo.EnterpriseListenerSet, opts Options) *Result {
+	if opts.SupportedProtocols == nil {
+		opts.SupportedProtocols = DefaultSupportedProtocols
+	}
+	if opts.DynamicPorts == nil {
+		opts.DynamicPorts = &DefaultDynamicPorts
+	}
+	if opts.Now.IsZero() {
+		opts.Now = metav1.Now()
+	}
+	m := &merger{opts: opts, gateway: gateway}
+	res := &Result{ListenerSets: map[types.NamespacedName]*enterprisesolo.EnterpriseListenerSetStatus{}}
+
+	for _, l := range gateway.Spec.Listeners {
+		res.Listeners = append(res.Listeners, Listener{Listener: *l.DeepCopy(), Accepted: m.supported(l.Protocol)})
+	}
+
+	var attached []*enterprisesolo.EnterpriseListenerSet
+	for _, set := range sets {
+		if !m.references(set) {
+			continue
+		}
+		key := types.NamespacedName{Namespace: set.Namespace, Name: set.Name}
+		if !m.allowed(set.Namespace) {
+			res.ListenerSets[key] = &enterprisesolo.EnterpriseListenerSetStatus{Conditions: []metav1.Condition{
+				m.condition(set, string(gwv1.ListenerSetConditionAccepted), false, string(gwv1.ListenerSetReasonNotAllowed),
+					fmt.Sprintf("Gateway %s/%s does not allow listener sets from namespace %s", gateway.Namespace, gateway.Name, set.Namespace)),
+				m.condition(set, string(gwv1.ListenerSetConditionProgrammed), false, string(gwv1.ListenerSetReasonNotAllowed),
+					"The listener set is not attached"),
+			}}
+			continue
+		}
+		attached = append(attached, set)
+	}
+	sort.SliceStable(attached, func(i, j int) bool {
+		a, b := attached[i], attached[j]
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	// invalid are the listeners of listener sets with a duplicate name.
+	invalid := map[int]bool{}
+	for _, set := range attached {
+		key := types.NamespacedName{Namespace: set.Namespace, Name: set.Name}
+		names := map[gwv1.SectionName]bool{}
+		for _, entry := range set.Spec.Listeners {
+			if names[entry.Name] {
+				invalid[len(res.Listeners)] = true
+			}
+			names[entry.Name] = true
+			res.Listeners = append(res.Listeners, Listener{
+				Listener:    enterprisesolo.ToListener(entry),
+				ListenerSet: &key,
+				Accepted:    m.supported(entry.Protocol),
+			})
+		}
+	}
+	for i := range invalid {
+		res.Listeners[i].Accepted = false
+	}
+	unassigned := assignPorts(res.Listeners, *opts.DynamicPorts)
+	detectConflicts(res.Listeners)
+
+	for _, set := range attached {
+		status := m.status(set, res.Listeners, invalid, unassigned)
+		res.ListenerSets[types.NamespacedName{Namespace: set.Namespace, Name: set.Name}] = status
+		if meta.IsStatusConditionTrue(status.Conditions, string(gwv1.ListenerSetConditionAccepted)) {
+			res.AttachedListenerSets++
+		}
+	}
+	res.Condition = m.gatewayCondition(res.AttachedListenerSets)
+	return res
+}
+
+type merger struct {
+	opts    Options
+	gateway *gwv1.Gateway
+}
+
+// references reports whether the parentRef of set selects the Gateway.
+func (m *merger) references(set *enterprisesolo.EnterpriseListenerSet) bool {
+	ref := set.Spec.ParentRef
+	if ref.Group != nil && string(*ref.Group) != gwv1.GroupName {
+		return false
+	}
+	if ref.Kind != nil && *ref.Kind != "Gateway" {
+		return false
+	}
+	namespace := set.Namespace
+	if ref.Namespace != nil {
+		namespace = string(*ref.Namespace)
+	}
+	return namespace == m.gateway.Namespace && string(ref.Name) == m.gateway.Name
+}
+
+// allowed reports whether the allowedListeners of the Gateway allow listener sets from
+// namespace.
+func (m *merger) allowed(namespace string) bool {
+	allowed := m.gateway.Spec.AllowedListeners
+	if allowed == nil || allowed.Namespaces == nil || allowed.Namespaces.From == nil {
+		return false
+	}
+	switch *allowed.Namespaces.From {
+	case gwv1.NamespacesFromAll:
+		return true
+	case gwv1.NamespacesFromSame:
+		return namespace == m.gateway.Namespace
+	case gwv1.NamespacesFromSelector:
+		if allowed.Namespaces.Selector == nil || m.opts.NamespaceLabels == nil {
+			return false
+		}
+		selector, err := metav1.LabelSelectorAsSelector(allowed.Namespaces.Selector)
+		if err != nil {
+			return false
+		}
+		return selector.Matches(labels.Set(m.opts.NamespaceLabels(namespace)))
+	default:
+		return false
+	}
+}
+
+func (m *merger) supported(protocol gwv1.ProtocolType) bool {
+	for _, p := range m.opts.SupportedProtocols {
+		if p == protocol {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *merger) gatewayCondition(attached int32) metav1.Condition {
+	c := metav1.Condition{
+		Type:               GatewayConditionAttachedListenerSets,
+		ObservedGeneration: m.gateway.Generation,
+		LastTransitionTime: m.opts.Now,
+	}
+	allowed := m.gateway.Spec.AllowedListeners
+	switch {
+	case allowed == nil:
+		c.Status, c.Reason, c.Message = metav1.ConditionUnknown, GatewayReasonPending, "The Gateway does not configure allowedListeners"
+	case allowed.Namespaces == nil || allowed.Namespaces.From == nil || *allowed.Namespaces.From == gwv1.NamespacesFromNone:
+		c.Status, c.Reason, c.Message = metav1.ConditionFalse, GatewayReasonListenerSetsNotAllowed, "The Gateway does not allow listener sets"
+	case attached == 0:
+		c.Status, c.Reason, c.Message = metav1.ConditionFalse, GatewayReasonNoListenerSetsAttached, "No listener sets are attached"
+	default:
+		c.Status, c.Reason, c.Message = metav1.ConditionTrue, GatewayReasonListenerSetsAttached, fmt.Sprintf("%d listener sets are attached", attached)
+	}
+	return c
+}
+
+// assignPorts assigns the lowest free ports of ports to the accepted listeners without a
+// port. It returns the indexes of the listeners that could not be assigned a port, which
+// are not accepted.
+func assignPorts(listeners []Listener, ports PortRange) map[int]bool {
+	used := map[gwv1.PortNumber]bool{}
+	for _, l := range listeners {
+		if l.Port != 0 {
+			used[l.Port] = true
+		}
+	}
+	unassigned := map[int]bool{}
+	next := ports.Min
+	for i := range listeners {
+		l := &listeners[i]
+		if l.Port != 0 || !l.Accepted {
+			continue
+		}
+		for next <= ports.Max && used[next] {
+			next++
+		}
+		if next > ports.Max {
+			l.Accepted = false
+			unassigned[i] = true
+			continue
+		}
+		l.Port = next
+		used[next] = true
+	}
+	return unassigned
+}
+
+// detectConflicts marks the accepted listeners that conflict with a programmed listener of
+// higher precedence.
+func detectConflicts(listeners []Listener) {
+	for i := range listeners {
+		l := &listeners[i]
+		if !l.Accepted {
+			continue
+		}
+		for j := 0; j < i; j++ {
+			other := &listeners[j]
+			if !other.Programmed() || other.Port != l.Port {
+				continue
+			}
+			if reason := conflict(&l.Listener, &other.Listener); reason != "" {
+				l.Conflicted, l.ConflictsWith = reason, other.String()
+				break
+			}
+		}
+	}
+}
+
+// protocolGroups are the groups of protocols that can share a port.
+var protocolGroups = map[gwv1.ProtocolType]int{
+	gwv1.HTTPProtocolType:  1,
+	gwv1.HTTPSProtocolType: 2,
+	gwv1.TLSProtocolType:   2,
+	gwv1.TCPProtocolType:   3,
+	gwv1.UDPProtocolType:   4,
+}
+
+// conflict returns the reason listeners a and b on the same port conflict, or empty.
+func conflict(a, b *gwv1.Listener) gwv1.ListenerEntryConditionReason {
+	ga, gb := protocolGroups[a.Protocol], protocolGroups[b.Protocol]
+	if ga != gb {
+		// UDP listeners do not bind the TCP port of the same number.
+		if a.Protocol == gwv1.UDPProtocolType || b.Protocol == gwv1.UDPProtocolType {
+			return ""
+		}
+		return gwv1.ListenerEntryReasonProtocolConflict
+	}
+	if hostname(a) != hostname(b) {
+		return ""
+	}
+	if a.Protocol != b.Protocol {
+		return gwv1.ListenerEntryReasonHostnameConflict
+	}
+	return gwv1.ListenerEntryReasonListenerConflict
+}
+
+func hostname(l *gwv1.Listener) gwv1.Hostname {
+	if l.Hostname == nil || l.Protocol == gwv1.TCPProtocolType || l.Protocol == gwv1.UDPProtocolType {
+		return ""
+	}
+	return *l.Hostname
+}
@@ -0,0 +1,272 @@
+package listenerset
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+)
+
+var created = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testGateway(from *gwv1.FromNamespaces, listeners ...gwv1.Listener) *gwv1.Gateway {
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "gw", Generation: 2},
+		Spec:       gwv1.GatewaySpec{Listeners: listeners},
+	}
+	if from != nil {
+		gw.Spec.AllowedListeners = &gwv1.AllowedListeners{Namespaces: &gwv1.ListenerNamespaces{From: from}}
+	}
+	return gw
+}
+
+// testListenerSet returns a listener set referencing the Gateway infra/gw, created age
+// minutes after created.
+func testListenerSet(namespace, name string, age int, listeners ...enterprisesolo.EnterpriseListenerEntry) *enterprisesolo.EnterpriseListenerSet {
+	return &enterprisesolo.EnterpriseListenerSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              name,
+			Generation:        1,
+			CreationTimestamp: metav1.NewTime(created.Add(time.Duration(age) * time.Minute)),
+		},
+		Spec: enterprisesolo.EnterpriseListenerSetSpec{
+			ParentRef: enterprisesolo.ParentGatewayReference{Name: "gw", Namespace: ptr.To[gwv1.Namespace]("infra")},
+			Listeners: listeners,
+		},
+	}
+}
+
+func gatewayListener(name string, protocol gwv1.ProtocolType, port gwv1.PortNumber, hostname string) gwv1.Listener {
+	l := gwv1.Listener{Name: gwv1.SectionName(name), Protocol: protocol, Port: port}
+	if hostname != "" {
+		l.Hostname = ptr.To(gwv1.Hostname(hostname))
+	}
+	return l
+}
+
+func entry(name string, protocol gwv1.ProtocolType, port enterprisesolo.PortNumber, hostname string) enterprisesolo.EnterpriseListenerEntry {
+	e := enterprisesolo.EnterpriseListenerEntry{Name: gwv1.SectionName(name), Protocol: protocol, Port: port}
+	if hostname != "" {
+		e.Hostname = ptr.To(gwv1.Hostname(hostname))
+	}
+	return e
+}
+
+// summary describes the merged listeners as "owner/listener:port:state", where state is
+// programmed, rejected, or the conflict reason.
+func summary(listeners []Listener) []string {
+	var out []string
+	for _, l := range listeners {
+		owner := "gw"
+		if l.ListenerSet != nil {
+			owner = l.ListenerSet.Name
+		}
+		state := "programmed"
+		switch {
+		case !l.Accepted:
+			state = "rejected"
+		case l.Conflicted != "":
+			state = string(l.Conflicted)
+		}
+		out = append(out, fmt.Sprintf("%s/%s:%d:%s", owner, l.Name, l.Port, state))
+	}
+	return out
+}
+
+func TestMerge(t *testing.T) {
+	all := ptr.To(gwv1.NamespacesFromAll)
+	tests := []struct {
+		name      string
+		gateway   *gwv1.Gateway
+		sets      []*enterprisesolo.EnterpriseListenerSet
+		opts      Options
+		listeners []string
+		// attached are the listener sets with an Accepted condition, and notAllowed those
+		// rejected by the allowedListeners of the Gateway.
+		attached   []string
+		notAllowed []string
+		reason     string
+	}{
+		{
+			name:       "allowedListeners not set",
+			gateway:    testGateway(nil, gatewayListener("http", gwv1.HTTPProtocolType, 80, "")),
+			sets:       []*enterprisesolo.EnterpriseListenerSet{testListenerSet("infra", "a", 0, entry("x", gwv1.HTTPProtocolType, 8080, ""))},
+			listeners:  []string{"gw/http:80:programmed"},
+			notAllowed: []string{"a"},
+			reason:     GatewayReasonPending,
+		},
+		{
+			name:       "same namespace",
+			gateway:    testGateway(ptr.To(gwv1.NamespacesFromSame)),
+			sets:       []*enterprisesolo.EnterpriseListenerSet{testListenerSet("infra", "a", 0, entry("x", gwv1.HTTPProtocolType, 8080, "")), testListenerSet("apps", "b", 0, entry("x", gwv1.HTTPProtocolType, 8081, ""))},
+			listeners:  []string{"a/x:8080:programmed"},
+			attached:   []string{"a"},
+			notAllowed: []string{"b"},
+			reason:     GatewayReasonListenerSetsAttached,
+		},
+		{
+			name: "namespaces selected by labels",
+			gateway: func() *gwv1.Gateway {
+				gw := testGateway(ptr.To(gwv1.NamespacesFromSelector))
+				gw.Spec.AllowedListeners.Namespaces.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "apps"}}
+				return gw
+			}(),
+			sets:       []*enterprisesolo.EnterpriseListenerSet{testListenerSet("apps", "a", 0, entry("x", gwv1.HTTPProtocolType, 8080, "")), testListenerSet("other", "b", 0, entry("x", gwv1.HTTPProtocolType, 8081, ""))},
+			opts:       Options{NamespaceLabels: func(ns string) map[string]string { return map[string]string{"team": ns} }},
+			listeners:  []string{"a/x:8080:programmed"},
+			attached:   []string{"a"},
+			notAllowed: []string{"b"},
+			reason:     GatewayReasonListenerSetsAttached,
+		},
+		{
+			name:    "other Gateway ignored",
+			gateway: testGateway(all),
+			sets: func() []*enterprisesolo.EnterpriseListenerSet {
+				s := testListenerSet("infra", "a", 0, entry("x", gwv1.HTTPProtocolType, 8080, ""))
+				s.Spec.ParentRef.Name = "other"
+				return []*enterprisesolo.EnterpriseListenerSet{s}
+			}(),
+			reason: GatewayReasonNoListenerSetsAttached,
+		},
+		{
+			name:    "precedence by creation time then name",
+			gateway: testGateway(all, gatewayListener("http", gwv1.HTTPProtocolType, 80, "")),
+			sets: []*enterprisesolo.EnterpriseListenerSet{
+				testListenerSet("infra", "c", 0, entry("x", gwv1.HTTPProtocolType, 80, "")),
+				testListenerSet("infra", "b", 1, entry("x", gwv1.HTTPProtocolType, 81, "")),
+				testListenerSet("infra", "a", 1, entry("x", gwv1.HTTPProtocolType, 81, "")),
+			},
+			listeners: []string{"gw/http:80:programmed", "c/x:80:ListenerConflict", "a/x:81:programmed", "b/x:81:ListenerConflict"},
+			attached:  []string{"a"},
+			reason:    GatewayReasonListenerSetsAttached,
+		},
+		{
+			name:    "conflicts on a shared port",
+			gateway: testGateway(all, gatewayListener("https", gwv1.HTTPSProtocolType, 443, "a.example.com")),
+			sets: []*enterprisesolo.EnterpriseListenerSet{testListenerSet("infra", "a", 0,
+				entry("other-host", gwv1.HTTPSProtocolType, 443, "b.example.com"),
+				entry("tls-same-host", gwv1.TLSProtocolType, 443, "a.example.com"),
+				entry("http", gwv1.HTTPProtocolType, 443, ""),
+				entry("tcp", gwv1.TCPProtocolType, 443, ""),
+			)},
+			listeners: []string{
+				"gw/https:443:programmed",
+				"a/other-host:443:programmed",
+				"a/tls-same-host:443:HostnameConflict",
+				"a/http:443:ProtocolConflict",
+				"a/tcp:443:ProtocolConflict",
+			},
+			attached: []string{"a"},
+			reason:   GatewayReasonListenerSetsAttached,
+		},
+		{
+			name:    "conflicted listeners do not conflict with others",
+			gateway: testGateway(all, gatewayListener("tcp", gwv1.TCPProtocolType, 80, "")),
+			sets: []*enterprisesolo.EnterpriseListenerSet{testListenerSet("infra", "a", 0,
+				entry("http-1", gwv1.HTTPProtocolType, 80, ""),
+				entry("http-2", gwv1.HTTPProtocolType, 80, ""),
+			)},
+			listeners: []string{"gw/tcp:80:programmed", "a/http-1:80:ProtocolConflict", "a/http-2:80:ProtocolConflict"},
+			reason:    GatewayReasonNoListenerSetsAttached,
+		},
+		{
+			name:    "dynamic ports",
+			gateway: testGateway(all, gatewayListener("http", gwv1.HTTPProtocolType, 2000, "")),
+			sets: []*enterprisesolo.EnterpriseListenerSet{testListenerSet("infra", "a", 0,
+				entry("x", gwv1.HTTPProtocolType, 0, ""),
+				entry("y", gwv1.HTTPProtocolType, 0, ""),
+				entry("z", gwv1.HTTPProtocolType, 0, ""),
+			)},
+			opts:      Options{DynamicPorts: &PortRange{Min: 2000, Max: 2002}},
+			listeners: []string{"gw/http:2000:programmed", "a/x:2001:programmed", "a/y:2002:programmed", "a/z:0:rejected"},
+			attached:  []string{"a"},
+			reason:    GatewayReasonListenerSetsAttached,
+		},
+		{
+			name:    "unsupported protocol and duplicate name",
+			gateway: testGateway(all),
+			sets: []*enterprisesolo.EnterpriseListenerSet{testListenerSet("infra", "a", 0,
+				entry("x", gwv1.HTTPProtocolType, 80, ""),
+				entry("x", gwv1.HTTPProtocolType, 81, ""),
+				entry("udp", gwv1.UDPProtocolType, 82, ""),
+			)},
+			listeners: []string{"a/x:80:programmed", "a/x:81:rejected", "a/udp:82:rejected"},
+			attached:  []string{"a"},
+			reason:    GatewayReasonListenerSetsAttached,
+		},
+		{
+			name:    "UDP does not conflict with TCP",
+			gateway: testGateway(all, gatewayListener("dns-tcp", gwv1.TCPProtocolType, 53, "")),
+			sets:    []*enterprisesolo.EnterpriseListenerSet{testListenerSet("infra", "a", 0, entry("dns", gwv1.UDPProtocolType, 53, ""))},
+			opts: Options{SupportedProtocols: []gwv1.ProtocolType{
+				gwv1.TCPProtocolType, gwv1.UDPProtocolType,
+			}},
+			listeners: []string{"gw/dns-tcp:53:programmed", "a/dns:53:programmed"},
+			attached:  []string{"a"},
+			reason:    GatewayReasonListenerSetsAttached,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := Merge(tt.gateway, tt.sets, tt.opts)
+			if got := summary(res.Listeners); !reflect.DeepEqual(got, tt.listeners) {
+				t.Errorf("listeners = %q, want %q", got, tt.listeners)
+			}
+			var attached, notAllowed []string
+			for _, set := range tt.sets {
+				status, ok := res.ListenerSets[types.NamespacedName{Namespace: set.Namespace, Name: set.Name}]
+				if !ok {
+					continue
+				}
+				accepted := status.Conditions[0]
+				switch {
+				case accepted.Status == metav1.ConditionTrue:
+					attached = append(attached, set.Name)
+				case accepted.Reason == string(gwv1.ListenerSetReasonNotAllowed):
+					notAllowed = append(notAllowed, set.Name)
+				}
+			}
+			if !reflect.DeepEqual(attached, tt.attached) {
+				t.Errorf("attached listener sets = %q, want %q", attached, tt.attached)
+			}
+			if !reflect.DeepEqual(notAllowed, tt.notAllowed) {
+				t.Errorf("listener sets not allowed = %q, want %q", notAllowed, tt.notAllowed)
+			}
+			if int(res.AttachedListenerSets) != len(tt.attached) {
+				t.Errorf("AttachedListenerSets = %d, want %d", res.AttachedListenerSets, len(tt.attached))
+			}
+			if res.Condition.Reason != tt.reason || res.Condition.ObservedGeneration != 2 {
+				t.Errorf("Gateway condition = %s (generation %d), want %s (generation 2)", res.Condition.Reason, res.Condition.ObservedGeneration, tt.reason)
+			}
+		})
+	}
+}
+
+func TestConflict(t *testing.T) {
+	tests := []struct {
+		a, b gwv1.Listener
+		want gwv1.ListenerEntryConditionReason
+	}{
+		{gatewayListener("a", gwv1.HTTPProtocolType, 80, ""), gatewayListener("b", gwv1.HTTPProtocolType, 80, ""), gwv1.ListenerEntryReasonListenerConflict},
+		{gatewayListener("a", gwv1.HTTPProtocolType, 80, "a.com"), gatewayListener("b", gwv1.HTTPProtocolType, 80, "b.com"), ""},
+		{gatewayListener("a", gwv1.HTTPSProtocolType, 443, "a.com"), gatewayListener("b", gwv1.TLSProtocolType, 443, "a.com"), gwv1.ListenerEntryReasonHostnameConflict},
+		{gatewayListener("a", gwv1.HTTPSProtocolType, 443, "a.com"), gatewayListener("b", gwv1.TLSProtocolType, 443, "b.com"), ""},
+		{gatewayListener("a", gwv1.HTTPProtocolType, 80, ""), gatewayListener("b", gwv1.HTTPSProtocolType, 80, ""), gwv1.ListenerEntryReasonProtocolConflict},
+		// The hostnames of TCP listeners are ignored.
+		{gatewayListener("a", gwv1.TCPProtocolType, 9000, "a.com"), gatewayListener("b", gwv1.TCPProtocolType, 9000, "b.com"), gwv1.ListenerEntryReasonListenerConflict},
+		{gatewayListener("a", gwv1.UDPProtocolType, 53, ""), gatewayListener("b", gwv1.TCPProtocolType, 53, ""), ""},
+	}
+	for _, tt := range tests {
+		if got := conflict(&tt.a, &tt.b); got != tt.want {
+			t.Errorf("conflict(%s %s, %s %s) = %q, want %q", tt.a.Protocol, ptr.Deref(tt.a.Hostname, ""), tt.b.Protocol, ptr.Deref(tt.b.Hostname, ""), got, tt.want)
+		}
+	}
+}
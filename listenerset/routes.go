@@ -0,0 +1,289 @@
+package listenerset
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+)
+
+// Routes are the routes that may attach to listener sets.
+type Routes struct {
+	HTTPRoutes []*gwv1.HTTPRoute
+	GRPCRoutes []*gwv1.GRPCRoute
+	TLSRoutes  []*gwv1.TLSRoute
+	TCPRoutes  []*gwv1a2.TCPRoute
+}
+
+// RouteRef identifies a route.
+type RouteRef struct {
+	Kind gwv1.Kind
+	types.NamespacedName
+}
+
+func (r RouteRef) String() string {
+	return fmt.Sprintf("%s %s", r.Kind, r.NamespacedName)
+}
+
+// Rejection is the reason a route does not attach to a listener.
+type Rejection string
+
+const (
+	// RejectionNoMatchingParent is reported when the listener set, or a listener with the
+	// sectionName or port of the parentRef, does not exist.
+	RejectionNoMatchingParent Rejection = "NoMatchingParent"
+	// RejectionNamespaceNotAllowed is reported when the allowedRoutes namespaces of the
+	// listener do not select the namespace of the route.
+	RejectionNamespaceNotAllowed Rejection = "NamespaceNotAllowed"
+	// RejectionKindNotAllowed is reported when the allowedRoutes kinds of the listener, or
+	// the kinds supported by its protocol, do not include the kind of the route.
+	RejectionKindNotAllowed Rejection = "KindNotAllowed"
+	// RejectionHostnameMismatch is reported when no hostname of the route matches the
+	// hostname of the listener.
+	RejectionHostnameMismatch Rejection = "HostnameMismatch"
+)
+
+// RouteConditionReason returns the reason of the Accepted route condition reporting the
+// rejection, or Accepted for an empty rejection.
+func (r Rejection) RouteConditionReason() gwv1.RouteConditionReason {
+	switch r {
+	case "":
+		return gwv1.RouteReasonAccepted
+	case RejectionNamespaceNotAllowed, RejectionKindNotAllowed:
+		return gwv1.RouteReasonNotAllowedByListeners
+	case RejectionHostnameMismatch:
+		return gwv1.RouteReasonNoMatchingListenerHostname
+	default:
+		return gwv1.RouteReasonNoMatchingParent
+	}
+}
+
+// Attachment is the outcome of attaching a route to a listener of a listener set through
+// one of its parentRefs.
+type Attachment struct {
+	Route RouteRef
+	// ParentRef is the index of the parentRef in the spec of the route.
+	ParentRef int
+	// ListenerSet and Listener identify the listener. Listener is empty when the parentRef
+	// matches no listener.
+	ListenerSet types.NamespacedName
+	Listener    gwv1.SectionName
+	// Rejection is empty when the route attaches to the listener. Otherwise Message
+	// explains it.
+	Rejection Rejection
+	Message   string
+}
+
+// Attached reports whether the route attaches to the listener.
+func (a *Attachment) Attached() bool {
+	return a.Rejection == ""
+}
+
+// Attachments are the attachments of routes to listener sets, by route, then by parentRef,
+// then by listener.
+type Attachments []Attachment
+
+// AttachedRoutes returns the number of routes attached to a listener of a listener set.
+// It can be used as Options.AttachedRoutes.
+func (as Attachments) AttachedRoutes(listenerSet types.NamespacedName, listener gwv1.SectionName) int32 {
+	routes := map[RouteRef]bool{}
+	for i := range as {
+		a := &as[i]
+		if a.Attached() && a.ListenerSet == listenerSet && a.Listener == listener {
+			routes[a.Route] = true
+		}
+	}
+	return int32(len(routes))
+}
+
+// Rejected returns the attachments of routes rejected by listeners.
+func (as Attachments) Rejected() Attachments {
+	var rejected Attachments
+	for _, a := range as {
+		if !a.Attached() {
+			rejected = append(rejected, a)
+		}
+	}
+	return rejected
+}
+
+// AttachRoutes attaches routes to the listeners of sets, as the controller does, using the
+// labels of namespaces to evaluate the namespace selectors of allowedRoutes. A parentRef
+// of a route selects an EnterpriseListenerSet, and the listener named by its sectionName,
+// or all its listeners, restricted to those on its port when set. Routes attach to each
+// selected listener whose allowedRoutes allow their namespace and kind, and whose
+// hostname matches one of their hostnames; the hostnames of TCPRoutes are not matched.
+//
+// Parent references to Gateways are ignored, and so are the conflicts and ports computed
+// by Merge: listeners without a port are only selected by parentRefs without a port.
+func AttachRoutes(sets []*enterprisesolo.EnterpriseListenerSet, namespaces []*corev1.Namespace, routes Routes) Attachments {
+	a := &attacher{
+		sets:       map[types.NamespacedName]*enterprisesolo.EnterpriseListenerSet{},
+		namespaces: map[string]labels.Set{},
+	}
+	for _, set := range sets {
+		a.sets[types.NamespacedName{Namespace: set.Namespace, Name: set.Name}] = set
+	}
+	for _, ns := range namespaces {
+		a.namespaces[ns.Name] = ns.Labels
+	}
+	for _, r := range routes.HTTPRoutes {
+		a.attach("HTTPRoute", &r.ObjectMeta, r.Spec.ParentRefs, r.Spec.Hostnames)
+	}
+	for _, r := range routes.GRPCRoutes {
+		a.attach("GRPCRoute", &r.ObjectMeta, r.Spec.ParentRefs, r.Spec.Hostnames)
+	}
+	for _, r := range routes.TLSRoutes {
+		a.attach("TLSRoute", &r.ObjectMeta, r.Spec.ParentRefs, r.Spec.Hostnames)
+	}
+	for _, r := range routes.TCPRoutes {
+		a.attach("TCPRoute", &r.ObjectMeta, r.Spec.ParentRefs, nil)
+	}
+	return a.attachments
+}
+
+type attacher struct {
+	sets        map[types.NamespacedName]*enterprisesolo.EnterpriseListenerSet
+	namespaces  map[string]labels.Set
+	attachments Attachments
+}
+
+func (a *attacher) attach(kind gwv1.Kind, meta *metav1.ObjectMeta, parentRefs []gwv1.ParentReference, hostnames []gwv1.Hostname) {
+	route := RouteRef{Kind: kind, NamespacedName: types.NamespacedName{Namespace: meta.Namespace, Name: meta.Name}}
+	for i, ref := range parentRefs {
+		if ref.Group == nil || *ref.Group != enterprisesolo.GroupName || ref.Kind == nil || *ref.Kind != "EnterpriseListenerSet" {
+			continue
+		}
+		key := types.NamespacedName{Namespace: meta.Namespace, Name: string(ref.Name)}
+		if ref.Namespace != nil {
+			key.Namespace = string(*ref.Namespace)
+		}
+		base := Attachment{Route: route, ParentRef: i, ListenerSet: key}
+
+		set, ok := a.sets[key]
+		if !ok {
+			a.reject(base, RejectionNoMatchingParent, fmt.Sprintf("EnterpriseListenerSet %s does not exist", key))
+			continue
+		}
+		selected := 0
+		for _, entry := range set.Spec.Listeners {
+			l := enterprisesolo.ToListener(entry)
+			if ref.SectionName != nil && *ref.SectionName != l.Name {
+				continue
+			}
+			if ref.Port != nil && *ref.Port != l.Port {
+				continue
+			}
+			selected++
+			attachment := base
+			attachment.Listener = l.Name
+			attachment.Rejection, attachment.Message = a.listenerRejection(route, set, l, hostnames)
+			a.attachments = append(a.attachments, attachment)
+		}
+		if selected == 0 {
+			msg := fmt.Sprintf("EnterpriseListenerSet %s has no listeners", key)
+			switch {
+			case ref.SectionName != nil && ref.Port != nil:
+				msg = fmt.Sprintf("EnterpriseListenerSet %s has no listener %s on port %d", key, *ref.SectionName, *ref.Port)
+			case ref.SectionName != nil:
+				msg = fmt.Sprintf("EnterpriseListenerSet %s has no listener %s", key, *ref.SectionName)
+			case ref.Port != nil:
+				msg = fmt.Sprintf("EnterpriseListenerSet %s has no listener on port %d", key, *ref.Port)
+			}
+			a.reject(base, RejectionNoMatchingParent, msg)
+		}
+	}
+}
+
+func (a *attacher) reject(attachment Attachment, rejection Rejection, msg string) {
+	attachment.Rejection, attachment.Message = rejection, msg
+	a.attachments = append(a.attachments, attachment)
+}
+
+// listenerRejection returns why the route does not attach to listener l of set, or empty.
+func (a *attacher) listenerRejection(route RouteRef, set *enterprisesolo.EnterpriseListenerSet, l gwv1.Listener, hostnames []gwv1.Hostname) (Rejection, string) {
+	if ok, msg := a.namespaceAllowed(route.Namespace, set, &l); !ok {
+		return RejectionNamespaceNotAllowed, msg
+	}
+
+	kinds, _ := supportedKinds(&l)
+	allowed := false
+	names := make([]string, 0, len(kinds))
+	for _, k := range kinds {
+		allowed = allowed || k.Kind == route.Kind
+		names = append(names, string(k.Kind))
+	}
+	if !allowed {
+		return RejectionKindNotAllowed, fmt.Sprintf("Listener %s allows kinds [%s], not %s", l.Name, strings.Join(names, ", "), route.Kind)
+	}
+
+	if route.Kind == "TCPRoute" || l.Hostname == nil || *l.Hostname == "" || len(hostnames) == 0 {
+		return "", ""
+	}
+	for _, h := range hostnames {
+		if hostnamesIntersect(string(*l.Hostname), string(h)) {
+			return "", ""
+		}
+	}
+	return RejectionHostnameMismatch, fmt.Sprintf("No hostname of the route matches the hostname %s of listener %s", *l.Hostname, l.Name)
+}
+
+// namespaceAllowed reports whether the allowedRoutes of listener l of set allow routes from
+// namespace, with a message explaining why not.
+func (a *attacher) namespaceAllowed(namespace string, set *enterprisesolo.EnterpriseListenerSet, l *gwv1.Listener) (bool, string) {
+	from := gwv1.NamespacesFromSame
+	var selector *metav1.LabelSelector
+	if l.AllowedRoutes != nil && l.AllowedRoutes.Namespaces != nil {
+		if l.AllowedRoutes.Namespaces.From != nil {
+			from = *l.AllowedRoutes.Namespaces.From
+		}
+		selector = l.AllowedRoutes.Namespaces.Selector
+	}
+	switch from {
+	case gwv1.NamespacesFromAll:
+		return true, ""
+	case gwv1.NamespacesFromSame:
+		if namespace == set.Namespace {
+			return true, ""
+		}
+		return false, fmt.Sprintf("Listener %s only allows routes from namespace %s", l.Name, set.Namespace)
+	case gwv1.NamespacesFromSelector:
+		if selector == nil {
+			return false, fmt.Sprintf("Listener %s allows routes from namespaces selected by labels but has no selector", l.Name)
+		}
+		s, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return false, fmt.Sprintf("Listener %s has an invalid namespace selector: %v", l.Name, err)
+		}
+		if s.Matches(a.namespaces[namespace]) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("The labels of namespace %s do not match the namespace selector %s of listener %s", namespace, s, l.Name)
+	default:
+		return false, fmt.Sprintf("Listener %s does not allow routes", l.Name)
+	}
+}
+
+// hostnamesIntersect reports whether hostnames a and b, either of which may be a wildcard
+// such as "*.example.com", match a common hostname.
+func hostnamesIntersect(a, b string) bool {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	return a == b || wildcardMatches(a, b) || wildcardMatches(b, a)
+}
+
+// wildcardMatches reports whether wildcard hostname w matches hostname h, which matches
+// when it has at least one more label than the suffix of w.
+func wildcardMatches(w, h string) bool {
+	if !strings.HasPrefix(w, "*.") {
+		return false
+	}
+	suffix := w[1:]
+	return strings.HasSuffix(h, suffix) && len(h) > len(suffix)
+}
@@ -0,0 +1,272 @@
+package listenerset
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+)
+
+func listenerSetRef(namespace, name, sectionName string, port gwv1.PortNumber) gwv1.ParentReference {
+	ref := gwv1.ParentReference{
+		Group: ptr.To(gwv1.Group(enterprisesolo.GroupName)),
+		Kind:  ptr.To(gwv1.Kind("EnterpriseListenerSet")),
+		Name:  gwv1.ObjectName(name),
+	}
+	if namespace != "" {
+		ref.Namespace = ptr.To(gwv1.Namespace(namespace))
+	}
+	if sectionName != "" {
+		ref.SectionName = ptr.To(gwv1.SectionName(sectionName))
+	}
+	if port != 0 {
+		ref.Port = ptr.To(port)
+	}
+	return ref
+}
+
+func httpRoute(namespace, name string, hostnames []gwv1.Hostname, refs ...gwv1.ParentReference) *gwv1.HTTPRoute {
+	return &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: gwv1.HTTPRouteSpec{
+			CommonRouteSpec: gwv1.CommonRouteSpec{ParentRefs: refs},
+			Hostnames:       hostnames,
+		},
+	}
+}
+
+func tcpRoute(namespace, name string, refs ...gwv1.ParentReference) *gwv1a2.TCPRoute {
+	return &gwv1a2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       gwv1a2.TCPRouteSpec{CommonRouteSpec: gwv1.CommonRouteSpec{ParentRefs: refs}},
+	}
+}
+
+// attachments describes attachments as "route listenerSet/listener" followed by the
+// rejection, if any.
+func attachments(as Attachments) []string {
+	var out []string
+	for _, a := range as {
+		s := fmt.Sprintf("%s %s/%s", a.Route, a.ListenerSet, a.Listener)
+		if !a.Attached() {
+			s += ": " + string(a.Rejection)
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+func TestAttachRoutes(t *testing.T) {
+	// The listener set apps/web has an HTTP listener allowing routes from its namespace,
+	// an HTTPS listener allowing routes from all namespaces, and a TCP listener allowing
+	// routes from the namespaces labeled env=prod.
+	https := entry("https", gwv1.HTTPSProtocolType, 443, "*.example.com")
+	https.AllowedRoutes = &enterprisesolo.AllowedRoutes{Namespaces: &enterprisesolo.RouteNamespaces{From: ptr.To(gwv1.NamespacesFromAll)}}
+	tcp := entry("tcp", gwv1.TCPProtocolType, 9000, "tcp.example.com")
+	tcp.AllowedRoutes = &enterprisesolo.AllowedRoutes{Namespaces: &enterprisesolo.RouteNamespaces{
+		From:     ptr.To(gwv1.NamespacesFromSelector),
+		Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+	}}
+	sets := []*enterprisesolo.EnterpriseListenerSet{
+		testListenerSet("apps", "web", 0, entry("http", gwv1.HTTPProtocolType, 80, ""), https, tcp),
+	}
+	namespaces := []*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "apps"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"env": "prod"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "dev", Labels: map[string]string{"env": "dev"}}},
+	}
+
+	tests := []struct {
+		name   string
+		routes Routes
+		want   []string
+	}{
+		{
+			name:   "sectionName",
+			routes: Routes{HTTPRoutes: []*gwv1.HTTPRoute{httpRoute("apps", "r", nil, listenerSetRef("", "web", "http", 0))}},
+			want:   []string{"HTTPRoute apps/r apps/web/http"},
+		},
+		{
+			name:   "port",
+			routes: Routes{HTTPRoutes: []*gwv1.HTTPRoute{httpRoute("apps", "r", nil, listenerSetRef("", "web", "", 443))}},
+			want:   []string{"HTTPRoute apps/r apps/web/https"},
+		},
+		{
+			name:   "all listeners",
+			routes: Routes{HTTPRoutes: []*gwv1.HTTPRoute{httpRoute("apps", "r", nil, listenerSetRef("", "web", "", 0))}},
+			want: []string{
+				"HTTPRoute apps/r apps/web/http",
+				"HTTPRoute apps/r apps/web/https",
+				"HTTPRoute apps/r apps/web/tcp: NamespaceNotAllowed",
+			},
+		},
+		{
+			name:   "no listener on port",
+			routes: Routes{HTTPRoutes: []*gwv1.HTTPRoute{httpRoute("apps", "r", nil, listenerSetRef("", "web", "http", 443))}},
+			want:   []string{"HTTPRoute apps/r apps/web/: NoMatchingParent"},
+		},
+		{
+			name:   "missing listener set",
+			routes: Routes{HTTPRoutes: []*gwv1.HTTPRoute{httpRoute("apps", "r", nil, listenerSetRef("", "api", "", 0))}},
+			want:   []string{"HTTPRoute apps/r apps/api/: NoMatchingParent"},
+		},
+		{
+			name: "namespace Same",
+			routes: Routes{HTTPRoutes: []*gwv1.HTTPRoute{
+				httpRoute("prod", "r", nil, listenerSetRef("apps", "web", "http", 0)),
+			}},
+			want: []string{"HTTPRoute prod/r apps/web/http: NamespaceNotAllowed"},
+		},
+		{
+			name: "namespace All",
+			routes: Routes{HTTPRoutes: []*gwv1.HTTPRoute{
+				httpRoute("dev", "r", nil, listenerSetRef("apps", "web", "https", 0)),
+			}},
+			want: []string{"HTTPRoute dev/r apps/web/https"},
+		},
+		{
+			name: "namespace selector",
+			routes: Routes{TCPRoutes: []*gwv1a2.TCPRoute{
+				tcpRoute("prod", "r", listenerSetRef("apps", "web", "tcp", 0)),
+				tcpRoute("dev", "r", listenerSetRef("apps", "web", "tcp", 0)),
+			}},
+			want: []string{
+				"TCPRoute prod/r apps/web/tcp",
+				"TCPRoute dev/r apps/web/tcp: NamespaceNotAllowed",
+			},
+		},
+		{
+			name: "kind not allowed",
+			routes: Routes{
+				HTTPRoutes: []*gwv1.HTTPRoute{httpRoute("prod", "r", nil, listenerSetRef("apps", "web", "tcp", 0))},
+				TLSRoutes: []*gwv1.TLSRoute{{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "apps", Name: "r"},
+					Spec:       gwv1.TLSRouteSpec{CommonRouteSpec: gwv1.CommonRouteSpec{ParentRefs: []gwv1.ParentReference{listenerSetRef("", "web", "https", 0)}}},
+				}},
+			},
+			want: []string{
+				"HTTPRoute prod/r apps/web/tcp: KindNotAllowed",
+				"TLSRoute apps/r apps/web/https: KindNotAllowed",
+			},
+		},
+		{
+			name: "hostnames",
+			routes: Routes{HTTPRoutes: []*gwv1.HTTPRoute{
+				httpRoute("apps", "exact", []gwv1.Hostname{"other.test", "www.example.com"}, listenerSetRef("", "web", "https", 0)),
+				httpRoute("apps", "wildcard", []gwv1.Hostname{"*.example.com"}, listenerSetRef("", "web", "https", 0)),
+				httpRoute("apps", "apex", []gwv1.Hostname{"example.com"}, listenerSetRef("", "web", "https", 0)),
+			}},
+			want: []string{
+				"HTTPRoute apps/exact apps/web/https",
+				"HTTPRoute apps/wildcard apps/web/https",
+				"HTTPRoute apps/apex apps/web/https: HostnameMismatch",
+			},
+		},
+		{
+			name:   "hostnames of TCPRoutes not matched",
+			routes: Routes{TCPRoutes: []*gwv1a2.TCPRoute{tcpRoute("prod", "r", listenerSetRef("apps", "web", "tcp", 0))}},
+			want:   []string{"TCPRoute prod/r apps/web/tcp"},
+		},
+		{
+			name: "GRPCRoute",
+			routes: Routes{GRPCRoutes: []*gwv1.GRPCRoute{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "apps", Name: "r"},
+				Spec:       gwv1.GRPCRouteSpec{CommonRouteSpec: gwv1.CommonRouteSpec{ParentRefs: []gwv1.ParentReference{listenerSetRef("", "web", "http", 0)}}},
+			}}},
+			want: []string{"GRPCRoute apps/r apps/web/http"},
+		},
+		{
+			name:   "Gateway parentRefs ignored",
+			routes: Routes{HTTPRoutes: []*gwv1.HTTPRoute{httpRoute("apps", "r", nil, gwv1.ParentReference{Name: "web"})}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := attachments(AttachRoutes(sets, namespaces, tt.routes))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AttachRoutes() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAttachmentsAttachedRoutes(t *testing.T) {
+	sets := []*enterprisesolo.EnterpriseListenerSet{
+		testListenerSet("apps", "web", 0, entry("http", gwv1.HTTPProtocolType, 80, ""), entry("alt", gwv1.HTTPProtocolType, 8080, "")),
+	}
+	as := AttachRoutes(sets, nil, Routes{HTTPRoutes: []*gwv1.HTTPRoute{
+		// a attaches to http through both its parentRefs, and is counted once.
+		httpRoute("apps", "a", nil, listenerSetRef("", "web", "http", 0), listenerSetRef("", "web", "", 80)),
+		httpRoute("apps", "b", nil, listenerSetRef("", "web", "", 0)),
+		httpRoute("other", "c", nil, listenerSetRef("apps", "web", "http", 0)),
+	}})
+	web := types.NamespacedName{Namespace: "apps", Name: "web"}
+
+	tests := []struct {
+		listenerSet types.NamespacedName
+		listener    gwv1.SectionName
+		want        int32
+	}{
+		{web, "http", 2},
+		{web, "alt", 1},
+		{web, "missing", 0},
+		{types.NamespacedName{Namespace: "other", Name: "web"}, "http", 0},
+	}
+	for _, tt := range tests {
+		if got := as.AttachedRoutes(tt.listenerSet, tt.listener); got != tt.want {
+			t.Errorf("AttachedRoutes(%s, %s) = %d, want %d", tt.listenerSet, tt.listener, got, tt.want)
+		}
+	}
+	want := []string{"HTTPRoute other/c apps/web/http: NamespaceNotAllowed"}
+	if got := attachments(as.Rejected()); !reflect.DeepEqual(got, want) {
+		t.Errorf("Rejected() = %q, want %q", got, want)
+	}
+}
+
+func TestRouteConditionReason(t *testing.T) {
+	tests := []struct {
+		rejection Rejection
+		want      gwv1.RouteConditionReason
+	}{
+		{"", gwv1.RouteReasonAccepted},
+		{RejectionNoMatchingParent, gwv1.RouteReasonNoMatchingParent},
+		{RejectionNamespaceNotAllowed, gwv1.RouteReasonNotAllowedByListeners},
+		{RejectionKindNotAllowed, gwv1.RouteReasonNotAllowedByListeners},
+		{RejectionHostnameMismatch, gwv1.RouteReasonNoMatchingListenerHostname},
+	}
+	for _, tt := range tests {
+		if got := tt.rejection.RouteConditionReason(); got != tt.want {
+			t.Errorf("Rejection(%q).RouteConditionReason() = %s, want %s", tt.rejection, got, tt.want)
+		}
+	}
+}
+
+func TestHostnamesIntersect(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"example.com", "example.com", true},
+		{"Example.COM", "example.com", true},
+		{"*.example.com", "www.example.com", true},
+		{"www.example.com", "*.example.com", true},
+		{"*.example.com", "a.b.example.com", true},
+		{"*.example.com", "*.example.com", true},
+		{"*.example.com", "example.com", false},
+		{"*.example.com", "wwwexample.com", false},
+		{"www.example.com", "api.example.com", false},
+	}
+	for _, tt := range tests {
+		if got := hostnamesIntersect(tt.a, tt.b); got != tt.want {
+			t.Errorf("hostnamesIntersect(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
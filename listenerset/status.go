@@ -0,0 +1,210 @@
+package listenerset
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+)
+
+// ListenerEntryReasonNoConflicts is the reason of the Conflicted condition of listeners
+// that do not conflict.
+const ListenerEntryReasonNoConflicts = "NoConflicts"
+
+// routeKinds are the route kinds supported by each protocol, the first ones being the
+// default kinds of listeners that do not list kinds.
+var routeKinds = map[gwv1.ProtocolType][]gwv1.Kind{
+	gwv1.HTTPProtocolType:  {"HTTPRoute", "GRPCRoute"},
+	gwv1.HTTPSProtocolType: {"HTTPRoute", "GRPCRoute"},
+	gwv1.TLSProtocolType:   {"TLSRoute"},
+	gwv1.TCPProtocolType:   {"TCPRoute"},
+	gwv1.UDPProtocolType:   {"UDPRoute"},
+}
+
+func (m *merger) condition(set *enterprisesolo.EnterpriseListenerSet, conditionType string, status bool, reason, message string) metav1.Condition {
+	c := metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: set.Generation,
+		LastTransitionTime: m.opts.Now,
+		Reason:             reason,
+		Message:            message,
+	}
+	if status {
+		c.Status = metav1.ConditionTrue
+	}
+	return c
+}
+
+// status returns the status of an attached listener set, whose listeners are in listeners.
+func (m *merger) status(set *enterprisesolo.EnterpriseListenerSet, listeners []Listener, invalid, unassigned map[int]bool) *enterprisesolo.EnterpriseListenerSetStatus {
+	key := types.NamespacedName{Namespace: set.Namespace, Name: set.Name}
+	status := &enterprisesolo.EnterpriseListenerSetStatus{}
+	programmed := 0
+	for i := range listeners {
+		l := &listeners[i]
+		if l.ListenerSet == nil || *l.ListenerSet != key {
+			continue
+		}
+		entry := m.listenerStatus(set, l, invalid[i], unassigned[i])
+		if l.Programmed() && m.resolved(set, l) {
+			programmed++
+		}
+		status.Listeners = append(status.Listeners, entry)
+	}
+
+	accepted := m.condition(set, string(gwv1.ListenerSetConditionAccepted), true, string(gwv1.ListenerSetReasonAccepted), "The listener set is attached")
+	prog := m.condition(set, string(gwv1.ListenerSetConditionProgrammed), true, string(gwv1.ListenerSetReasonProgrammed), "The listener set is programmed")
+	switch {
+	case programmed == 0:
+		msg := "No listener of the listener set is valid"
+		accepted = m.condition(set, string(gwv1.ListenerSetConditionAccepted), false, string(gwv1.ListenerSetReasonListenersNotValid), msg)
+		prog = m.condition(set, string(gwv1.ListenerSetConditionProgrammed), false, string(gwv1.ListenerSetReasonListenersNotValid), msg)
+	case programmed < len(status.Listeners):
+		accepted.Reason = string(gwv1.ListenerSetReasonListenersNotValid)
+		accepted.Message = fmt.Sprintf("%d of %d listeners are not valid", len(status.Listeners)-programmed, len(status.Listeners))
+	}
+	status.Conditions = []metav1.Condition{accepted, prog}
+	return status
+}
+
+func (m *merger) listenerStatus(set *enterprisesolo.EnterpriseListenerSet, l *Listener, invalid, unassigned bool) enterprisesolo.EnterpriseListenerEntryStatus {
+	entry := enterprisesolo.EnterpriseListenerEntryStatus{
+		Name: l.Name,
+		Port: enterprisesolo.PortNumber(l.Port),
+	}
+	if m.opts.AttachedRoutes != nil {
+		entry.AttachedRoutes = m.opts.AttachedRoutes(*l.ListenerSet, l.Name)
+	}
+	kinds, invalidKinds := supportedKinds(&l.Listener)
+	entry.SupportedKinds = kinds
+
+	accepted := m.condition(set, string(gwv1.ListenerEntryConditionAccepted), true, string(gwv1.ListenerEntryReasonAccepted), "The listener is accepted")
+	switch {
+	case invalid:
+		accepted = m.condition(set, string(gwv1.ListenerEntryConditionAccepted), false, string(gwv1.ListenerEntryReasonInvalid), "The listener name must be unique within the listener set")
+	case !m.supported(l.Protocol):
+		accepted = m.condition(set, string(gwv1.ListenerEntryConditionAccepted), false, string(gwv1.ListenerEntryReasonUnsupportedProtocol), fmt.Sprintf("Protocol %s is not supported", l.Protocol))
+	case unassigned:
+		accepted = m.condition(set, string(gwv1.ListenerEntryConditionAccepted), false, string(gwv1.ListenerEntryReasonPortUnavailable), "No port is available for dynamic assignment")
+	}
+
+	conflicted := m.condition(set, string(gwv1.ListenerEntryConditionConflicted), false, ListenerEntryReasonNoConflicts, "The listener does not conflict with other listeners")
+	if l.Conflicted != "" {
+		conflicted = m.condition(set, string(gwv1.ListenerEntryConditionConflicted), true, string(l.Conflicted),
+			fmt.Sprintf("The listener conflicts with %s on port %d", l.ConflictsWith, l.Port))
+	}
+
+	resolvedRefs := m.condition(set, string(gwv1.ListenerEntryConditionResolvedRefs), true, string(gwv1.ListenerEntryReasonResolvedRefs), "All references are resolved")
+	if ref, ok := m.unpermittedCertificateRef(set, l); !ok {
+		resolvedRefs = m.condition(set, string(gwv1.ListenerEntryConditionResolvedRefs), false, string(gwv1.ListenerEntryReasonRefNotPermitted),
+			fmt.Sprintf("Certificate reference to Secret %s/%s is not permitted by a ReferenceGrant", *ref.Namespace, ref.Name))
+	} else if len(invalidKinds) > 0 {
+		resolvedRefs = m.condition(set, string(gwv1.ListenerEntryConditionResolvedRefs), false, string(gwv1.ListenerEntryReasonInvalidRouteKinds),
+			fmt.Sprintf("Route kinds %v are not supported by protocol %s", invalidKinds, l.Protocol))
+	}
+
+	programmed := m.condition(set, string(gwv1.ListenerEntryConditionProgrammed), true, string(gwv1.ListenerEntryReasonProgrammed), "The listener is programmed")
+	switch {
+	case unassigned:
+		programmed = m.condition(set, string(gwv1.ListenerEntryConditionProgrammed), false, string(gwv1.ListenerEntryReasonPortUnavailable), accepted.Message)
+	case accepted.Status != metav1.ConditionTrue:
+		programmed = m.condition(set, string(gwv1.ListenerEntryConditionProgrammed), false, string(gwv1.ListenerEntryReasonInvalid), accepted.Message)
+	case conflicted.Status == metav1.ConditionTrue:
+		programmed = m.condition(set, string(gwv1.ListenerEntryConditionProgrammed), false, string(gwv1.ListenerEntryReasonInvalid), conflicted.Message)
+	case resolvedRefs.Status != metav1.ConditionTrue:
+		programmed = m.condition(set, string(gwv1.ListenerEntryConditionProgrammed), false, string(gwv1.ListenerEntryReasonInvalid), resolvedRefs.Message)
+	}
+	entry.Conditions = []metav1.Condition{accepted, conflicted, resolvedRefs, programmed}
+	return entry
+}
+
+// resolved reports whether the references of l are resolved.
+func (m *merger) resolved(set *enterprisesolo.EnterpriseListenerSet, l *Listener) bool {
+	if _, ok := m.unpermittedCertificateRef(set, l); !ok {
+		return false
+	}
+	_, invalidKinds := supportedKinds(&l.Listener)
+	return len(invalidKinds) == 0
+}
+
+// supportedKinds returns the route kinds of l supported by its protocol, and the others.
+func supportedKinds(l *gwv1.Listener) ([]gwv1.RouteGroupKind, []gwv1.Kind) {
+	kinds := routeKinds[l.Protocol]
+	if l.AllowedRoutes == nil || len(l.AllowedRoutes.Kinds) == 0 {
+		group := gwv1.Group(gwv1.GroupName)
+		supported := []gwv1.RouteGroupKind{}
+		for _, k := range kinds {
+			supported = append(supported, gwv1.RouteGroupKind{Group: &group, Kind: k})
+		}
+		return supported, nil
+	}
+	supported := []gwv1.RouteGroupKind{}
+	var invalid []gwv1.Kind
+	for _, rgk := range l.AllowedRoutes.Kinds {
+		group := gwv1.Group(gwv1.GroupName)
+		if rgk.Group != nil {
+			group = *rgk.Group
+		}
+		ok := false
+		for _, k := range kinds {
+			ok = ok || (group == gwv1.GroupName && rgk.Kind == k)
+		}
+		if ok {
+			supported = append(supported, gwv1.RouteGroupKind{Group: &group, Kind: rgk.Kind})
+		} else {
+			invalid = append(invalid, rgk.Kind)
+		}
+	}
+	return supported, invalid
+}
+
+// unpermittedCertificateRef returns the first certificate reference of l to another
+// namespace that no ReferenceGrant permits, and false, or true if there is none. The
+// ReferenceGrants must permit references from the EnterpriseListenerSet: those permitting
+// references from its Gateway do not apply.
+func (m *merger) unpermittedCertificateRef(set *enterprisesolo.EnterpriseListenerSet, l *Listener) (gwv1.SecretObjectReference, bool) {
+	if l.TLS == nil {
+		return gwv1.SecretObjectReference{}, true
+	}
+	for _, ref := range l.TLS.CertificateRefs {
+		if ref.Namespace == nil || string(*ref.Namespace) == set.Namespace {
+			continue
+		}
+		if !m.granted(set.Namespace, ref) {
+			return ref, false
+		}
+	}
+	return gwv1.SecretObjectReference{}, true
+}
+
+func (m *merger) granted(fromNamespace string, ref gwv1.SecretObjectReference) bool {
+	group, kind := gwv1.Group(""), gwv1.Kind("Secret")
+	if ref.Group != nil {
+		group = *ref.Group
+	}
+	if ref.Kind != nil {
+		kind = *ref.Kind
+	}
+	for _, grant := range m.opts.ReferenceGrants {
+		if grant.Namespace != string(*ref.Namespace) {
+			continue
+		}
+		from := false
+		for _, f := range grant.Spec.From {
+			from = from || (f.Group == enterprisesolo.GroupName && f.Kind == "EnterpriseListenerSet" && string(f.Namespace) == fromNamespace)
+		}
+		if !from {
+			continue
+		}
+		for _, t := range grant.Spec.To {
+			if t.Group == group && t.Kind == kind && (t.Name == nil || *t.Name == ref.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
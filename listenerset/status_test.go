@@ -0,0 +1,242 @@
+package listenerset
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+)
+
+func tlsEntry(name, secretNamespace string) enterprisesolo.EnterpriseListenerEntry {
+	e := entry(name, gwv1.HTTPSProtocolType, 443, name+".example.com")
+	ref := gwv1.SecretObjectReference{Name: "cert"}
+	if secretNamespace != "" {
+		ref.Namespace = ptr.To(gwv1.Namespace(secretNamespace))
+	}
+	e.TLS = &gwv1.ListenerTLSConfig{CertificateRefs: []gwv1.SecretObjectReference{ref}}
+	return e
+}
+
+func referenceGrant(fromGroup gwv1.Group, fromKind gwv1.Kind, fromNamespace string) *gwv1.ReferenceGrant {
+	return &gwv1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "certs", Name: "grant"},
+		Spec: gwv1beta1.ReferenceGrantSpec{
+			From: []gwv1beta1.ReferenceGrantFrom{{Group: fromGroup, Kind: fromKind, Namespace: gwv1.Namespace(fromNamespace)}},
+			To:   []gwv1beta1.ReferenceGrantTo{{Kind: "Secret"}},
+		},
+	}
+}
+
+func TestListenerSetStatus(t *testing.T) {
+	// listener is the expected state of a listener: the reasons of its Accepted, Conflicted
+	// and ResolvedRefs conditions, and whether it is programmed.
+	type listener struct {
+		accepted, conflicted, resolvedRefs string
+		programmed                         bool
+	}
+	ok := listener{string(gwv1.ListenerEntryReasonAccepted), ListenerEntryReasonNoConflicts, string(gwv1.ListenerEntryReasonResolvedRefs), true}
+
+	tests := []struct {
+		name       string
+		gateway    *gwv1.Gateway
+		entries    []enterprisesolo.EnterpriseListenerEntry
+		grants     []*gwv1.ReferenceGrant
+		listeners  []listener
+		accepted   metav1.ConditionStatus
+		reason     gwv1.ListenerSetConditionReason
+		programmed metav1.ConditionStatus
+	}{
+		{
+			name:       "programmed",
+			gateway:    testGateway(ptr.To(gwv1.NamespacesFromAll)),
+			entries:    []enterprisesolo.EnterpriseListenerEntry{entry("http", gwv1.HTTPProtocolType, 80, ""), tlsEntry("https", "")},
+			listeners:  []listener{ok, ok},
+			accepted:   metav1.ConditionTrue,
+			reason:     gwv1.ListenerSetReasonAccepted,
+			programmed: metav1.ConditionTrue,
+		},
+		{
+			name:    "some listeners not valid",
+			gateway: testGateway(ptr.To(gwv1.NamespacesFromAll), gatewayListener("http", gwv1.HTTPProtocolType, 80, "")),
+			entries: []enterprisesolo.EnterpriseListenerEntry{entry("http", gwv1.HTTPProtocolType, 80, ""), entry("alt", gwv1.HTTPProtocolType, 8080, "")},
+			listeners: []listener{
+				{string(gwv1.ListenerEntryReasonAccepted), string(gwv1.ListenerEntryReasonListenerConflict), string(gwv1.ListenerEntryReasonResolvedRefs), false},
+				ok,
+			},
+			accepted:   metav1.ConditionTrue,
+			reason:     gwv1.ListenerSetReasonListenersNotValid,
+			programmed: metav1.ConditionTrue,
+		},
+		{
+			name:    "no listener valid",
+			gateway: testGateway(ptr.To(gwv1.NamespacesFromAll)),
+			entries: []enterprisesolo.EnterpriseListenerEntry{entry("udp", gwv1.UDPProtocolType, 53, "")},
+			listeners: []listener{
+				{string(gwv1.ListenerEntryReasonUnsupportedProtocol), ListenerEntryReasonNoConflicts, string(gwv1.ListenerEntryReasonResolvedRefs), false},
+			},
+			accepted:   metav1.ConditionFalse,
+			reason:     gwv1.ListenerSetReasonListenersNotValid,
+			programmed: metav1.ConditionFalse,
+		},
+		{
+			name:    "duplicate name",
+			gateway: testGateway(ptr.To(gwv1.NamespacesFromAll)),
+			entries: []enterprisesolo.EnterpriseListenerEntry{entry("http", gwv1.HTTPProtocolType, 80, ""), entry("http", gwv1.HTTPProtocolType, 81, "")},
+			listeners: []listener{
+				ok,
+				{string(gwv1.ListenerEntryReasonInvalid), ListenerEntryReasonNoConflicts, string(gwv1.ListenerEntryReasonResolvedRefs), false},
+			},
+			accepted:   metav1.ConditionTrue,
+			reason:     gwv1.ListenerSetReasonListenersNotValid,
+			programmed: metav1.ConditionTrue,
+		},
+		{
+			name:    "certificate in another namespace without ReferenceGrant",
+			gateway: testGateway(ptr.To(gwv1.NamespacesFromAll)),
+			entries: []enterprisesolo.EnterpriseListenerEntry{tlsEntry("https", "certs")},
+			listeners: []listener{
+				{string(gwv1.ListenerEntryReasonAccepted), ListenerEntryReasonNoConflicts, string(gwv1.ListenerEntryReasonRefNotPermitted), false},
+			},
+			accepted:   metav1.ConditionFalse,
+			reason:     gwv1.ListenerSetReasonListenersNotValid,
+			programmed: metav1.ConditionFalse,
+		},
+		{
+			name:    "ReferenceGrant of the Gateway not inherited",
+			gateway: testGateway(ptr.To(gwv1.NamespacesFromAll)),
+			entries: []enterprisesolo.EnterpriseListenerEntry{tlsEntry("https", "certs")},
+			grants:  []*gwv1.ReferenceGrant{referenceGrant(gwv1.GroupName, "Gateway", "infra")},
+			listeners: []listener{
+				{string(gwv1.ListenerEntryReasonAccepted), ListenerEntryReasonNoConflicts, string(gwv1.ListenerEntryReasonRefNotPermitted), false},
+			},
+			accepted:   metav1.ConditionFalse,
+			reason:     gwv1.ListenerSetReasonListenersNotValid,
+			programmed: metav1.ConditionFalse,
+		},
+		{
+			name:       "ReferenceGrant of the listener set",
+			gateway:    testGateway(ptr.To(gwv1.NamespacesFromAll)),
+			entries:    []enterprisesolo.EnterpriseListenerEntry{tlsEntry("https", "certs")},
+			grants:     []*gwv1.ReferenceGrant{referenceGrant(enterprisesolo.GroupName, "EnterpriseListenerSet", "apps")},
+			listeners:  []listener{ok},
+			accepted:   metav1.ConditionTrue,
+			reason:     gwv1.ListenerSetReasonAccepted,
+			programmed: metav1.ConditionTrue,
+		},
+		{
+			name:    "route kinds not supported by the protocol",
+			gateway: testGateway(ptr.To(gwv1.NamespacesFromAll)),
+			entries: func() []enterprisesolo.EnterpriseListenerEntry {
+				e := entry("http", gwv1.HTTPProtocolType, 80, "")
+				e.AllowedRoutes = &enterprisesolo.AllowedRoutes{Kinds: []gwv1.RouteGroupKind{{Kind: "HTTPRoute"}, {Kind: "TCPRoute"}}}
+				return []enterprisesolo.EnterpriseListenerEntry{e}
+			}(),
+			listeners: []listener{
+				{string(gwv1.ListenerEntryReasonAccepted), ListenerEntryReasonNoConflicts, string(gwv1.ListenerEntryReasonInvalidRouteKinds), false},
+			},
+			accepted:   metav1.ConditionFalse,
+			reason:     gwv1.ListenerSetReasonListenersNotValid,
+			programmed: metav1.ConditionFalse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := testListenerSet("apps", "set", 0, tt.entries...)
+			key := types.NamespacedName{Namespace: "apps", Name: "set"}
+			res := Merge(tt.gateway, []*enterprisesolo.EnterpriseListenerSet{set}, Options{
+				ReferenceGrants: tt.grants,
+				AttachedRoutes: func(listenerSet types.NamespacedName, listener gwv1.SectionName) int32 {
+					if listenerSet == key && listener == "http" {
+						return 3
+					}
+					return 0
+				},
+			})
+			status := res.ListenerSets[key]
+			if status == nil {
+				t.Fatalf("no status for %s", key)
+			}
+			accepted := meta.FindStatusCondition(status.Conditions, string(gwv1.ListenerSetConditionAccepted))
+			programmed := meta.FindStatusCondition(status.Conditions, string(gwv1.ListenerSetConditionProgrammed))
+			if accepted.Status != tt.accepted || accepted.Reason != string(tt.reason) || programmed.Status != tt.programmed {
+				t.Errorf("Accepted = %s %s, Programmed = %s, want %s %s, %s", accepted.Status, accepted.Reason, programmed.Status, tt.accepted, tt.reason, tt.programmed)
+			}
+			if accepted.ObservedGeneration != set.Generation {
+				t.Errorf("ObservedGeneration = %d, want %d", accepted.ObservedGeneration, set.Generation)
+			}
+			if len(status.Listeners) != len(tt.listeners) {
+				t.Fatalf("got %d listener statuses, want %d", len(status.Listeners), len(tt.listeners))
+			}
+			for i, want := range tt.listeners {
+				l := status.Listeners[i]
+				got := listener{
+					accepted:     meta.FindStatusCondition(l.Conditions, string(gwv1.ListenerEntryConditionAccepted)).Reason,
+					conflicted:   meta.FindStatusCondition(l.Conditions, string(gwv1.ListenerEntryConditionConflicted)).Reason,
+					resolvedRefs: meta.FindStatusCondition(l.Conditions, string(gwv1.ListenerEntryConditionResolvedRefs)).Reason,
+					programmed:   meta.IsStatusConditionTrue(l.Conditions, string(gwv1.ListenerEntryConditionProgrammed)),
+				}
+				if got != want {
+					t.Errorf("listener %d (%s) = %+v, want %+v", i, l.Name, got, want)
+				}
+				wantRoutes := int32(0)
+				if l.Name == "http" {
+					wantRoutes = 3
+				}
+				if l.AttachedRoutes != wantRoutes {
+					t.Errorf("listener %d (%s) AttachedRoutes = %d, want %d", i, l.Name, l.AttachedRoutes, wantRoutes)
+				}
+			}
+		})
+	}
+}
+
+func TestSupportedKinds(t *testing.T) {
+	tests := []struct {
+		name     string
+		listener gwv1.Listener
+		want     []gwv1.Kind
+		invalid  []gwv1.Kind
+	}{
+		{"HTTP defaults", gatewayListener("a", gwv1.HTTPProtocolType, 80, ""), []gwv1.Kind{"HTTPRoute", "GRPCRoute"}, nil},
+		{"TLS defaults", gatewayListener("a", gwv1.TLSProtocolType, 443, ""), []gwv1.Kind{"TLSRoute"}, nil},
+		{
+			name: "allowed kinds",
+			listener: func() gwv1.Listener {
+				l := gatewayListener("a", gwv1.HTTPSProtocolType, 443, "")
+				other := gwv1.Group("example.com")
+				l.AllowedRoutes = &gwv1.AllowedRoutes{Kinds: []gwv1.RouteGroupKind{{Kind: "GRPCRoute"}, {Kind: "TLSRoute"}, {Group: &other, Kind: "HTTPRoute"}}}
+				return l
+			}(),
+			want:    []gwv1.Kind{"GRPCRoute"},
+			invalid: []gwv1.Kind{"TLSRoute", "HTTPRoute"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kinds, invalid := supportedKinds(&tt.listener)
+			var got []gwv1.Kind
+			for _, k := range kinds {
+				got = append(got, k.Kind)
+			}
+			if len(got) != len(tt.want) || len(invalid) != len(tt.invalid) {
+				t.Fatalf("supportedKinds() = %v, %v, want %v, %v", got, invalid, tt.want, tt.invalid)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("supportedKinds() = %v, want %v", got, tt.want)
+				}
+			}
+			for i := range invalid {
+				if invalid[i] != tt.invalid[i] {
+					t.Errorf("invalid kinds = %v, want %v", invalid, tt.invalid)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// EnterpriseKgatewayParametersLister helps list EnterpriseKgatewayParameters.
+// All objects returned here must be treated as read-only.
+type EnterpriseKgatewayParametersLister interface {
+	// List lists all EnterpriseKgatewayParameters in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1enterprisekgateway.EnterpriseKgatewayParameters, err error)
+	// EnterpriseKgatewayParameters returns an object that can list and get EnterpriseKgatewayParameters.
+	EnterpriseKgatewayParameters(namespace string) EnterpriseKgatewayParametersNamespaceLister
+	EnterpriseKgatewayParametersListerExpansion
+}
+
+// enterpriseKgatewayParametersLister implements the EnterpriseKgatewayParametersLister interface.
+type enterpriseKgatewayParametersLister struct {
+	listers.ResourceIndexer[*v1alpha1enterprisekgateway.EnterpriseKgatewayParameters]
+}
+
+// NewEnterpriseKgatewayParametersLister returns a new EnterpriseKgatewayParametersLister.
+func NewEnterpriseKgatewayParametersLister(indexer cache.Indexer) EnterpriseKgatewayParametersLister {
+	return &enterpriseKgatewayParametersLister{listers.New[*v1alpha1enterprisekgateway.EnterpriseKgatewayParameters](indexer, v1alpha1enterprisekgateway.Resource("enterprisekgatewayparameters"))}
+}
+
+// EnterpriseKgatewayParameters returns an object that can list and get EnterpriseKgatewayParameters.
+func (s *enterpriseKgatewayParametersLister) EnterpriseKgatewayParameters(namespace string) EnterpriseKgatewayParametersNamespaceLister {
+	return enterpriseKgatewayParametersNamespaceLister{listers.NewNamespaced[*v1alpha1enterprisekgateway.EnterpriseKgatewayParameters](s.ResourceIndexer, namespace)}
+}
+
+// EnterpriseKgatewayParametersNamespaceLister helps list and get EnterpriseKgatewayParameters.
+// All objects returned here must be treated as read-only.
+type EnterpriseKgatewayParametersNamespaceLister interface {
+	// List lists all EnterpriseKgatewayParameters in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1enterprisekgateway.EnterpriseKgatewayParameters, err error)
+	// Get retrieves the EnterpriseKgatewayParameters from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1enterprisekgateway.EnterpriseKgatewayParameters, error)
+	EnterpriseKgatewayParametersNamespaceListerExpansion
+}
+
+// enterpriseKgatewayParametersNamespaceLister implements the EnterpriseKgatewayParametersNamespaceLister
+// interface.
+type enterpriseKgatewayParametersNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1enterprisekgateway.EnterpriseKgatewayParameters]
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package enterprisekgateway
+
+import (
+	v1alpha1enterprisekgateway "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// EnterpriseKgatewayTrafficPolicyLister helps list EnterpriseKgatewayTrafficPolicies.
+// All objects returned here must be treated as read-only.
+type EnterpriseKgatewayTrafficPolicyLister interface {
+	// List lists all EnterpriseKgatewayTrafficPolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy, err error)
+	// EnterpriseKgatewayTrafficPolicies returns an object that can list and get EnterpriseKgatewayTrafficPolicies.
+	EnterpriseKgatewayTrafficPolicies(namespace string) EnterpriseKgatewayTrafficPolicyNamespaceLister
+	EnterpriseKgatewayTrafficPolicyListerExpansion
+}
+
+// enterpriseKgatewayTrafficPolicyLister implements the EnterpriseKgatewayTrafficPolicyLister interface.
+type enterpriseKgatewayTrafficPolicyLister struct {
+	listers.ResourceIndexer[*v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy]
+}
+
+// NewEnterpriseKgatewayTrafficPolicyLister returns a new EnterpriseKgatewayTrafficPolicyLister.
+func NewEnterpriseKgatewayTrafficPolicyLister(indexer cache.Indexer) EnterpriseKgatewayTrafficPolicyLister {
+	return &enterpriseKgatewayTrafficPolicyLister{listers.New[*v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy](indexer, v1alpha1enterprisekgateway.Resource("enterprisekgatewaytrafficpolicy"))}
+}
+
+// EnterpriseKgatewayTrafficPolicies returns an object that can list and get EnterpriseKgatewayTrafficPolicies.
+func (s *enterpriseKgatewayTrafficPolicyLister) EnterpriseKgatewayTrafficPolicies(namespace string) EnterpriseKgatewayTrafficPolicyNamespaceLister {
+	return enterpriseKgatewayTrafficPolicyNamespaceLister{listers.NewNamespaced[*v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy](s.ResourceIndexer, namespace)}
+}
+
+// EnterpriseKgatewayTrafficPolicyNamespaceLister helps list and get EnterpriseKgatewayTrafficPolicies.
+// All objects returned here must be treated as read-only.
+type EnterpriseKgatewayTrafficPolicyNamespaceLister interface {
+	// List lists all EnterpriseKgatewayTrafficPolicies in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy, err error)
+	// Get retrieves the EnterpriseKgatewayTrafficPolicy from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy, error)
+	EnterpriseKgatewayTrafficPolicyNamespaceListerExpansion
+}
+
+// enterpriseKgatewayTrafficPolicyNamespaceLister implements the EnterpriseKgatewayTrafficPolicyNamespaceLister
+// interface.
+type enterpriseKgatewayTrafficPolicyNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1enterprisekgateway.EnterpriseKgatewayTrafficPolicy]
+}
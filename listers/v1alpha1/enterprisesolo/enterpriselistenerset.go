@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package enterprisesolo
+
+import (
+	v1alpha1enterprisesolo "github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisesolo"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// EnterpriseListenerSetLister helps list EnterpriseListenerSets.
+// All objects returned here must be treated as read-only.
+type EnterpriseListenerSetLister interface {
+	// List lists all EnterpriseListenerSets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1enterprisesolo.EnterpriseListenerSet, err error)
+	// EnterpriseListenerSets returns an object that can list and get EnterpriseListenerSets.
+	EnterpriseListenerSets(namespace string) EnterpriseListenerSetNamespaceLister
+	EnterpriseListenerSetListerExpansion
+}
+
+// enterpriseListenerSetLister implements the EnterpriseListenerSetLister interface.
+type enterpriseListenerSetLister struct {
+	listers.ResourceIndexer[*v1alpha1enterprisesolo.EnterpriseListenerSet]
+}
+
+// NewEnterpriseListenerSetLister returns a new EnterpriseListenerSetLister.
+func NewEnterpriseListenerSetLister(indexer cache.Indexer) EnterpriseListenerSetLister {
+	return &enterpriseListenerSetLister{listers.New[*v1alpha1enterprisesolo.EnterpriseListenerSet](indexer, v1alpha1enterprisesolo.Resource("enterpriselistenerset"))}
+}
+
+// EnterpriseListenerSets returns an object that can list and get EnterpriseListenerSets.
+func (s *enterpriseListenerSetLister) EnterpriseListenerSets(namespace string) EnterpriseListenerSetNamespaceLister {
+	return enterpriseListenerSetNamespaceLister{listers.NewNamespaced[*v1alpha1enterprisesolo.EnterpriseListenerSet](s.ResourceIndexer, namespace)}
+}
+
+// EnterpriseListenerSetNamespaceLister helps list and get EnterpriseListenerSets.
+// All objects returned here must be treated as read-only.
+type EnterpriseListenerSetNamespaceLister interface {
+	// List lists all EnterpriseListenerSets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1enterprisesolo.EnterpriseListenerSet, err error)
+	// Get retrieves the EnterpriseListenerSet from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1enterprisesolo.EnterpriseListenerSet, error)
+	EnterpriseListenerSetNamespaceListerExpansion
+}
+
+// enterpriseListenerSetNamespaceLister implements the EnterpriseListenerSetNamespaceLister
+// interface.
+type enterpriseListenerSetNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1enterprisesolo.EnterpriseListenerSet]
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package waf
+
+import (
+	v1alpha1waf "github.com/solo-io/kgateway-client/v2/api/v1alpha1/waf"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// WAFPolicyLister helps list WAFPolicies.
+// All objects returned here must be treated as read-only.
+type WAFPolicyLister interface {
+	// List lists all WAFPolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1waf.WAFPolicy, err error)
+	// WAFPolicies returns an object that can list and get WAFPolicies.
+	WAFPolicies(namespace string) WAFPolicyNamespaceLister
+	WAFPolicyListerExpansion
+}
+
+// wAFPolicyLister implements the WAFPolicyLister interface.
+type wAFPolicyLister struct {
+	listers.ResourceIndexer[*v1alpha1waf.WAFPolicy]
+}
+
+// NewWAFPolicyLister returns a new WAFPolicyLister.
+func NewWAFPolicyLister(indexer cache.Indexer) WAFPolicyLister {
+	return &wAFPolicyLister{listers.New[*v1alpha1waf.WAFPolicy](indexer, v1alpha1waf.Resource("wafpolicy"))}
+}
+
+// WAFPolicies returns an object that can list and get WAFPolicies.
+func (s *wAFPolicyLister) WAFPolicies(namespace string) WAFPolicyNamespaceLister {
+	return wAFPolicyNamespaceLister{listers.NewNamespaced[*v1alpha1waf.WAFPolicy](s.ResourceIndexer, namespace)}
+}
+
+// WAFPolicyNamespaceLister helps list and get WAFPolicies.
+// All objects returned here must be treated as read-only.
+type WAFPolicyNamespaceLister interface {
+	// List lists all WAFPolicies in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1waf.WAFPolicy, err error)
+	// Get retrieves the WAFPolicy from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1waf.WAFPolicy, error)
+	WAFPolicyNamespaceListerExpansion
+}
+
+// wAFPolicyNamespaceLister implements the WAFPolicyNamespaceLister
+// interface.
+type wAFPolicyNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1waf.WAFPolicy]
+}
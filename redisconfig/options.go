@@ -0,0 +1,220 @@
+// Package redisconfig converts the RedisClientConfig of EnterpriseKgatewayParameters, used
+// by the ext-auth session store and the rate limiter, into go-redis client options, and
+// checks that a Redis server accepts them.
+//
+// Secrets referenced by the configuration are read through a Secret lister, so that the
+// credentials and CA certificates used are those of the cluster:
+//
+//	r := &redisconfig.Resolver{Secrets: secretLister}
+//	opts, err := r.UniversalOptions(params.Spec.Kube.SharedExtensions.RateLimiter.Redis,
+//		"kgateway-system", field.NewPath("spec", "kube", "sharedExtensions", "ratelimiter", "redis"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := redisconfig.Ping(ctx, opts); err != nil {
+//		return err
+//	}
+package redisconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// Socket types of RedisClientConfig.
+const (
+	SocketTypeTCP  = "tcp"
+	SocketTypeTLS  = "tls"
+	SocketTypeUnix = "unix"
+)
+
+// AWSCredentialsFunc returns the username and IAM authentication token used to connect to
+// the ElastiCache cluster of auth. It is called for each new connection.
+type AWSCredentialsFunc func(ctx context.Context, auth *enterprisekgateway.RedisAWSAuth) (username, token string, err error)
+
+// Resolver resolves the Secrets and credentials referenced by Redis configurations.
+type Resolver struct {
+	// Secrets lists the Secrets holding credentials and CA certificates.
+	Secrets corev1listers.SecretLister
+	// AWSCredentials generates the tokens of AWS IAM authentication. Configurations using
+	// AWS authentication are rejected when it is nil.
+	AWSCredentials AWSCredentialsFunc
+}
+
+// UniversalOptions returns the go-redis options of cfg for an extension deployed in
+// namespace, the default namespace of the referenced Secrets. Errors are reported with
+// field paths under fldPath.
+func (r *Resolver) UniversalOptions(cfg *enterprisekgateway.RedisClientConfig, namespace string, fldPath *field.Path) (*redis.UniversalOptions, error) {
+	var errs field.ErrorList
+	opts := &redis.UniversalOptions{
+		Addrs:         []string{cfg.Address},
+		DB:            int(ptr.Deref(cfg.DB, 0)),
+		IsClusterMode: ptr.Deref(cfg.Clustered, false),
+	}
+	if cfg.Address == "" {
+		errs = append(errs, field.Required(fldPath.Child("address"), ""))
+	}
+
+	socketType := ptr.Deref(cfg.SocketType, SocketTypeTCP)
+	switch socketType {
+	case SocketTypeTCP:
+	case SocketTypeTLS:
+		tlsConfig, err := r.tlsConfig(cfg, namespace, fldPath)
+		errs = append(errs, err...)
+		opts.TLSConfig = tlsConfig
+	case SocketTypeUnix:
+		var d net.Dialer
+		opts.Dialer = func(ctx context.Context, _, addr string) (net.Conn, error) {
+			return d.DialContext(ctx, "unix", addr)
+		}
+	default:
+		errs = append(errs, field.NotSupported(fldPath.Child("socketType"), socketType, []string{SocketTypeTCP, SocketTypeTLS, SocketTypeUnix}))
+	}
+	if cfg.Certs != nil && socketType != SocketTypeTLS {
+		errs = append(errs, field.Invalid(fldPath.Child("certs"), "", "certs can only be set when socketType is 'tls'"))
+	}
+
+	if auth := cfg.Auth; auth != nil {
+		authPath := fldPath.Child("auth")
+		switch {
+		case auth.SecretRef != nil && auth.AWS != nil:
+			errs = append(errs, field.Invalid(authPath, "", "exactly one of secretRef or aws must be set"))
+		case auth.SecretRef != nil:
+			username, password, err := r.secretCredentials(auth.SecretRef, namespace, authPath.Child("secretRef"))
+			errs = append(errs, err...)
+			opts.Username, opts.Password = username, password
+		case auth.AWS != nil:
+			if r.AWSCredentials == nil {
+				errs = append(errs, field.Invalid(authPath.Child("aws"), "", "AWS IAM authentication requires an AWS credentials provider"))
+				break
+			}
+			aws, credentials := auth.AWS.DeepCopy(), r.AWSCredentials
+			opts.CredentialsProviderContext = func(ctx context.Context) (string, string, error) {
+				return credentials(ctx, aws)
+			}
+		default:
+			errs = append(errs, field.Invalid(authPath, "", "exactly one of secretRef or aws must be set"))
+		}
+	}
+
+	if c := cfg.Connection; c != nil {
+		for _, v := range []struct {
+			dst *int
+			src *int32
+		}{
+			{&opts.PoolSize, c.PoolSize},
+			{&opts.MinIdleConns, c.MinIdleConns},
+			{&opts.MaxIdleConns, c.MaxIdleConns},
+			{&opts.MaxRetries, c.MaxRetries},
+		} {
+			if v.src != nil {
+				*v.dst = int(*v.src)
+			}
+		}
+		if c.MaxRetries != nil && *c.MaxRetries == 0 {
+			// go-redis uses 0 for its default and -1 to disable retries.
+			opts.MaxRetries = -1
+		}
+		opts.DialTimeout = durationOrZero(c.DialTimeout)
+		opts.ReadTimeout = durationOrZero(c.ReadTimeout)
+		opts.WriteTimeout = durationOrZero(c.WriteTimeout)
+		opts.PoolTimeout = durationOrZero(c.PoolTimeout)
+		opts.ConnMaxIdleTime = durationOrZero(c.ConnMaxIdleTime)
+		opts.ConnMaxLifetime = durationOrZero(c.ConnMaxLifetime)
+		opts.MinRetryBackoff = durationOrZero(c.MinRetryBackoff)
+		opts.MaxRetryBackoff = durationOrZero(c.MaxRetryBackoff)
+	}
+
+	if len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	return opts, nil
+}
+
+// Ping connects to the Redis server of opts and checks that it answers a PING command,
+// which also checks the credentials and TLS settings.
+func Ping(ctx context.Context, opts *redis.UniversalOptions) error {
+	client := redis.NewUniversalClient(opts)
+	defer client.Close()
+	if err := client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("ping Redis at %v: %w", opts.Addrs, err)
+	}
+	return nil
+}
+
+func (r *Resolver) tlsConfig(cfg *enterprisekgateway.RedisClientConfig, namespace string, fldPath *field.Path) (*tls.Config, field.ErrorList) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if host, _, err := net.SplitHostPort(cfg.Address); err == nil {
+		tlsConfig.ServerName = host
+	}
+	if cfg.Certs == nil || cfg.Certs.CACertSecretRef == nil {
+		// The system roots are used.
+		return tlsConfig, nil
+	}
+	ref := cfg.Certs.CACertSecretRef
+	refPath := fldPath.Child("certs", "caCertSecretRef")
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+	key := ptr.Deref(cfg.Certs.CACertKey, enterprisekgateway.DefaultRedisCACertKey)
+	data, errs := r.secretValue(namespace, ref.Name, key, true, refPath)
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, field.ErrorList{field.Invalid(fldPath.Child("certs", "caCertKey"), key,
+			fmt.Sprintf("Secret %s/%s does not hold a PEM encoded certificate under this key", namespace, ref.Name))}
+	}
+	tlsConfig.RootCAs = pool
+	return tlsConfig, nil
+}
+
+func (r *Resolver) secretCredentials(ref *enterprisekgateway.RedisSecretAuth, namespace string, fldPath *field.Path) (string, string, field.ErrorList) {
+	namespace = ptr.Deref(ref.Namespace, namespace)
+	// Servers without ACLs only use a password, so the username is optional.
+	username, errs := r.secretValue(namespace, ref.Name, ptr.Deref(ref.UsernameKey, enterprisekgateway.DefaultRedisUsernameKey), false, fldPath)
+	if len(errs) > 0 {
+		return "", "", errs
+	}
+	password, errs := r.secretValue(namespace, ref.Name, ptr.Deref(ref.PasswordKey, enterprisekgateway.DefaultRedisPasswordKey), true, fldPath)
+	return string(username), string(password), errs
+}
+
+func (r *Resolver) secretValue(namespace, name, key string, required bool, fldPath *field.Path) ([]byte, field.ErrorList) {
+	if r.Secrets == nil {
+		return nil, field.ErrorList{field.InternalError(fldPath, fmt.Errorf("no Secret lister to read Secret %s/%s", namespace, name))}
+	}
+	secret, err := r.Secrets.Secrets(namespace).Get(name)
+	if k8serrors.IsNotFound(err) {
+		return nil, field.ErrorList{field.NotFound(fldPath.Child("name"), namespace+"/"+name)}
+	}
+	if err != nil {
+		return nil, field.ErrorList{field.InternalError(fldPath, err)}
+	}
+	data, ok := secret.Data[key]
+	if !ok && required {
+		return nil, field.ErrorList{field.Invalid(fldPath, name, fmt.Sprintf("Secret %s/%s has no key %q", namespace, name, key))}
+	}
+	return data, nil
+}
+
+func durationOrZero(d *metav1.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+	return d.Duration
+}
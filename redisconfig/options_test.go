@@ -0,0 +1,302 @@
+package redisconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+func secretLister(t *testing.T, secrets ...*corev1.Secret) corev1listers.SecretLister {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, s := range secrets {
+		if err := indexer.Add(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return corev1listers.NewSecretLister(indexer)
+}
+
+func secret(namespace, name string, data map[string]string) *corev1.Secret {
+	s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}, Data: map[string][]byte{}}
+	for k, v := range data {
+		s.Data[k] = []byte(v)
+	}
+	return s
+}
+
+func caPEM(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "redis-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+var fldPath = field.NewPath("redis")
+
+func TestUniversalOptionsTLS(t *testing.T) {
+	r := &Resolver{Secrets: secretLister(t,
+		secret("apps", "redis-ca", map[string]string{"ca.crt": caPEM(t), "other.crt": "not a certificate"}),
+	)}
+	tests := []struct {
+		name    string
+		cfg     *enterprisekgateway.RedisClientConfig
+		roots   bool
+		wantErr string
+	}{
+		{
+			name: "system roots",
+			cfg:  &enterprisekgateway.RedisClientConfig{Address: "redis.example.com:6380", SocketType: ptr.To(SocketTypeTLS)},
+		},
+		{
+			name: "CA certificate under the default key",
+			cfg: &enterprisekgateway.RedisClientConfig{
+				Address:    "redis.example.com:6380",
+				SocketType: ptr.To(SocketTypeTLS),
+				Certs:      &enterprisekgateway.RedisCerts{CACertSecretRef: &corev1.SecretReference{Name: "redis-ca"}},
+			},
+			roots: true,
+		},
+		{
+			name: "key without a certificate",
+			cfg: &enterprisekgateway.RedisClientConfig{
+				Address:    "redis.example.com:6380",
+				SocketType: ptr.To(SocketTypeTLS),
+				Certs:      &enterprisekgateway.RedisCerts{CACertSecretRef: &corev1.SecretReference{Name: "redis-ca"}, CACertKey: ptr.To("other.crt")},
+			},
+			wantErr: `redis.certs.caCertKey: Invalid value: "other.crt": Secret apps/redis-ca does not hold a PEM encoded certificate under this key`,
+		},
+		{
+			name: "missing key",
+			cfg: &enterprisekgateway.RedisClientConfig{
+				Address:    "redis.example.com:6380",
+				SocketType: ptr.To(SocketTypeTLS),
+				Certs:      &enterprisekgateway.RedisCerts{CACertSecretRef: &corev1.SecretReference{Name: "redis-ca"}, CACertKey: ptr.To("tls.crt")},
+			},
+			wantErr: `redis.certs.caCertSecretRef: Invalid value: "redis-ca": Secret apps/redis-ca has no key "tls.crt"`,
+		},
+		{
+			name: "Secret in another namespace",
+			cfg: &enterprisekgateway.RedisClientConfig{
+				Address:    "redis.example.com:6380",
+				SocketType: ptr.To(SocketTypeTLS),
+				Certs:      &enterprisekgateway.RedisCerts{CACertSecretRef: &corev1.SecretReference{Name: "redis-ca", Namespace: "infra"}},
+			},
+			wantErr: `redis.certs.caCertSecretRef.name: Not found: "infra/redis-ca"`,
+		},
+		{
+			name: "certs without TLS",
+			cfg: &enterprisekgateway.RedisClientConfig{
+				Address: "redis.example.com:6379",
+				Certs:   &enterprisekgateway.RedisCerts{CACertSecretRef: &corev1.SecretReference{Name: "redis-ca"}},
+			},
+			wantErr: `redis.certs: Invalid value: "": certs can only be set when socketType is 'tls'`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := r.UniversalOptions(tt.cfg, "apps", fldPath)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("UniversalOptions() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if opts.TLSConfig == nil || opts.TLSConfig.ServerName != "redis.example.com" || opts.TLSConfig.MinVersion != tls.VersionTLS12 {
+				t.Fatalf("TLSConfig = %+v, want TLS 1.2 for redis.example.com", opts.TLSConfig)
+			}
+			if got := opts.TLSConfig.RootCAs != nil; got != tt.roots {
+				t.Errorf("RootCAs set = %v, want %v", got, tt.roots)
+			}
+		})
+	}
+}
+
+func TestUniversalOptionsAuth(t *testing.T) {
+	r := &Resolver{Secrets: secretLister(t,
+		secret("apps", "redis", map[string]string{"username": "app", "password": "secret"}),
+		secret("apps", "password-only", map[string]string{"pass": "secret"}),
+		secret("infra", "redis", map[string]string{"password": "infra-secret"}),
+	)}
+	tests := []struct {
+		name         string
+		auth         *enterprisekgateway.RedisAuth
+		wantUsername string
+		wantPassword string
+		wantErr      string
+	}{
+		{
+			name:         "default keys",
+			auth:         &enterprisekgateway.RedisAuth{SecretRef: &enterprisekgateway.RedisSecretAuth{Name: "redis"}},
+			wantUsername: "app",
+			wantPassword: "secret",
+		},
+		{
+			name:         "password without username",
+			auth:         &enterprisekgateway.RedisAuth{SecretRef: &enterprisekgateway.RedisSecretAuth{Name: "password-only", PasswordKey: ptr.To("pass")}},
+			wantPassword: "secret",
+		},
+		{
+			name:         "Secret in another namespace",
+			auth:         &enterprisekgateway.RedisAuth{SecretRef: &enterprisekgateway.RedisSecretAuth{Name: "redis", Namespace: ptr.To("infra")}},
+			wantPassword: "infra-secret",
+		},
+		{
+			name:    "missing password",
+			auth:    &enterprisekgateway.RedisAuth{SecretRef: &enterprisekgateway.RedisSecretAuth{Name: "password-only"}},
+			wantErr: `redis.auth.secretRef: Invalid value: "password-only": Secret apps/password-only has no key "password"`,
+		},
+		{
+			name:    "missing Secret",
+			auth:    &enterprisekgateway.RedisAuth{SecretRef: &enterprisekgateway.RedisSecretAuth{Name: "missing"}},
+			wantErr: `redis.auth.secretRef.name: Not found: "apps/missing"`,
+		},
+		{
+			name:    "AWS without a credentials provider",
+			auth:    &enterprisekgateway.RedisAuth{AWS: &enterprisekgateway.RedisAWSAuth{Region: "us-east-1", ClusterName: "c", UserName: "u"}},
+			wantErr: `redis.auth.aws: Invalid value: "": AWS IAM authentication requires an AWS credentials provider`,
+		},
+		{
+			name: "secretRef and AWS",
+			auth: &enterprisekgateway.RedisAuth{
+				SecretRef: &enterprisekgateway.RedisSecretAuth{Name: "redis"},
+				AWS:       &enterprisekgateway.RedisAWSAuth{Region: "us-east-1", ClusterName: "c", UserName: "u"},
+			},
+			wantErr: `redis.auth: Invalid value: "": exactly one of secretRef or aws must be set`,
+		},
+		{
+			name:    "no method",
+			auth:    &enterprisekgateway.RedisAuth{},
+			wantErr: `redis.auth: Invalid value: "": exactly one of secretRef or aws must be set`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := r.UniversalOptions(&enterprisekgateway.RedisClientConfig{Address: "redis:6379", Auth: tt.auth}, "apps", fldPath)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("UniversalOptions() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if opts.Username != tt.wantUsername || opts.Password != tt.wantPassword {
+				t.Errorf("credentials = %q, %q, want %q, %q", opts.Username, opts.Password, tt.wantUsername, tt.wantPassword)
+			}
+		})
+	}
+}
+
+func TestUniversalOptionsAWSCredentials(t *testing.T) {
+	var got *enterprisekgateway.RedisAWSAuth
+	r := &Resolver{AWSCredentials: func(_ context.Context, auth *enterprisekgateway.RedisAWSAuth) (string, string, error) {
+		got = auth
+		return auth.UserName, "token", nil
+	}}
+	aws := &enterprisekgateway.RedisAWSAuth{Region: "us-east-1", ClusterName: "sessions", UserName: "ext-auth"}
+	opts, err := r.UniversalOptions(&enterprisekgateway.RedisClientConfig{Address: "redis:6379", Auth: &enterprisekgateway.RedisAuth{AWS: aws}}, "apps", fldPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	username, token, err := opts.CredentialsProviderContext(context.Background())
+	if err != nil || username != "ext-auth" || token != "token" {
+		t.Errorf("CredentialsProviderContext() = %q, %q, %v, want ext-auth, token", username, token, err)
+	}
+	if got == aws || *got != *aws {
+		t.Errorf("credentials provider called with %+v, want a copy of %+v", got, aws)
+	}
+}
+
+func TestUniversalOptionsClient(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *enterprisekgateway.RedisClientConfig
+		// cluster is whether go-redis creates a cluster client. RedisClientConfig has no
+		// sentinel settings, so it never creates a failover client.
+		cluster bool
+	}{
+		{name: "single node", cfg: &enterprisekgateway.RedisClientConfig{Address: "redis:6379", DB: ptr.To[int32](2)}},
+		{name: "cluster", cfg: &enterprisekgateway.RedisClientConfig{Address: "redis:6379", Clustered: ptr.To(true)}, cluster: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := (&Resolver{}).UniversalOptions(tt.cfg, "apps", fldPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(opts.Addrs) != 1 || opts.Addrs[0] != "redis:6379" || opts.DB != int(ptr.Deref(tt.cfg.DB, 0)) || opts.MasterName != "" {
+				t.Errorf("UniversalOptions() = %+v", opts)
+			}
+			client := redis.NewUniversalClient(opts)
+			defer client.Close()
+			if _, cluster := client.(*redis.ClusterClient); cluster != tt.cluster {
+				t.Errorf("NewUniversalClient() = %T, want cluster client %v", client, tt.cluster)
+			}
+		})
+	}
+}
+
+func TestUniversalOptionsConnection(t *testing.T) {
+	cfg := &enterprisekgateway.RedisClientConfig{
+		Address:    "/var/run/redis.sock",
+		SocketType: ptr.To(SocketTypeUnix),
+		Connection: &enterprisekgateway.RedisConnectionConfig{
+			PoolSize:    ptr.To[int32](20),
+			MaxRetries:  ptr.To[int32](0),
+			DialTimeout: &metav1.Duration{Duration: 2 * time.Second},
+		},
+	}
+	opts, err := (&Resolver{}).UniversalOptions(cfg, "apps", fldPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.PoolSize != 20 || opts.MaxRetries != -1 || opts.DialTimeout != 2*time.Second || opts.ReadTimeout != 0 {
+		t.Errorf("UniversalOptions() = %+v, want pool size 20, no retries and a 2s dial timeout", opts)
+	}
+	if opts.Dialer == nil {
+		t.Error("UniversalOptions() has no Unix socket dialer")
+	}
+
+	cfg.SocketType = ptr.To("udp")
+	want := `redis.socketType: Unsupported value: "udp": supported values: "tcp", "tls", "unix"`
+	if _, err := (&Resolver{}).UniversalOptions(cfg, "apps", fldPath); err == nil || err.Error() != want {
+		t.Errorf("UniversalOptions() error = %v, want %s", err, want)
+	}
+}
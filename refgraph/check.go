@@ -0,0 +1,151 @@
+package refgraph
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// IssueType is the type of an issue reported by Check.
+type IssueType string
+
+const (
+	// IssueMissingTarget reports a reference to an object that is not in the graph.
+	IssueMissingTarget IssueType = "MissingTarget"
+	// IssueCrossNamespace reports a reference to an object in another namespace. References
+	// that require a ReferenceGrant are not reported if a ReferenceGrant in the graph
+	// permits them.
+	IssueCrossNamespace IssueType = "CrossNamespace"
+	// IssueOrphaned reports an AuthConfig, RateLimitConfig or WAFPolicy that no object in
+	// the graph references.
+	IssueOrphaned IssueType = "Orphaned"
+	// IssueUnchecked reports an object whose references could not be collected, such as an
+	// AuthConfig whose spec cannot be decoded. Its references are neither checked nor
+	// counted.
+	IssueUnchecked IssueType = "Unchecked"
+)
+
+// orphanKinds are the kinds that are only useful when referenced by a policy.
+var orphanKinds = map[schema.GroupKind]bool{
+	AuthConfigKind:      true,
+	RateLimitConfigKind: true,
+	WAFPolicyKind:       true,
+}
+
+// Issue is a problem found in the graph.
+type Issue struct {
+	Type IssueType
+	// Object is the object the issue is reported on: the referencing object for
+	// MissingTarget and CrossNamespace issues, the orphaned object for Orphaned issues, and
+	// the unchecked object for Unchecked issues.
+	Object ObjectKey
+	// Ref is the offending reference. It is nil for Orphaned and Unchecked issues.
+	Ref *Ref
+	// Message describes the issue.
+	Message string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Type, i.Object, i.Message)
+}
+
+// Check reports the references to objects that are not in the graph, the cross-namespace
+// references, the AuthConfigs, RateLimitConfigs and WAFPolicies that are not referenced,
+// and the objects whose references could not be collected. Missing targets are reported for every kind, so the graph should include the
+// ConfigMaps, GatewayExtensions and backends referenced by policies, and the Secrets
+// referenced by AuthConfigs; filter the issues on Ref.To otherwise.
+//
+// Issues are sorted by object, then in the order of the references.
+func (g *Graph) Check() []Issue {
+	var issues []Issue
+	referenced := map[ObjectKey]bool{}
+	for i := range g.refs {
+		ref := &g.refs[i]
+		referenced[ref.To] = true
+		if _, ok := g.objects[ref.To]; !ok {
+			issues = append(issues, Issue{
+				Type:    IssueMissingTarget,
+				Object:  ref.From,
+				Ref:     ref,
+				Message: fmt.Sprintf("%s: %s not found", ref.Field, ref.To),
+			})
+		}
+		if ref.To.Namespace != ref.From.Namespace {
+			msg := fmt.Sprintf("%s: references %s in namespace %s", ref.Field, ref.To.GroupKind, ref.To.Namespace)
+			if ref.GrantRequired {
+				if g.permitted(ref) {
+					continue
+				}
+				msg += " without a ReferenceGrant"
+			}
+			issues = append(issues, Issue{
+				Type:    IssueCrossNamespace,
+				Object:  ref.From,
+				Ref:     ref,
+				Message: msg,
+			})
+		}
+	}
+	for _, key := range g.Objects() {
+		if err, ok := g.unchecked[key]; ok {
+			issues = append(issues, Issue{
+				Type:    IssueUnchecked,
+				Object:  key,
+				Message: fmt.Sprintf("%s references not checked: %v", key.Kind, err),
+			})
+		}
+		if orphanKinds[key.GroupKind] && !referenced[key] {
+			issues = append(issues, Issue{
+				Type:    IssueOrphaned,
+				Object:  key,
+				Message: fmt.Sprintf("%s is not referenced by any policy", key.Kind),
+			})
+		}
+	}
+	sort.SliceStable(issues, func(i, j int) bool { return lessKey(issues[i].Object, issues[j].Object) })
+	return issues
+}
+
+// permitted reports whether a ReferenceGrant in the graph permits the cross-namespace ref.
+func (g *Graph) permitted(ref *Ref) bool {
+	for key, obj := range g.objects {
+		if key.Namespace != ref.To.Namespace {
+			continue
+		}
+		var spec *gwv1.ReferenceGrantSpec
+		switch grant := obj.(type) {
+		case *gwv1.ReferenceGrant:
+			spec = &grant.Spec
+		case *gwv1beta1.ReferenceGrant:
+			spec = &grant.Spec
+		default:
+			continue
+		}
+		if grantAllows(spec, ref) {
+			return true
+		}
+	}
+	return false
+}
+
+func grantAllows(spec *gwv1.ReferenceGrantSpec, ref *Ref) bool {
+	from := false
+	for _, f := range spec.From {
+		if string(f.Group) == ref.From.Group && string(f.Kind) == ref.From.Kind && string(f.Namespace) == ref.From.Namespace {
+			from = true
+			break
+		}
+	}
+	if !from {
+		return false
+	}
+	for _, t := range spec.To {
+		if string(t.Group) == ref.To.Group && string(t.Kind) == ref.To.Kind && (t.Name == nil || string(*t.Name) == ref.To.Name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,211 @@
+package refgraph
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const trafficPolicy = `
+apiVersion: enterprisekgateway.solo.io/v1alpha1
+kind: EnterpriseKgatewayTrafficPolicy
+metadata:
+  name: api
+  namespace: apps
+spec:
+  entExtAuth:
+    authConfigRef:
+      name: oidc
+  entWAF:
+    wafPolicyRef:
+      name: crs
+      namespace: security
+    wafServerRef:
+      name: waf
+      namespace: security
+`
+
+const authConfig = `
+apiVersion: extauth.solo.io/v1
+kind: AuthConfig
+metadata:
+  name: oidc
+  namespace: apps
+spec:
+  configs:
+  - oauth2:
+      oidcAuthorizationCode:
+        clientId: client
+        clientSecretRef:
+          name: oauth
+          namespace: apps
+        issuerUrl: https://idp.example.com/
+        appUrl: https://app.example.com
+        callbackPath: /callback
+`
+
+const wafPolicy = `
+apiVersion: waf.solo.io/v1alpha1
+kind: WAFPolicy
+metadata:
+  name: crs
+  namespace: security
+spec:
+  ruleEngineSettings:
+    inline: SecRuleEngine On
+`
+
+const secret = `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: oauth
+  namespace: apps
+`
+
+const wafService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: waf
+  namespace: security
+`
+
+const referenceGrant = `
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: ReferenceGrant
+metadata:
+  name: waf
+  namespace: security
+spec:
+  from:
+  - group: enterprisekgateway.solo.io
+    kind: EnterpriseKgatewayTrafficPolicy
+    namespace: apps
+  to:
+  - group: ""
+    kind: Service
+`
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		docs []string
+		// want are prefixes of the issues, as the messages of protojson errors are not
+		// stable.
+		want []string
+	}{
+		{
+			name: "all references resolved",
+			docs: []string{trafficPolicy, authConfig, wafPolicy, secret, wafService, referenceGrant},
+			want: []string{
+				"CrossNamespace: EnterpriseKgatewayTrafficPolicy.enterprisekgateway.solo.io apps/api: spec.entWAF.wafPolicyRef: references WAFPolicy.waf.solo.io in namespace security",
+			},
+		},
+		{
+			name: "missing targets",
+			docs: []string{trafficPolicy, wafService, referenceGrant},
+			want: []string{
+				"MissingTarget: EnterpriseKgatewayTrafficPolicy.enterprisekgateway.solo.io apps/api: spec.entExtAuth.authConfigRef: AuthConfig.extauth.solo.io apps/oidc not found",
+				"MissingTarget: EnterpriseKgatewayTrafficPolicy.enterprisekgateway.solo.io apps/api: spec.entWAF.wafPolicyRef: WAFPolicy.waf.solo.io security/crs not found",
+				"CrossNamespace: EnterpriseKgatewayTrafficPolicy.enterprisekgateway.solo.io apps/api: spec.entWAF.wafPolicyRef: references WAFPolicy.waf.solo.io in namespace security",
+			},
+		},
+		{
+			name: "backend reference without a ReferenceGrant",
+			docs: []string{trafficPolicy, authConfig, wafPolicy, secret, wafService},
+			want: []string{
+				"CrossNamespace: EnterpriseKgatewayTrafficPolicy.enterprisekgateway.solo.io apps/api: spec.entWAF.wafPolicyRef: references WAFPolicy.waf.solo.io in namespace security",
+				"CrossNamespace: EnterpriseKgatewayTrafficPolicy.enterprisekgateway.solo.io apps/api: spec.entWAF.wafServerRef: references Service in namespace security without a ReferenceGrant",
+			},
+		},
+		{
+			name: "AuthConfig secret not found",
+			docs: []string{authConfig},
+			want: []string{
+				"MissingTarget: AuthConfig.extauth.solo.io apps/oidc: spec.configs[0].oauth2.oidcAuthorizationCode.clientSecretRef: Secret apps/oauth not found",
+				"Orphaned: AuthConfig.extauth.solo.io apps/oidc: AuthConfig is not referenced by any policy",
+			},
+		},
+		{
+			name: "orphaned WAFPolicy",
+			docs: []string{wafPolicy},
+			want: []string{"Orphaned: WAFPolicy.waf.solo.io security/crs: WAFPolicy is not referenced by any policy"},
+		},
+		{
+			name: "AuthConfig spec that cannot be decoded",
+			docs: []string{strings.Replace(authConfig, "clientId: client", "clientId: [client]", 1)},
+			want: []string{
+				"Unchecked: AuthConfig.extauth.solo.io apps/oidc: AuthConfig references not checked: proto:",
+				"Orphaned: AuthConfig.extauth.solo.io apps/oidc: AuthConfig is not referenced by any policy",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs, err := Decode(strings.NewReader(strings.Join(tt.docs, "---")))
+			if err != nil {
+				t.Fatal(err)
+			}
+			g, err := New(objs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, issue := range g.Check() {
+				got = append(got, issue.String())
+			}
+			if !hasPrefixes(got, tt.want) {
+				t.Errorf("Check() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func hasPrefixes(got, want []string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if !strings.HasPrefix(got[i], want[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestNewRejectsDuplicates(t *testing.T) {
+	objs, err := Decode(strings.NewReader(wafPolicy + "---" + wafPolicy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(objs...); err == nil || !strings.Contains(err.Error(), "duplicate object") {
+		t.Errorf("New() error = %v, want duplicate object", err)
+	}
+}
+
+func TestRefs(t *testing.T) {
+	objs, err := Decode(strings.NewReader(trafficPolicy + "---" + authConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := New(objs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := ObjectKey{GroupKind: AuthConfigKind}
+	key.Namespace, key.Name = "apps", "oidc"
+	var to, from []string
+	for _, ref := range g.RefsTo(key) {
+		to = append(to, ref.String())
+	}
+	for _, ref := range g.RefsFrom(key) {
+		from = append(from, ref.String())
+	}
+	if want := []string{"EnterpriseKgatewayTrafficPolicy.enterprisekgateway.solo.io apps/api spec.entExtAuth.authConfigRef -> AuthConfig.extauth.solo.io apps/oidc"}; !reflect.DeepEqual(to, want) {
+		t.Errorf("RefsTo() = %q, want %q", to, want)
+	}
+	if want := []string{"AuthConfig.extauth.solo.io apps/oidc spec.configs[0].oauth2.oidcAuthorizationCode.clientSecretRef -> Secret apps/oauth"}; !reflect.DeepEqual(from, want) {
+		t.Errorf("RefsFrom() = %q, want %q", from, want)
+	}
+}
@@ -0,0 +1,178 @@
+// Package refgraph builds the graph of references between enterprise policies and the
+// objects they depend on, such as the AuthConfigs, RateLimitConfigs and WAFPolicies
+// referenced by traffic policies, and reports dangling references and orphaned objects.
+//
+// The graph is built from any set of objects: typed objects read from listers or a fake
+// clientset tracker, or objects decoded from YAML manifests. Objects of kinds unknown to
+// this package, such as GatewayExtensions, Services or Backends, may be added as
+// unstructured objects so that references to them resolve.
+package refgraph
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ObjectKey identifies an object in the graph.
+type ObjectKey struct {
+	schema.GroupKind
+	types.NamespacedName
+}
+
+func (k ObjectKey) String() string {
+	return k.GroupKind.String() + " " + k.NamespacedName.String()
+}
+
+// Ref is a reference from one object to another.
+type Ref struct {
+	// From is the referencing object.
+	From ObjectKey
+	// To is the referenced object, with its namespace defaulted.
+	To ObjectKey
+	// Field is the path of the reference in the referencing object.
+	Field *field.Path
+	// GrantRequired is true if a cross-namespace reference must be permitted by a
+	// ReferenceGrant in the namespace of the referenced object.
+	GrantRequired bool
+}
+
+func (r Ref) String() string {
+	return fmt.Sprintf("%s %s -> %s", r.From, r.Field, r.To)
+}
+
+// Graph is a dependency graph of objects and the references between them.
+type Graph struct {
+	objects map[ObjectKey]runtime.Object
+	refs    []Ref
+	// unchecked holds the objects whose references could not be collected, with the
+	// reason. Check reports them.
+	unchecked map[ObjectKey]error
+}
+
+// New returns the graph of objs. See Add.
+func New(objs ...runtime.Object) (*Graph, error) {
+	g := &Graph{objects: map[ObjectKey]runtime.Object{}, unchecked: map[ObjectKey]error{}}
+	for _, obj := range objs {
+		if err := g.Add(obj); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// Add adds obj and its references to the graph. Lists are added item by item. The kind
+// of typed objects is resolved from Scheme if their TypeMeta is not set. Objects whose
+// references cannot be collected, such as AuthConfigs with an invalid spec, are added
+// without references and reported by Check.
+func (g *Graph) Add(obj runtime.Object) error {
+	if meta.IsListType(obj) {
+		items, err := meta.ExtractList(obj)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := g.Add(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	key, err := keyOf(obj)
+	if err != nil {
+		return err
+	}
+	if _, ok := g.objects[key]; ok {
+		return fmt.Errorf("duplicate object %s", key)
+	}
+	g.objects[key] = obj
+	refs, err := refsOf(key, obj)
+	if err != nil {
+		g.unchecked[key] = err
+		return nil
+	}
+	g.refs = append(g.refs, refs...)
+	return nil
+}
+
+func keyOf(obj runtime.Object) (ObjectKey, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ObjectKey{}, err
+	}
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Kind == "" {
+		gvks, _, err := Scheme.ObjectKinds(obj)
+		if err != nil {
+			return ObjectKey{}, err
+		}
+		gvk = gvks[0]
+	}
+	return ObjectKey{
+		GroupKind:      gvk.GroupKind(),
+		NamespacedName: types.NamespacedName{Namespace: accessor.GetNamespace(), Name: accessor.GetName()},
+	}, nil
+}
+
+// Get returns the object with the given key.
+func (g *Graph) Get(key ObjectKey) (runtime.Object, bool) {
+	obj, ok := g.objects[key]
+	return obj, ok
+}
+
+// Objects returns the keys of the objects in the graph, sorted.
+func (g *Graph) Objects() []ObjectKey {
+	keys := make([]ObjectKey, 0, len(g.objects))
+	for key := range g.objects {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return lessKey(keys[i], keys[j]) })
+	return keys
+}
+
+// Refs returns all references in the graph, in the order the objects were added.
+func (g *Graph) Refs() []Ref {
+	return append([]Ref(nil), g.refs...)
+}
+
+// RefsFrom returns the references made by the object with the given key.
+func (g *Graph) RefsFrom(key ObjectKey) []Ref {
+	var refs []Ref
+	for _, ref := range g.refs {
+		if ref.From == key {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// RefsTo returns the references to the object with the given key, whether or not the
+// object is in the graph.
+func (g *Graph) RefsTo(key ObjectKey) []Ref {
+	var refs []Ref
+	for _, ref := range g.refs {
+		if ref.To == key {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+func lessKey(a, b ObjectKey) bool {
+	if a.Group != b.Group {
+		return a.Group < b.Group
+	}
+	if a.Kind != b.Kind {
+		return a.Kind < b.Kind
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
@@ -0,0 +1,201 @@
+package refgraph
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+
+	upstream "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	upstreamshared "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/waf"
+	enterprisev1 "github.com/solo-io/kgateway-client/v2/external/enterprise.gloo.solo.io/v1"
+	extauthv1 "github.com/solo-io/kgateway-client/v2/external/extauth.solo.io/v1"
+	ratelimitv1alpha1 "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
+)
+
+var (
+	// TrafficPolicyKind is the kind of EnterpriseKgatewayTrafficPolicy.
+	TrafficPolicyKind = enterprisekgateway.SchemeGroupVersion.WithKind("EnterpriseKgatewayTrafficPolicy").GroupKind()
+	// WAFPolicyKind is the kind of WAFPolicy.
+	WAFPolicyKind = waf.SchemeGroupVersion.WithKind("WAFPolicy").GroupKind()
+	// AuthConfigKind is the kind of AuthConfig.
+	AuthConfigKind = extauthv1.AuthConfigGVK.GroupKind()
+	// RateLimitConfigKind is the kind of RateLimitConfig.
+	RateLimitConfigKind = ratelimitv1alpha1.RateLimitConfigGVK.GroupKind()
+	// GatewayExtensionKind is the kind of the kgateway GatewayExtension.
+	GatewayExtensionKind = schema.GroupKind{Group: upstream.GroupName, Kind: "GatewayExtension"}
+	// ConfigMapKind is the kind of ConfigMap.
+	ConfigMapKind = schema.GroupKind{Kind: "ConfigMap"}
+	// SecretKind is the kind of Secret.
+	SecretKind = schema.GroupKind{Kind: "Secret"}
+	// ServiceKind is the kind of Service, the default kind of backend references.
+	ServiceKind = schema.GroupKind{Kind: "Service"}
+)
+
+// refsOf returns the references made by obj. It returns an error if they cannot be known,
+// such as for an unstructured AuthConfig whose spec cannot be decoded.
+func refsOf(key ObjectKey, obj runtime.Object) ([]Ref, error) {
+	r := &refCollector{from: key}
+	switch obj := obj.(type) {
+	case *enterprisekgateway.EnterpriseKgatewayTrafficPolicy:
+		r.trafficPolicy(&obj.Spec, field.NewPath("spec"))
+	case *waf.WAFPolicy:
+		r.wafPolicy(&obj.Spec, field.NewPath("spec"))
+	case *extauthv1.AuthConfig:
+		r.authConfig(&obj.Spec, obj.Namespace, field.NewPath("spec"))
+	case *unstructured.Unstructured:
+		if key.GroupKind == AuthConfigKind {
+			spec, err := authConfigSpec(obj)
+			if err != nil {
+				return nil, err
+			}
+			r.authConfig(spec, obj.GetNamespace(), field.NewPath("spec"))
+		}
+	}
+	return r.refs, nil
+}
+
+type refCollector struct {
+	from ObjectKey
+	refs []Ref
+}
+
+// add adds a reference to the named object. An empty namespace defaults to the namespace
+// of the referencing object.
+func (r *refCollector) add(fldPath *field.Path, gk schema.GroupKind, namespace, name string, grantRequired bool) {
+	if namespace == "" {
+		namespace = r.from.Namespace
+	}
+	r.refs = append(r.refs, Ref{
+		From:          r.from,
+		To:            ObjectKey{GroupKind: gk, NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}},
+		Field:         fldPath,
+		GrantRequired: grantRequired,
+	})
+}
+
+func (r *refCollector) trafficPolicy(spec *enterprisekgateway.EnterpriseKgatewayTrafficPolicySpec, fldPath *field.Path) {
+	if rl := spec.EntRateLimit; rl != nil {
+		globalPath := fldPath.Child("entRateLimit", "global")
+		r.extensionRef(rl.Global.ExtensionRef, globalPath.Child("extensionRef"))
+		for i, ref := range rl.Global.RateLimitConfigRefs {
+			r.add(globalPath.Child("rateLimitConfigRefs").Index(i), RateLimitConfigKind, namespace(ref.Namespace), string(ref.Name), false)
+		}
+	}
+	if ea := spec.EntExtAuth; ea != nil {
+		eaPath := fldPath.Child("entExtAuth")
+		if ref := ea.AuthConfigRef; ref != nil {
+			r.add(eaPath.Child("authConfigRef"), AuthConfigKind, namespace(ref.Namespace), string(ref.Name), false)
+		}
+		r.extensionRef(ea.ExtensionRef, eaPath.Child("extensionRef"))
+	}
+	if w := spec.EntWAF; w != nil {
+		wafPath := fldPath.Child("entWAF")
+		if ref := w.WAFPolicyRef; ref != nil {
+			r.add(wafPath.Child("wafPolicyRef"), WAFPolicyKind, namespace(ref.Namespace), string(ref.Name), false)
+		}
+		if ref := w.WAFServerRef; ref != nil {
+			r.backendRef(*ref, wafPath.Child("wafServerRef"))
+		}
+	}
+	if jwt := spec.EntJWT; jwt != nil {
+		jwtPath := fldPath.Child("entJWT")
+		r.jwt(jwt.BeforeExtAuth, jwtPath.Child("beforeExtAuth"))
+		r.jwt(jwt.AfterExtAuth, jwtPath.Child("afterExtAuth"))
+	}
+}
+
+func (r *refCollector) jwt(jwt *enterprisekgateway.EntJWT, fldPath *field.Path) {
+	if jwt == nil {
+		return
+	}
+	for _, name := range slices.Sorted(maps.Keys(jwt.Providers)) {
+		if remote := jwt.Providers[name].JWKS.Remote; remote != nil {
+			r.backendRef(remote.BackendRef.BackendObjectReference, fldPath.Child("providers").Key(name).Child("jwks", "remote", "backendRef"))
+		}
+	}
+}
+
+// extensionRef adds a reference to a GatewayExtension. Reference grants are not required
+// for cross-namespace extension references.
+func (r *refCollector) extensionRef(ref *upstreamshared.NamespacedObjectReference, fldPath *field.Path) {
+	if ref == nil {
+		return
+	}
+	r.add(fldPath, GatewayExtensionKind, namespace(ref.Namespace), string(ref.Name), false)
+}
+
+// backendRef adds a Gateway API backend reference, which defaults to a Service.
+func (r *refCollector) backendRef(ref gwv1.BackendObjectReference, fldPath *field.Path) {
+	gk := ServiceKind
+	if ref.Group != nil {
+		gk.Group = string(*ref.Group)
+	}
+	if ref.Kind != nil {
+		gk.Kind = string(*ref.Kind)
+	}
+	r.add(fldPath, gk, namespace(ref.Namespace), string(ref.Name), true)
+}
+
+func (r *refCollector) wafPolicy(spec *waf.WAFPolicySpec, fldPath *field.Path) {
+	if spec.CoreRuleSet != nil {
+		r.directiveSource(&spec.CoreRuleSet.Settings, fldPath.Child("coreRuleSet", "settings"))
+	}
+	r.directiveSource(&spec.RuleEngineSettings, fldPath.Child("ruleEngineSettings"))
+	for i := range spec.CustomDirectives {
+		r.directiveSource(&spec.CustomDirectives[i], fldPath.Child("customDirectives").Index(i))
+	}
+}
+
+func (r *refCollector) directiveSource(ds *waf.DirectiveSource, fldPath *field.Path) {
+	if ref := ds.ConfigMap; ref != nil {
+		r.add(fldPath.Child("configMap"), ConfigMapKind, ref.Namespace, ref.Name, false)
+	}
+}
+
+// authConfig adds the Secrets and ConfigMaps referenced by name by the spec of an
+// AuthConfig in namespace. Secrets selected by labels are not added.
+func (r *refCollector) authConfig(spec *enterprisev1.AuthConfigSpec, namespace string, fldPath *field.Path) {
+	for _, ref := range spec.References(namespace, fldPath) {
+		if ref.Name == "" {
+			continue
+		}
+		gk := SecretKind
+		if ref.Kind == enterprisev1.ReferenceKindConfigMap {
+			gk = ConfigMapKind
+		}
+		r.add(ref.Path, gk, ref.Namespace, ref.Name, false)
+	}
+}
+
+// authConfigSpec decodes the spec of an unstructured AuthConfig, as returned by Decode.
+// Unlike the Go type, it does not require the kubernetes_protomessage_one_more_release tag.
+func authConfigSpec(u *unstructured.Unstructured) (*enterprisev1.AuthConfigSpec, error) {
+	spec, _, err := unstructured.NestedFieldNoCopy(u.Object, "spec")
+	if err != nil {
+		return nil, err
+	}
+	if spec == nil {
+		return &enterprisev1.AuthConfigSpec{}, nil
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	return enterprisev1.DecodeAuthConfigSpec(data)
+}
+
+func namespace(ns *gwv1.Namespace) string {
+	if ns == nil {
+		return ""
+	}
+	return string(*ns)
+}
@@ -0,0 +1,150 @@
+package refgraph
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	upstream "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/testing"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/waf"
+	clientsetscheme "github.com/solo-io/kgateway-client/v2/clientset/versioned/scheme"
+	extauthv1 "github.com/solo-io/kgateway-client/v2/external/extauth.solo.io/v1"
+	ratelimitv1alpha1 "github.com/solo-io/kgateway-client/v2/external/ratelimit.solo.io/v1alpha1"
+)
+
+// Scheme holds the types of this clientset along with the core, Gateway API and kgateway
+// types that policies refer to. It is used to resolve the kind of typed objects and to
+// decode manifests.
+var Scheme = runtime.NewScheme()
+
+var codecs = serializer.NewCodecFactory(Scheme)
+
+func init() {
+	utilruntime.Must(clientsetscheme.AddToScheme(Scheme))
+	utilruntime.Must(corev1.AddToScheme(Scheme))
+	utilruntime.Must(gwv1.Install(Scheme))
+	utilruntime.Must(gwv1beta1.Install(Scheme))
+	utilruntime.Must(upstream.AddToScheme(Scheme))
+}
+
+// Objects converts the items returned by a lister to runtime objects, for New.
+func Objects[T runtime.Object](items []T) []runtime.Object {
+	objs := make([]runtime.Object, len(items))
+	for i, item := range items {
+		objs[i] = item
+	}
+	return objs
+}
+
+// trackedKinds are the resources read from an object tracker.
+var trackedKinds = []struct {
+	gvr schema.GroupVersionResource
+	gvk schema.GroupVersionKind
+}{
+	{enterprisekgateway.SchemeGroupVersion.WithResource("enterprisekgatewaytrafficpolicies"), enterprisekgateway.SchemeGroupVersion.WithKind("EnterpriseKgatewayTrafficPolicy")},
+	{waf.SchemeGroupVersion.WithResource("wafpolicies"), waf.SchemeGroupVersion.WithKind("WAFPolicy")},
+	{extauthv1.SchemeGroupVersion.WithResource("authconfigs"), extauthv1.AuthConfigGVK},
+	{ratelimitv1alpha1.SchemeGroupVersion.WithResource("ratelimitconfigs"), ratelimitv1alpha1.RateLimitConfigGVK},
+}
+
+// FromTracker returns the traffic policies, WAFPolicies, AuthConfigs and RateLimitConfigs
+// held by tracker in namespace, or in all namespaces if namespace is empty. It can be
+// used with the tracker of the fake clientset.
+func FromTracker(tracker testing.ObjectTracker, namespace string) ([]runtime.Object, error) {
+	var objs []runtime.Object
+	for _, k := range trackedKinds {
+		list, err := tracker.List(k.gvr, k.gvk, namespace)
+		if err != nil {
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, items...)
+	}
+	return objs, nil
+}
+
+// Decode decodes the YAML or JSON documents of r. Objects of kinds registered in Scheme
+// are decoded to their Go types; other objects are decoded as unstructured objects.
+// Documents without a kind are skipped.
+//
+// AuthConfigs are always decoded as unstructured objects: decoding their spec to the Go
+// type panics unless the binary is built with the kubernetes_protomessage_one_more_release
+// tag. Add collects their references without it.
+func Decode(r io.Reader) ([]runtime.Object, error) {
+	var objs []runtime.Object
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	decoder := codecs.UniversalDeserializer()
+	for i := 0; ; i++ {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		var obj runtime.Object
+		if isAuthConfig(doc) {
+			obj, err = decodeUnstructured(doc)
+		} else {
+			obj, _, err = decoder.Decode(doc, nil, nil)
+			if runtime.IsNotRegisteredError(err) {
+				obj, err = decodeUnstructured(doc)
+			}
+		}
+		if runtime.IsMissingKind(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		objs = append(objs, obj)
+	}
+}
+
+// isAuthConfig reports whether doc is an AuthConfig. Documents that can not be read are
+// left to the decoder, which reports the error.
+func isAuthConfig(doc []byte) bool {
+	data, err := utilyaml.ToJSON(doc)
+	if err != nil {
+		return false
+	}
+	gvk, err := json.DefaultMetaFactory.Interpret(data)
+	if err != nil {
+		return false
+	}
+	return gvk.GroupKind() == AuthConfigKind
+}
+
+func decodeUnstructured(doc []byte) (runtime.Object, error) {
+	data, err := utilyaml.ToJSON(doc)
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
@@ -0,0 +1,95 @@
+package sharedextensions
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// applyOverlay merges the metadata of overlay into obj and applies its spec to obj. The spec
+// of typed objects is applied as a strategic merge patch, as the controller does, and the
+// one of unstructured objects as a JSON merge patch.
+func applyOverlay(obj metav1.Object, overlay *shared.KubernetesResourceOverlay, fldPath *field.Path) field.ErrorList {
+	if overlay == nil {
+		return nil
+	}
+	if md := overlay.Metadata; md != nil {
+		if len(md.Labels) > 0 {
+			labels := maps.Clone(obj.GetLabels())
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			maps.Copy(labels, md.Labels)
+			obj.SetLabels(labels)
+		}
+		if len(md.Annotations) > 0 {
+			annotations := maps.Clone(obj.GetAnnotations())
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			maps.Copy(annotations, md.Annotations)
+			obj.SetAnnotations(annotations)
+		}
+	}
+	if overlay.Spec == nil || len(overlay.Spec.Raw) == 0 {
+		return nil
+	}
+
+	specPath := fldPath.Child("spec")
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		var spec any
+		if err := json.Unmarshal(overlay.Spec.Raw, &spec); err != nil {
+			return field.ErrorList{field.Invalid(specPath, string(overlay.Spec.Raw), err.Error())}
+		}
+		u.Object = mergePatch(u.Object, map[string]any{"spec": spec}).(map[string]any)
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]json.RawMessage{"spec": overlay.Spec.Raw})
+	if err != nil {
+		return field.ErrorList{field.Invalid(specPath, string(overlay.Spec.Raw), err.Error())}
+	}
+	original, err := json.Marshal(obj)
+	if err != nil {
+		return field.ErrorList{field.InternalError(specPath, err)}
+	}
+	patched, err := strategicpatch.StrategicMergePatch(original, patch, obj)
+	if err != nil {
+		return field.ErrorList{field.Invalid(specPath, string(overlay.Spec.Raw), err.Error())}
+	}
+	// obj is cleared before decoding the patched object, so that the fields the patch
+	// deletes are unset.
+	v := reflect.ValueOf(obj).Elem()
+	v.Set(reflect.Zero(v.Type()))
+	if err := json.Unmarshal(patched, obj); err != nil {
+		return field.ErrorList{field.Invalid(specPath, string(overlay.Spec.Raw), err.Error())}
+	}
+	return nil
+}
+
+// mergePatch applies the JSON merge patch of RFC 7386 to target.
+func mergePatch(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = map[string]any{}
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = mergePatch(t[k], v)
+	}
+	return t
+}
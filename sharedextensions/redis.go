@@ -0,0 +1,126 @@
+package sharedextensions
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// RedisEnv returns the environment variables, volumes and volume mounts that pass the Redis
+// connection of cfg to an extension container deployed in namespace. The names of the
+// variables are prefix followed by URL, DB, SOCKET_TYPE, CLUSTERED, USERNAME, PASSWORD,
+// CA_CERT, the AWS_ authentication fields and the connection pool fields, such as
+// POOL_SIZE or DIAL_TIMEOUT. Unset fields are omitted. Credentials are read from their
+// Secret, and the CA certificate is mounted from its Secret and passed by path.
+//
+// Secrets are referenced from the pod, so they must be in namespace.
+func RedisEnv(prefix string, cfg *enterprisekgateway.RedisClientConfig, namespace string, fldPath *field.Path) ([]corev1.EnvVar, []corev1.Volume, []corev1.VolumeMount, field.ErrorList) {
+	var env []corev1.EnvVar
+	var volumes []corev1.Volume
+	var mounts []corev1.VolumeMount
+	var errs field.ErrorList
+	add := func(name, value string) {
+		env = append(env, corev1.EnvVar{Name: prefix + name, Value: value})
+	}
+
+	add("URL", cfg.Address)
+	if cfg.DB != nil {
+		add("DB", fmt.Sprint(*cfg.DB))
+	}
+	if cfg.SocketType != nil {
+		add("SOCKET_TYPE", *cfg.SocketType)
+	}
+	if ptr.Deref(cfg.Clustered, false) {
+		add("CLUSTERED", "true")
+	}
+
+	if certs := cfg.Certs; certs != nil && certs.CACertSecretRef != nil {
+		refPath := fldPath.Child("certs", "caCertSecretRef")
+		if ns := certs.CACertSecretRef.Namespace; ns != "" && ns != namespace {
+			errs = append(errs, field.Invalid(refPath.Child("namespace"), ns, fmt.Sprintf("must be the namespace of the extension, %s", namespace)))
+		}
+		volume := strings.ToLower(strings.ReplaceAll(prefix, "_", "-")) + "ca-cert"
+		dir := path.Join("/etc", volume)
+		key := ptr.Deref(certs.CACertKey, enterprisekgateway.DefaultRedisCACertKey)
+		volumes = append(volumes, corev1.Volume{
+			Name: volume,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName: certs.CACertSecretRef.Name,
+				Items:      []corev1.KeyToPath{{Key: key, Path: key}},
+			}},
+		})
+		mounts = append(mounts, corev1.VolumeMount{Name: volume, MountPath: dir, ReadOnly: true})
+		add("CA_CERT", path.Join(dir, key))
+	}
+
+	if auth := cfg.Auth; auth != nil {
+		if s := auth.SecretRef; s != nil {
+			if ns := ptr.Deref(s.Namespace, ""); ns != "" && ns != namespace {
+				errs = append(errs, field.Invalid(fldPath.Child("auth", "secretRef", "namespace"), ns, fmt.Sprintf("must be the namespace of the extension, %s", namespace)))
+			}
+			for _, v := range []struct{ name, key string }{
+				{"USERNAME", ptr.Deref(s.UsernameKey, enterprisekgateway.DefaultRedisUsernameKey)},
+				{"PASSWORD", ptr.Deref(s.PasswordKey, enterprisekgateway.DefaultRedisPasswordKey)},
+			} {
+				env = append(env, corev1.EnvVar{
+					Name: prefix + v.name,
+					ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: s.Name},
+						Key:                  v.key,
+						// Servers without ACLs only use a password.
+						Optional: ptr.To(v.name == "USERNAME"),
+					}},
+				})
+			}
+		}
+		if aws := auth.AWS; aws != nil {
+			add("AWS_REGION", aws.Region)
+			add("AWS_CLUSTER_NAME", aws.ClusterName)
+			add("AWS_USER_NAME", aws.UserName)
+			if aws.ServerlessCacheName != nil {
+				add("AWS_SERVERLESS_CACHE_NAME", *aws.ServerlessCacheName)
+			}
+		}
+	}
+
+	if c := cfg.Connection; c != nil {
+		for _, v := range []struct {
+			name  string
+			value *int32
+		}{
+			{"POOL_SIZE", c.PoolSize},
+			{"MIN_IDLE_CONNS", c.MinIdleConns},
+			{"MAX_IDLE_CONNS", c.MaxIdleConns},
+			{"MAX_RETRIES", c.MaxRetries},
+		} {
+			if v.value != nil {
+				add(v.name, fmt.Sprint(*v.value))
+			}
+		}
+		for _, v := range []struct {
+			name  string
+			value *metav1.Duration
+		}{
+			{"DIAL_TIMEOUT", c.DialTimeout},
+			{"READ_TIMEOUT", c.ReadTimeout},
+			{"WRITE_TIMEOUT", c.WriteTimeout},
+			{"POOL_TIMEOUT", c.PoolTimeout},
+			{"CONN_MAX_IDLE_TIME", c.ConnMaxIdleTime},
+			{"CONN_MAX_LIFETIME", c.ConnMaxLifetime},
+			{"MIN_RETRY_BACKOFF", c.MinRetryBackoff},
+			{"MAX_RETRY_BACKOFF", c.MaxRetryBackoff},
+		} {
+			if v.value != nil {
+				add(v.name, v.value.Duration.String())
+			}
+		}
+	}
+	return env, volumes, mounts, errs
+}
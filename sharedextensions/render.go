@@ -0,0 +1,606 @@
+// Package sharedextensions renders the shared extensions configured by an
+// EnterpriseKgatewayParameters object, the ext-auth service, the rate limiter, the ext-cache
+// Redis and the WAF server, into the Kubernetes objects deployed for them: a Deployment, a
+// Service and a ServiceAccount per extension, and a PodDisruptionBudget,
+// HorizontalPodAutoscaler and VerticalPodAutoscaler when requested.
+//
+// The typed configuration of each extension, such as its replicas, strategy, resources,
+// image and security context, is applied first, then its overlays: the metadata of an
+// overlay is merged into the object, and its spec is applied as a strategic merge patch,
+// or as a JSON merge patch for VerticalPodAutoscalers, which have no Go types here.
+//
+// The Redis connections of the rate limiter and of the ext-auth session store are passed to
+// their containers as environment variables; see RedisEnv. The rate limiter uses the
+// ext-cache Redis when no connection is configured and ext-cache is enabled.
+//
+// The output only approximates the objects deployed by the controller. The ports,
+// environment variable names and images that the controller uses are not defined in this
+// repository, so Options must set them, to match a given controller release, for each
+// enabled extension.
+package sharedextensions
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	upstream "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// Component is a shared extension.
+type Component string
+
+const (
+	ComponentExtAuth     Component = "extauth"
+	ComponentRateLimiter Component = "ratelimiter"
+	ComponentExtCache    Component = "extCache"
+	ComponentWAF         Component = "waf"
+)
+
+// Components are the shared extensions, in the order they are rendered.
+var Components = []Component{ComponentExtAuth, ComponentRateLimiter, ComponentExtCache, ComponentWAF}
+
+// Port is a port of an extension container and its Service.
+type Port struct {
+	Port int32
+	// Env is the environment variable that passes the port to the container. No variable
+	// is set if it is empty.
+	Env string
+}
+
+// Ports are the ports of an extension.
+type Ports struct {
+	// Serve is the port the extension serves on, named grpc, or redis for ext-cache.
+	Serve Port
+	// Admin is the admin port of the WAF server, named admin. It is only exposed when the
+	// admin endpoint is enabled.
+	Admin Port
+}
+
+// Env are the names of the environment variables passed to the extension containers,
+// other than those of their ports.
+type Env struct {
+	// WAFLogLevel passes the log level of the WAF server.
+	WAFLogLevel string
+	// RedisPrefix prefixes the variables of the rate limiter Redis connection; see
+	// RedisEnv.
+	RedisPrefix string
+	// SessionRedisPrefix prefixes the variables of the ext-auth session Redis connection.
+	SessionRedisPrefix string
+}
+
+// Options configures the rendering of shared extensions.
+type Options struct {
+	// Namespace is the namespace the extensions are deployed to. It defaults to the
+	// namespace of the parameters.
+	Namespace string
+	// Instance is the value of the app.kubernetes.io/instance label and the suffix of the
+	// object names. It defaults to the name of the parameters.
+	Instance string
+	// Images are the default images of the extensions, which the image of their container
+	// configuration overrides field by field. Each enabled component must have one.
+	Images map[Component]upstream.Image
+	// Ports are the ports of the extensions. Each enabled component must have them, with
+	// an admin port for a WAF server exposing its admin endpoint.
+	Ports map[Component]Ports
+	// Env are the names of the environment variables of the extensions. The names used by
+	// the enabled components must be set.
+	Env Env
+}
+
+// names are the base names of the objects of each component.
+var names = map[Component]string{
+	ComponentExtAuth:     "ext-auth-service",
+	ComponentRateLimiter: "rate-limiter",
+	ComponentExtCache:    "ext-cache",
+	ComponentWAF:         "waf-server",
+}
+
+// Extension holds the objects rendered for a shared extension. Optional objects are nil
+// when not requested.
+type Extension struct {
+	Component  Component
+	Deployment *appsv1.Deployment
+	Service    *corev1.Service
+	// ServiceAccount is nil when the configuration names an existing service account.
+	ServiceAccount          *corev1.ServiceAccount
+	PodDisruptionBudget     *policyv1.PodDisruptionBudget
+	HorizontalPodAutoscaler *autoscalingv2.HorizontalPodAutoscaler
+	VerticalPodAutoscaler   *unstructured.Unstructured
+}
+
+// Objects returns the objects of the extension, in the order they are written.
+func (e *Extension) Objects() []runtime.Object {
+	var objs []runtime.Object
+	if e.ServiceAccount != nil {
+		objs = append(objs, e.ServiceAccount)
+	}
+	objs = append(objs, e.Service, e.Deployment)
+	if e.PodDisruptionBudget != nil {
+		objs = append(objs, e.PodDisruptionBudget)
+	}
+	if e.HorizontalPodAutoscaler != nil {
+		objs = append(objs, e.HorizontalPodAutoscaler)
+	}
+	if e.VerticalPodAutoscaler != nil {
+		objs = append(objs, e.VerticalPodAutoscaler)
+	}
+	return objs
+}
+
+// Render returns the objects of the shared extensions enabled in params, in the order of
+// Components. An extension is enabled when it is configured and its enabled field is not
+// false. params is defaulted as by the API server and is not modified. Render fails when
+// opts lacks the image, ports or environment variable names of an enabled extension.
+func Render(params *enterprisekgateway.EnterpriseKgatewayParameters, opts Options) ([]Extension, error) {
+	params = params.DeepCopy()
+	enterprisekgateway.SetObjectDefaults_EnterpriseKgatewayParameters(params)
+	if opts.Namespace == "" {
+		opts.Namespace = params.Namespace
+	}
+	if opts.Instance == "" {
+		opts.Instance = params.Name
+	}
+	if params.Spec.Kube == nil || params.Spec.Kube.SharedExtensions == nil {
+		return nil, nil
+	}
+	r := &renderer{
+		opts:                opts,
+		extensions:          params.Spec.Kube.SharedExtensions,
+		omitSecurityContext: ptr.Deref(params.Spec.Kube.OmitDefaultSecurityContext, false),
+		extensionsPath:      field.NewPath("spec", "kube", "sharedExtensions"),
+	}
+	if err := r.checkOptions(); err != nil {
+		return nil, err
+	}
+
+	var exts []Extension
+	var errs field.ErrorList
+	for _, c := range Components {
+		if !r.enabled(c) {
+			continue
+		}
+		ext, err := r.render(c)
+		errs = append(errs, err...)
+		if len(err) == 0 {
+			exts = append(exts, ext)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	return exts, nil
+}
+
+// WriteYAML writes the objects of exts to w as a multi-document YAML stream, without their
+// empty status.
+func WriteYAML(w io.Writer, exts []Extension) error {
+	for _, ext := range exts {
+		for _, obj := range ext.Objects() {
+			u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+			if err != nil {
+				return err
+			}
+			delete(u, "status")
+			data, err := yaml.Marshal(u)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "---\n%s", data); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type renderer struct {
+	opts                Options
+	extensions          *enterprisekgateway.Extensions
+	omitSecurityContext bool
+	extensionsPath      *field.Path
+}
+
+// checkOptions returns an error naming the options that the enabled extensions need but
+// that are not set.
+func (r *renderer) checkOptions() error {
+	var errs []error
+	for _, c := range Components {
+		if !r.enabled(c) {
+			continue
+		}
+		if _, ok := r.opts.Images[c]; !ok {
+			errs = append(errs, fmt.Errorf("Options.Images has no image for %s", c))
+		}
+		ports, ok := r.opts.Ports[c]
+		switch {
+		case !ok || ports.Serve.Port == 0:
+			errs = append(errs, fmt.Errorf("Options.Ports has no serve port for %s", c))
+		case r.adminEnabled(c) && ports.Admin.Port == 0:
+			errs = append(errs, fmt.Errorf("Options.Ports has no admin port for %s", c))
+		}
+	}
+	if r.enabled(ComponentWAF) && r.opts.Env.WAFLogLevel == "" {
+		errs = append(errs, errors.New("Options.Env.WAFLogLevel is not set"))
+	}
+	if r.enabled(ComponentRateLimiter) && r.rateLimiterRedis() != nil && r.opts.Env.RedisPrefix == "" {
+		errs = append(errs, errors.New("Options.Env.RedisPrefix is not set"))
+	}
+	if r.enabled(ComponentExtAuth) && r.extensions.ExtAuth.SessionRedis != nil && r.opts.Env.SessionRedisPrefix == "" {
+		errs = append(errs, errors.New("Options.Env.SessionRedisPrefix is not set"))
+	}
+	return errors.Join(errs...)
+}
+
+// config returns the deployment configuration of c and the path of its field.
+func (r *renderer) config(c Component) (*enterprisekgateway.DeploymentConfiguration, *field.Path) {
+	e := r.extensions
+	fldPath := r.extensionsPath.Child(string(c))
+	switch c {
+	case ComponentExtAuth:
+		if e.ExtAuth != nil {
+			return &e.ExtAuth.DeploymentConfiguration, fldPath
+		}
+	case ComponentRateLimiter:
+		if e.RateLimiter != nil {
+			return &e.RateLimiter.DeploymentConfiguration, fldPath
+		}
+	case ComponentExtCache:
+		return e.ExtCache, fldPath
+	case ComponentWAF:
+		if e.WAF != nil {
+			return &e.WAF.DeploymentConfiguration, fldPath
+		}
+	}
+	return nil, fldPath
+}
+
+func (r *renderer) enabled(c Component) bool {
+	cfg, _ := r.config(c)
+	return cfg != nil && ptr.Deref(cfg.Enabled, true)
+}
+
+// name returns the name of the objects of c.
+func (r *renderer) name(c Component) string {
+	return names[c] + "-" + r.opts.Instance
+}
+
+func (r *renderer) selectorLabels(c Component) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":     names[c],
+		"app.kubernetes.io/instance": r.opts.Instance,
+	}
+}
+
+func (r *renderer) objectMeta(c Component) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      r.name(c),
+		Namespace: r.opts.Namespace,
+		Labels:    r.selectorLabels(c),
+	}
+}
+
+func (r *renderer) render(c Component) (Extension, field.ErrorList) {
+	cfg, fldPath := r.config(c)
+	ext := Extension{Component: c}
+	var errs field.ErrorList
+
+	container, volumes, err := r.container(c, cfg, fldPath)
+	errs = append(errs, err...)
+	serviceAccountName := r.serviceAccountName(c)
+	ext.Deployment = r.deployment(c, cfg, container, volumes, serviceAccountName)
+	ext.Service = r.service(c)
+	if serviceAccountName == r.name(c) {
+		ext.ServiceAccount = &corev1.ServiceAccount{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ServiceAccount"},
+			ObjectMeta: r.objectMeta(c),
+		}
+	}
+
+	errs = append(errs, applyOverlay(ext.Deployment, cfg.DeploymentOverlay, fldPath.Child("deploymentOverlay"))...)
+	errs = append(errs, applyOverlay(ext.Service, cfg.ServiceOverlay, fldPath.Child("serviceOverlay"))...)
+	if ext.ServiceAccount != nil {
+		errs = append(errs, applyOverlay(ext.ServiceAccount, cfg.ServiceAccountOverlay, fldPath.Child("serviceAccountOverlay"))...)
+	}
+	if cfg.PodDisruptionBudget != nil {
+		ext.PodDisruptionBudget = &policyv1.PodDisruptionBudget{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "policy/v1", Kind: "PodDisruptionBudget"},
+			ObjectMeta: r.objectMeta(c),
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: r.selectorLabels(c)},
+			},
+		}
+		errs = append(errs, applyOverlay(ext.PodDisruptionBudget, cfg.PodDisruptionBudget, fldPath.Child("podDisruptionBudget"))...)
+	}
+	if cfg.HorizontalPodAutoscaler != nil {
+		ext.HorizontalPodAutoscaler = &autoscalingv2.HorizontalPodAutoscaler{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler"},
+			ObjectMeta: r.objectMeta(c),
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: r.name(c)},
+			},
+		}
+		errs = append(errs, applyOverlay(ext.HorizontalPodAutoscaler, cfg.HorizontalPodAutoscaler, fldPath.Child("horizontalPodAutoscaler"))...)
+	}
+	if cfg.VerticalPodAutoscaler != nil {
+		meta := r.objectMeta(c)
+		ext.VerticalPodAutoscaler = &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "autoscaling.k8s.io/v1",
+			"kind":       "VerticalPodAutoscaler",
+			"metadata": map[string]any{
+				"name":      meta.Name,
+				"namespace": meta.Namespace,
+				"labels":    toAny(meta.Labels),
+			},
+			"spec": map[string]any{
+				"targetRef": map[string]any{"apiVersion": "apps/v1", "kind": "Deployment", "name": r.name(c)},
+			},
+		}}
+		errs = append(errs, applyOverlay(ext.VerticalPodAutoscaler, cfg.VerticalPodAutoscaler, fldPath.Child("verticalPodAutoscaler"))...)
+	}
+	return ext, errs
+}
+
+// serviceAccountName returns the service account named in the configuration of c, or the
+// name of the rendered one.
+func (r *renderer) serviceAccountName(c Component) string {
+	var name *string
+	switch c {
+	case ComponentExtAuth:
+		name = r.extensions.ExtAuth.ServiceAccountName
+	case ComponentRateLimiter:
+		name = r.extensions.RateLimiter.ServiceAccountName
+	}
+	if name != nil && *name != "" {
+		return *name
+	}
+	return r.name(c)
+}
+
+// portsOf returns the ports of c.
+func (r *renderer) portsOf(c Component) Ports {
+	return r.opts.Ports[c]
+}
+
+// adminEnabled reports whether the admin port of c is exposed.
+func (r *renderer) adminEnabled(c Component) bool {
+	if c != ComponentWAF {
+		return false
+	}
+	admin := r.extensions.WAF.Admin
+	return admin != nil && ptr.Deref(admin.Enabled, false)
+}
+
+func (r *renderer) ports(c Component) []corev1.ContainerPort {
+	ports := r.portsOf(c)
+	name := "grpc"
+	if c == ComponentExtCache {
+		name = "redis"
+	}
+	container := []corev1.ContainerPort{{Name: name, ContainerPort: ports.Serve.Port, Protocol: corev1.ProtocolTCP}}
+	if r.adminEnabled(c) {
+		container = append(container, corev1.ContainerPort{Name: "admin", ContainerPort: ports.Admin.Port, Protocol: corev1.ProtocolTCP})
+	}
+	return container
+}
+
+// portEnv returns the environment variables that pass the ports of c to its container.
+func (r *renderer) portEnv(c Component) []corev1.EnvVar {
+	ports := r.portsOf(c)
+	var env []corev1.EnvVar
+	if ports.Serve.Env != "" {
+		env = append(env, corev1.EnvVar{Name: ports.Serve.Env, Value: fmt.Sprint(ports.Serve.Port)})
+	}
+	if r.adminEnabled(c) && ports.Admin.Env != "" {
+		env = append(env, corev1.EnvVar{Name: ports.Admin.Env, Value: fmt.Sprint(ports.Admin.Port)})
+	}
+	return env
+}
+
+// image returns the image of c, with the fields of the container configuration overriding
+// those of the default image.
+func (r *renderer) image(c Component, cfg *enterprisekgateway.DeploymentConfiguration) (string, corev1.PullPolicy) {
+	img := r.opts.Images[c]
+	if cfg.Container != nil && cfg.Container.Image != nil {
+		override := cfg.Container.Image
+		for _, f := range []struct{ dst, src **string }{
+			{&img.Registry, &override.Registry},
+			{&img.Repository, &override.Repository},
+			{&img.Tag, &override.Tag},
+			{&img.Digest, &override.Digest},
+		} {
+			if *f.src != nil {
+				*f.dst = *f.src
+			}
+		}
+		if override.PullPolicy != nil {
+			img.PullPolicy = override.PullPolicy
+		}
+	}
+	image := ptr.Deref(img.Repository, "")
+	if registry := ptr.Deref(img.Registry, ""); registry != "" {
+		image = registry + "/" + image
+	}
+	if digest := ptr.Deref(img.Digest, ""); digest != "" {
+		image += "@" + digest
+	} else if tag := ptr.Deref(img.Tag, ""); tag != "" {
+		image += ":" + tag
+	}
+	return image, ptr.Deref(img.PullPolicy, "")
+}
+
+// defaultSecurityContext is the security context of containers without one, unless the
+// parameters omit default security contexts.
+func defaultSecurityContext() *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		AllowPrivilegeEscalation: ptr.To(false),
+		ReadOnlyRootFilesystem:   ptr.To(true),
+		RunAsNonRoot:             ptr.To(true),
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+	}
+}
+
+func (r *renderer) container(c Component, cfg *enterprisekgateway.DeploymentConfiguration, fldPath *field.Path) (corev1.Container, []corev1.Volume, field.ErrorList) {
+	image, pullPolicy := r.image(c, cfg)
+	container := corev1.Container{
+		Name:            names[c],
+		Image:           image,
+		ImagePullPolicy: pullPolicy,
+		Ports:           r.ports(c),
+	}
+	if cfg.Resources != nil {
+		container.Resources = *cfg.Resources
+	}
+	switch {
+	case cfg.Container != nil && cfg.Container.SecurityContext != nil:
+		container.SecurityContext = cfg.Container.SecurityContext
+	case !r.omitSecurityContext:
+		container.SecurityContext = defaultSecurityContext()
+	}
+	if pod := cfg.PodTemplate; pod != nil {
+		container.StartupProbe = pod.StartupProbe
+		container.ReadinessProbe = pod.ReadinessProbe
+		container.LivenessProbe = pod.LivenessProbe
+	}
+
+	container.Env = r.portEnv(c)
+
+	var volumes []corev1.Volume
+	var errs field.ErrorList
+	switch c {
+	case ComponentExtAuth:
+		if redis := r.extensions.ExtAuth.SessionRedis; redis != nil {
+			env, vols, mounts, err := RedisEnv(r.opts.Env.SessionRedisPrefix, redis, r.opts.Namespace, fldPath.Child("sessionRedis"))
+			container.Env = append(container.Env, env...)
+			container.VolumeMounts = append(container.VolumeMounts, mounts...)
+			volumes = append(volumes, vols...)
+			errs = append(errs, err...)
+		}
+	case ComponentRateLimiter:
+		if redis := r.rateLimiterRedis(); redis != nil {
+			env, vols, mounts, err := RedisEnv(r.opts.Env.RedisPrefix, redis, r.opts.Namespace, fldPath.Child("redis"))
+			container.Env = append(container.Env, env...)
+			container.VolumeMounts = append(container.VolumeMounts, mounts...)
+			volumes = append(volumes, vols...)
+			errs = append(errs, err...)
+		}
+	case ComponentExtCache:
+		// Redis persists its data under /data, which must be writable with a read-only root
+		// filesystem.
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: "data", MountPath: "/data"})
+		volumes = append(volumes, corev1.Volume{Name: "data", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}})
+	case ComponentWAF:
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  r.opts.Env.WAFLogLevel,
+			Value: string(ptr.Deref(r.extensions.WAF.LogLevel, enterprisekgateway.WAFLogLevelInfo)),
+		})
+	}
+	return container, volumes, errs
+}
+
+// rateLimiterRedis returns the Redis connection of the rate limiter, which is the ext-cache
+// Redis when none is configured and ext-cache is enabled.
+func (r *renderer) rateLimiterRedis() *enterprisekgateway.RedisClientConfig {
+	if redis := r.extensions.RateLimiter.Redis; redis != nil || !r.enabled(ComponentExtCache) {
+		return redis
+	}
+	return &enterprisekgateway.RedisClientConfig{
+		Address: fmt.Sprintf("%s.%s.svc.cluster.local:%d", r.name(ComponentExtCache), r.opts.Namespace, r.portsOf(ComponentExtCache).Serve.Port),
+	}
+}
+
+func (r *renderer) deployment(c Component, cfg *enterprisekgateway.DeploymentConfiguration, container corev1.Container, volumes []corev1.Volume, serviceAccountName string) *appsv1.Deployment {
+	podLabels := r.selectorLabels(c)
+	var podAnnotations map[string]string
+	spec := corev1.PodSpec{
+		ServiceAccountName: serviceAccountName,
+		Containers:         []corev1.Container{container},
+		Volumes:            volumes,
+	}
+	if pod := cfg.PodTemplate; pod != nil {
+		podLabels = maps.Clone(podLabels)
+		for k, v := range pod.ExtraLabels {
+			// The selector labels can not be overridden.
+			if _, ok := podLabels[k]; !ok {
+				podLabels[k] = v
+			}
+		}
+		podAnnotations = maps.Clone(pod.ExtraAnnotations)
+		spec.SecurityContext = pod.SecurityContext
+		spec.ImagePullSecrets = pod.ImagePullSecrets
+		spec.NodeSelector = pod.NodeSelector
+		spec.Affinity = pod.Affinity
+		spec.Tolerations = pod.Tolerations
+		spec.TerminationGracePeriodSeconds = pod.TerminationGracePeriodSeconds
+		spec.TopologySpreadConstraints = pod.TopologySpreadConstraints
+		spec.Volumes = append(spec.Volumes, pod.ExtraVolumes...)
+		if pod.PriorityClassName != nil {
+			spec.PriorityClassName = *pod.PriorityClassName
+		}
+	}
+	if spec.SecurityContext == nil && !r.omitSecurityContext {
+		spec.SecurityContext = &corev1.PodSecurityContext{
+			RunAsNonRoot:   ptr.To(true),
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		}
+	}
+
+	d := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: r.objectMeta(c),
+		Spec: appsv1.DeploymentSpec{
+			Replicas: cfg.Replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: r.selectorLabels(c)},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: podLabels, Annotations: podAnnotations},
+				Spec:       spec,
+			},
+		},
+	}
+	if cfg.Strategy != nil {
+		d.Spec.Strategy = *cfg.Strategy
+	}
+	return d
+}
+
+func (r *renderer) service(c Component) *corev1.Service {
+	svc := &corev1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: r.objectMeta(c),
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: r.selectorLabels(c),
+		},
+	}
+	for _, p := range r.ports(c) {
+		svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+			Name:       p.Name,
+			Port:       p.ContainerPort,
+			TargetPort: intstr.FromString(p.Name),
+			Protocol:   p.Protocol,
+		})
+	}
+	return svc
+}
+
+func toAny(m map[string]string) map[string]any {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,128 @@
+package sharedextensions
+
+import (
+	"bytes"
+	"flag"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	upstream "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+var update = flag.Bool("update", false, "update the golden files of testdata")
+
+// testOptions are options setting the images, ports and environment variable names of
+// every component.
+var testOptions = Options{
+	Images: map[Component]upstream.Image{
+		ComponentExtAuth:     {Registry: ptr.To("example.com"), Repository: ptr.To("ext-auth"), Tag: ptr.To("2.0.0")},
+		ComponentRateLimiter: {Registry: ptr.To("example.com"), Repository: ptr.To("rate-limiter"), Tag: ptr.To("2.0.0")},
+		ComponentExtCache:    {Repository: ptr.To("redis"), Tag: ptr.To("7")},
+		ComponentWAF:         {Registry: ptr.To("example.com"), Repository: ptr.To("waf"), Tag: ptr.To("2.0.0")},
+	},
+	Ports: map[Component]Ports{
+		ComponentExtAuth:     {Serve: Port{Port: 8083, Env: "SERVER_PORT"}},
+		ComponentRateLimiter: {Serve: Port{Port: 18081, Env: "GRPC_PORT"}},
+		ComponentExtCache:    {Serve: Port{Port: 6379}},
+		ComponentWAF:         {Serve: Port{Port: 18080, Env: "GRPC_PORT"}, Admin: Port{Port: 18082, Env: "ADMIN_PORT"}},
+	},
+	Env: Env{WAFLogLevel: "LOG_LEVEL", RedisPrefix: "REDIS_", SessionRedisPrefix: "SESSION_REDIS_"},
+}
+
+func readParameters(t *testing.T, name string) *enterprisekgateway.EnterpriseKgatewayParameters {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := &enterprisekgateway.EnterpriseKgatewayParameters{}
+	if err := yaml.UnmarshalStrict(data, params); err != nil {
+		t.Fatal(err)
+	}
+	return params
+}
+
+// TestRender renders testdata/parameters.yaml, whose overlays unset and merge container
+// fields by strategic merge patch and set VerticalPodAutoscaler fields by JSON merge
+// patch, and compares the output with its golden file, which -update rewrites.
+func TestRender(t *testing.T) {
+	exts, err := Render(readParameters(t, "parameters.yaml"), testOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got bytes.Buffer
+	if err := WriteYAML(&got, exts); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join("testdata", "parameters.golden.yaml")
+	if *update {
+		if err := os.WriteFile(path, got.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Bytes(), want) {
+		t.Errorf("output differs from %s:\n%s", path, got.String())
+	}
+}
+
+func TestRenderRequiresOptions(t *testing.T) {
+	params := readParameters(t, "parameters.yaml")
+	tests := []struct {
+		name   string
+		modify func(*Options)
+		want   string
+	}{
+		{
+			name:   "image",
+			modify: func(o *Options) { delete(o.Images, ComponentExtCache) },
+			want:   "Options.Images has no image for extCache",
+		},
+		{
+			name:   "ports",
+			modify: func(o *Options) { delete(o.Ports, ComponentExtAuth) },
+			want:   "Options.Ports has no serve port for extauth",
+		},
+		{
+			name:   "admin port",
+			modify: func(o *Options) { o.Ports[ComponentWAF] = Ports{Serve: Port{Port: 18080}} },
+			want:   "Options.Ports has no admin port for waf",
+		},
+		{
+			name:   "environment variable names",
+			modify: func(o *Options) { o.Env = Env{} },
+			want:   "Options.Env.WAFLogLevel is not set\nOptions.Env.RedisPrefix is not set\nOptions.Env.SessionRedisPrefix is not set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := testOptions
+			opts.Images = maps.Clone(testOptions.Images)
+			opts.Ports = maps.Clone(testOptions.Ports)
+			tt.modify(&opts)
+			if _, err := Render(params, opts); err == nil || err.Error() != tt.want {
+				t.Errorf("Render() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderInvalidOverlay(t *testing.T) {
+	params := readParameters(t, "parameters.yaml")
+	params.Spec.Kube.SharedExtensions.WAF.ServiceOverlay.Spec.Raw = []byte(`{"ports": "http"}`)
+	_, err := Render(params, testOptions)
+	if err == nil || !strings.HasPrefix(err.Error(), "spec.kube.sharedExtensions.waf.serviceOverlay.spec: Invalid value:") {
+		t.Errorf("Render() error = %v, want an invalid serviceOverlay.spec", err)
+	}
+}
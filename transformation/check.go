@@ -0,0 +1,182 @@
+package transformation
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/transformation/inja"
+)
+
+// Check parses the Inja templates of every staged transformation in t without rendering
+// them. fldPath is the path of t, for example field.NewPath("spec", "entTransformation").
+// See CheckTemplate for the reported errors.
+func Check(t *enterprisekgateway.EntTransformation, fldPath *field.Path) field.ErrorList {
+	if t == nil || t.Stages == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	stagesPath := fldPath.Child("stages")
+	allErrs = append(allErrs, checkStage(t.Stages.Early, stagesPath.Child("early"))...)
+	allErrs = append(allErrs, checkStage(t.Stages.Regular, stagesPath.Child("regular"))...)
+	allErrs = append(allErrs, checkStage(t.Stages.PostRouting, stagesPath.Child("postRouting"))...)
+	return allErrs
+}
+
+func checkStage(s *enterprisekgateway.RequestResponseTransformations, fldPath *field.Path) field.ErrorList {
+	if s == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	for i := range s.Requests {
+		allErrs = append(allErrs, checkTransformation(&s.Requests[i].Transformation, fldPath.Child("requests").Index(i).Child("transformation"))...)
+	}
+	for i := range s.Responses {
+		allErrs = append(allErrs, checkTransformation(&s.Responses[i].Transformation, fldPath.Child("responses").Index(i).Child("transformation"))...)
+	}
+	return allErrs
+}
+
+func checkTransformation(t *enterprisekgateway.Transformation, fldPath *field.Path) field.ErrorList {
+	if t.Template == nil {
+		return nil
+	}
+	return CheckTemplate(t.Template, fldPath.Child("template"))
+}
+
+// CheckTemplate parses the Inja templates of tmpl without rendering them and reports:
+//   - syntax errors, such as unbalanced `{{ }}` and `{% %}` blocks,
+//   - calls to unknown functions or with the wrong number of arguments,
+//   - extraction() calls naming an extractor that is not declared in Extractors,
+//   - advanced template syntax, extraction() and JSON pointer variable names, when
+//     AdvancedTemplates is not set,
+//   - variables that name no extractor when AdvancedTemplates is not set and the body is
+//     not parsed, as with ParseBodyBehavior DontParse: they are never defined, and fail
+//     the rendering unless they are the first argument of default().
+//
+// Errors are reported on the path of the template, with the line and column within it.
+func CheckTemplate(tmpl *enterprisekgateway.TransformationTemplate, fldPath *field.Path) field.ErrorList {
+	c := &checker{
+		env:        newEnv(nil, false, false),
+		advanced:   tmpl.AdvancedTemplates != nil && *tmpl.AdvancedTemplates,
+		extractors: tmpl.Extractors,
+	}
+	// Without advanced templates, variables are looked up in the extractors merged into the
+	// parsed body, so only extractors define them when the body is not parsed.
+	dontParse := tmpl.ParseBodyBehavior != nil && *tmpl.ParseBodyBehavior == enterprisekgateway.DontParse
+	passthrough := tmpl.BodyTransformation != nil && tmpl.BodyTransformation.Type == enterprisekgateway.BodyTransformationTypePassthrough
+	c.onlyExtractors = !c.advanced && (dontParse || passthrough)
+
+	var allErrs field.ErrorList
+	for _, name := range slices.Sorted(maps.Keys(tmpl.Headers)) {
+		allErrs = append(allErrs, c.check(tmpl.Headers[name], fldPath.Child("headers").Key(name))...)
+	}
+	for i, h := range tmpl.HeadersToAppend {
+		allErrs = append(allErrs, c.check(h.Value, fldPath.Child("headersToAppend").Index(i).Child("value"))...)
+	}
+	if bt := tmpl.BodyTransformation; bt != nil {
+		btPath := fldPath.Child("bodyTransformation")
+		if bt.Body != nil {
+			allErrs = append(allErrs, c.check(*bt.Body, btPath.Child("body"))...)
+		}
+		for _, key := range slices.Sorted(maps.Keys(bt.MergeJsonKeys)) {
+			allErrs = append(allErrs, c.check(bt.MergeJsonKeys[key].Tmpl, btPath.Child("mergeJsonKeys").Key(key).Child("tmpl"))...)
+		}
+	}
+	for i, md := range tmpl.DynamicMetadataValues {
+		allErrs = append(allErrs, c.check(md.Value, fldPath.Child("dynamicMetadataValues").Index(i).Child("value"))...)
+	}
+	if tmpl.SpanTransformer != nil {
+		allErrs = append(allErrs, c.check(tmpl.SpanTransformer.Name, fldPath.Child("spanTransformer", "name"))...)
+	}
+	return allErrs
+}
+
+type checker struct {
+	env        *inja.Env
+	advanced   bool
+	extractors map[string]enterprisekgateway.Extraction
+	// onlyExtractors is set when the extractors are the only variables of the body.
+	onlyExtractors bool
+}
+
+func (c *checker) check(src enterprisekgateway.InjaTemplate, fldPath *field.Path) field.ErrorList {
+	t, err := inja.Parse(string(src))
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, string(src), err.Error())}
+	}
+
+	var allErrs field.ErrorList
+	invalid := func(pos inja.Pos, format string, args ...interface{}) {
+		msg := fmt.Sprintf("%s: %s", pos, fmt.Sprintf(format, args...))
+		allErrs = append(allErrs, field.Invalid(fldPath, string(src), msg))
+	}
+	// bound are the names of the loop and set variables, which are defined whatever the
+	// body, and defaulted the variables whose absence default() recovers from.
+	bound := map[string]bool{"loop": true}
+	defaulted := map[*inja.Variable]bool{}
+	inja.Inspect(t, func(n inja.Node) bool {
+		switch n := n.(type) {
+		case *inja.For:
+			bound[n.Key], bound[n.Value] = true, true
+		case *inja.Set:
+			bound[n.Name] = true
+		case *inja.Call:
+			if n.Name == "default" && len(n.Args) > 0 {
+				if v, ok := n.Args[0].(*inja.Variable); ok {
+					defaulted[v] = true
+				}
+			}
+		}
+		return true
+	})
+	inja.Inspect(t, func(n inja.Node) bool {
+		switch n := n.(type) {
+		case *inja.Call:
+			if err := c.env.CheckCall(n); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath, string(src), err.Error()))
+				break
+			}
+			if n.Name != "extraction" {
+				break
+			}
+			if !c.advanced {
+				invalid(n.Pos(), "extraction() requires advancedTemplates; reference the extractor by name instead")
+				break
+			}
+			if lit, ok := n.Args[0].(*inja.Literal); ok {
+				if name, ok := lit.Value.(string); ok {
+					if _, declared := c.extractors[name]; !declared {
+						invalid(lit.Pos(), "extractor %q is not declared in extractors", name)
+					}
+				}
+			}
+		case *inja.Variable:
+			if !c.advanced && strings.Contains(n.Name, "/") {
+				invalid(n.Pos(), "JSON pointer notation in %q requires advancedTemplates", n.Name)
+				break
+			}
+			root, _, _ := strings.Cut(n.Name, ".")
+			if c.onlyExtractors && !bound[root] && !defaulted[n] && !c.namesExtractor(n.Name) {
+				invalid(n.Pos(), "variable %q names no extractor, and the body is not parsed", n.Name)
+			}
+		}
+		return true
+	})
+	return allErrs
+}
+
+// namesExtractor reports whether the variable name is an extractor or an object holding
+// extractors, as extractors with dotted names are nested in the body.
+func (c *checker) namesExtractor(name string) bool {
+	for extractor := range c.extractors {
+		if extractor == name || strings.HasPrefix(extractor, name+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package transformation
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+func TestCheckTemplate(t *testing.T) {
+	extractors := map[string]enterprisekgateway.Extraction{
+		"user":   {ExtractionHeader: ptr.To("x-user"), Regex: ".*"},
+		"org.id": {ExtractionHeader: ptr.To("x-org"), Regex: ".*"},
+	}
+	tests := []struct {
+		name string
+		tmpl enterprisekgateway.TransformationTemplate
+		// want are the details of the errors.
+		want []string
+	}{
+		{
+			name: "body variables",
+			tmpl: enterprisekgateway.TransformationTemplate{
+				Headers: map[string]enterprisekgateway.InjaTemplate{"x-tenant": "{{ tenant }}"},
+			},
+		},
+		{
+			name: "extractors without parsing the body",
+			tmpl: enterprisekgateway.TransformationTemplate{
+				ParseBodyBehavior: ptr.To(enterprisekgateway.DontParse),
+				Extractors:        extractors,
+				Headers: map[string]enterprisekgateway.InjaTemplate{
+					"x-user": "{{ user }}",
+					"x-org":  "{{ org.id }}{{ org }}",
+					"x-body": "{{ body() }}",
+				},
+			},
+		},
+		{
+			name: "loop, set and defaulted variables without parsing the body",
+			tmpl: enterprisekgateway.TransformationTemplate{
+				ParseBodyBehavior: ptr.To(enterprisekgateway.DontParse),
+				Headers: map[string]enterprisekgateway.InjaTemplate{
+					"x-loop":    `{% for v in ["a", "b"] %}{{ v }}{{ loop.index }}{% endfor %}`,
+					"x-set":     `{% set tier = "gold" %}{{ tier }}`,
+					"x-default": `{{ default(tenant, "none") }}`,
+				},
+			},
+		},
+		{
+			name: "undefined variables without parsing the body",
+			tmpl: enterprisekgateway.TransformationTemplate{
+				ParseBodyBehavior: ptr.To(enterprisekgateway.DontParse),
+				Extractors:        extractors,
+				Headers: map[string]enterprisekgateway.InjaTemplate{
+					"x-tenant": "{{ tenant }}",
+					"x-org":    "{{ org.name }}",
+				},
+			},
+			want: []string{
+				`1:4: variable "org.name" names no extractor, and the body is not parsed`,
+				`1:4: variable "tenant" names no extractor, and the body is not parsed`,
+			},
+		},
+		{
+			name: "undefined variables of a passthrough body",
+			tmpl: enterprisekgateway.TransformationTemplate{
+				BodyTransformation: &enterprisekgateway.BodyTransformation{Type: enterprisekgateway.BodyTransformationTypePassthrough},
+				HeadersToAppend:    []enterprisekgateway.HeaderToAppend{{Key: "x-tenant", Value: "tenant={{ tenant }}"}},
+			},
+			want: []string{`1:11: variable "tenant" names no extractor, and the body is not parsed`},
+		},
+		{
+			name: "advanced templates without parsing the body",
+			tmpl: enterprisekgateway.TransformationTemplate{
+				AdvancedTemplates: ptr.To(true),
+				ParseBodyBehavior: ptr.To(enterprisekgateway.DontParse),
+				Extractors:        extractors,
+				Headers:           map[string]enterprisekgateway.InjaTemplate{"x-user": `{{ extraction("user") }}{{ extraction("tenant") }}`},
+			},
+			want: []string{`1:39: extractor "tenant" is not declared in extractors`},
+		},
+		{
+			name: "advanced syntax without advanced templates",
+			tmpl: enterprisekgateway.TransformationTemplate{
+				Extractors: extractors,
+				Headers:    map[string]enterprisekgateway.InjaTemplate{"x-user": `{{ extraction("user") }}{{ a/b }}`},
+			},
+			want: []string{
+				`1:4: extraction() requires advancedTemplates; reference the extractor by name instead`,
+				`1:28: JSON pointer notation in "a/b" requires advancedTemplates`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, err := range CheckTemplate(&tt.tmpl, field.NewPath("template")) {
+				got = append(got, err.Detail)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CheckTemplate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package transformation
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+)
+
+// extract runs the extractor against the message headers and body.
+//
+// Regexes are evaluated with RE2 rather than the ECMAScript std::regex used by the
+// filter, so constructs such as backreferences and lookarounds are not supported.
+func extract(e *enterprisekgateway.Extraction, headers http.Header, body []byte) (string, error) {
+	var source string
+	switch {
+	case e.ExtractionHeader != nil:
+		source = headerValue(headers, *e.ExtractionHeader)
+	case e.ExtractionBody != nil && *e.ExtractionBody:
+		source = string(body)
+	default:
+		return "", fmt.Errorf("one of header or body must be set")
+	}
+
+	mode := enterprisekgateway.ModeExtract
+	if e.Mode != nil {
+		mode = *e.Mode
+	}
+	subgroup := 0
+	if e.Subgroup != nil {
+		subgroup = int(*e.Subgroup)
+	}
+
+	pattern := e.Regex
+	if mode != enterprisekgateway.ModeReplaceAll {
+		// Extract and SingleReplace require the regex to match the entire source.
+		pattern = `^(?:` + pattern + `)$`
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", fmt.Errorf("invalid regex: %v", err)
+	}
+	if subgroup > re.NumSubexp() {
+		return "", fmt.Errorf("group %d requested for regex with only %d sub groups", subgroup, re.NumSubexp())
+	}
+
+	switch mode {
+	case enterprisekgateway.ModeExtract:
+		m := re.FindStringSubmatchIndex(source)
+		if m == nil || m[2*subgroup] < 0 {
+			return "", nil
+		}
+		return source[m[2*subgroup]:m[2*subgroup+1]], nil
+	case enterprisekgateway.ModeSingleReplace:
+		if e.ReplacementText == nil {
+			return "", fmt.Errorf("replacementText must be set in %s mode", mode)
+		}
+		m := re.FindStringSubmatchIndex(source)
+		if m == nil || m[2*subgroup] < 0 {
+			return source, nil
+		}
+		return source[:m[2*subgroup]] + *e.ReplacementText + source[m[2*subgroup+1]:], nil
+	case enterprisekgateway.ModeReplaceAll:
+		if e.ReplacementText == nil {
+			return "", fmt.Errorf("replacementText must be set in %s mode", mode)
+		}
+		if subgroup != 0 {
+			return "", fmt.Errorf("subgroup must not be set in %s mode", mode)
+		}
+		return replaceAll(re, source, *e.ReplacementText), nil
+	default:
+		return "", fmt.Errorf("unsupported mode %q", mode)
+	}
+}
+
+// replaceAll replaces every match of re in source, expanding the replacement with the
+// ECMAScript format rules of std::regex_replace: $&, $`, $', $n, $nn and $$.
+func replaceAll(re *regexp.Regexp, source, replacement string) string {
+	var b strings.Builder
+	last := 0
+	for _, m := range re.FindAllStringSubmatchIndex(source, -1) {
+		b.WriteString(source[last:m[0]])
+		expandECMAScript(&b, replacement, source, m)
+		last = m[1]
+	}
+	b.WriteString(source[last:])
+	return b.String()
+}
+
+func expandECMAScript(b *strings.Builder, replacement, source string, m []int) {
+	group := func(n int) string {
+		if 2*n+1 >= len(m) || m[2*n] < 0 {
+			return ""
+		}
+		return source[m[2*n]:m[2*n+1]]
+	}
+	isDigit := func(c byte) bool { return '0' <= c && c <= '9' }
+
+	for i := 0; i < len(replacement); i++ {
+		c := replacement[i]
+		if c != '$' || i+1 >= len(replacement) {
+			b.WriteByte(c)
+			continue
+		}
+		next := replacement[i+1]
+		switch {
+		case next == '$':
+			b.WriteByte('$')
+			i++
+		case next == '&':
+			b.WriteString(group(0))
+			i++
+		case next == '`':
+			b.WriteString(source[:m[0]])
+			i++
+		case next == '\'':
+			b.WriteString(source[m[1]:])
+			i++
+		case isDigit(next):
+			n := int(next - '0')
+			width := 1
+			if i+2 < len(replacement) && isDigit(replacement[i+2]) {
+				if nn := n*10 + int(replacement[i+2]-'0'); 2*nn+1 < len(m) {
+					n, width = nn, 2
+				}
+			}
+			if n == 0 || 2*n+1 >= len(m) {
+				b.WriteByte(c)
+				continue
+			}
+			b.WriteString(group(n))
+			i += width
+		default:
+			b.WriteByte(c)
+		}
+	}
+}
+
+// headerValue returns the first value of the named header, or "" if it is absent.
+func headerValue(headers http.Header, name string) string {
+	if values := headers.Values(name); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,146 @@
+package transformation
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/solo-io/kgateway-client/v2/transformation/inja"
+)
+
+// renderContext holds the state read by the template functions of the transformation filter.
+type renderContext struct {
+	msg *Message
+	// headers are the headers of the message being transformed, updated as headers are rendered.
+	headers        http.Header
+	extractions    map[string]string
+	body           interface{}
+	env            map[string]string
+	randomReplaces map[string]string
+}
+
+// newEnv returns an inja.Env with the functions of the transformation filter.
+// A nil renderContext registers the functions for checking only.
+func newEnv(ctx *renderContext, advanced, escape bool) *inja.Env {
+	env := inja.NewEnv()
+	env.PointerNotation = advanced
+	env.EscapeStrings = escape
+
+	if ctx == nil {
+		ctx = &renderContext{msg: &Message{}}
+	}
+	str := func(args []interface{}, i int) (string, error) {
+		s, ok := args[i].(string)
+		if !ok {
+			return "", fmt.Errorf("argument %d must be a string", i+1)
+		}
+		return s, nil
+	}
+	stringFunc := func(fn func(string) (interface{}, error)) inja.Func {
+		return func(args []interface{}) (interface{}, error) {
+			s, err := str(args, 0)
+			if err != nil {
+				return nil, err
+			}
+			return fn(s)
+		}
+	}
+
+	env.AddFunc("header", 1, 1, stringFunc(func(name string) (interface{}, error) {
+		return headerValue(ctx.headers, name), nil
+	}))
+	env.AddFunc("request_header", 1, 1, stringFunc(func(name string) (interface{}, error) {
+		if ctx.msg.RequestHeaders != nil {
+			return headerValue(ctx.msg.RequestHeaders, name), nil
+		}
+		return headerValue(ctx.headers, name), nil
+	}))
+	env.AddFunc("extraction", 1, 1, stringFunc(func(name string) (interface{}, error) {
+		return ctx.extractions[name], nil
+	}))
+	env.AddFunc("context", 0, 0, func([]interface{}) (interface{}, error) {
+		return ctx.body, nil
+	})
+	env.AddFunc("body", 0, 0, func([]interface{}) (interface{}, error) {
+		return string(ctx.msg.Body), nil
+	})
+	env.AddFunc("env", 1, 1, stringFunc(func(name string) (interface{}, error) {
+		return ctx.env[name], nil
+	}))
+	env.AddFunc("base64_encode", 1, 1, stringFunc(func(s string) (interface{}, error) {
+		return base64.StdEncoding.EncodeToString([]byte(s)), nil
+	}))
+	env.AddFunc("base64url_encode", 1, 1, stringFunc(func(s string) (interface{}, error) {
+		return base64.RawURLEncoding.EncodeToString([]byte(s)), nil
+	}))
+	env.AddFunc("base64_decode", 1, 1, stringFunc(func(s string) (interface{}, error) {
+		// Invalid input decodes to an empty string, as in the filter.
+		decoded, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return "", nil
+		}
+		return string(decoded), nil
+	}))
+	env.AddFunc("base64url_decode", 1, 1, stringFunc(func(s string) (interface{}, error) {
+		decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+		if err != nil {
+			return "", nil
+		}
+		return string(decoded), nil
+	}))
+	env.AddFunc("substring", 2, 3, func(args []interface{}) (interface{}, error) {
+		s, err := str(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		start, ok := args[1].(int64)
+		if !ok {
+			return nil, fmt.Errorf("argument 2 must be an integer")
+		}
+		length := int64(-1)
+		if len(args) == 3 {
+			if length, ok = args[2].(int64); !ok {
+				return nil, fmt.Errorf("argument 3 must be an integer")
+			}
+		}
+		if start < 0 || start >= int64(len(s)) {
+			return "", nil
+		}
+		if length <= 0 || start+length > int64(len(s)) {
+			return s[start:], nil
+		}
+		return s[start : start+length], nil
+	})
+	env.AddFunc("replace_with_random", 2, 2, func(args []interface{}) (interface{}, error) {
+		s, err := str(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		pattern, err := str(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		random, ok := ctx.randomReplaces[pattern]
+		if !ok {
+			buf := make([]byte, 16)
+			if _, err := rand.Read(buf); err != nil {
+				return nil, err
+			}
+			random = base64.RawStdEncoding.EncodeToString(buf)
+			if ctx.randomReplaces == nil {
+				ctx.randomReplaces = map[string]string{}
+			}
+			ctx.randomReplaces[pattern] = random
+		}
+		return strings.ReplaceAll(s, pattern, random), nil
+	})
+	env.AddFunc("raw_string", 1, 1, func(args []interface{}) (interface{}, error) {
+		if s, ok := args[0].(string); ok {
+			return inja.RawString(s), nil
+		}
+		return inja.RawString(inja.Dump(args[0])), nil
+	})
+	return env
+}
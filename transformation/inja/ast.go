@@ -0,0 +1,168 @@
+package inja
+
+// Node is a node of the syntax tree: a statement or an expression.
+type Node interface {
+	Pos() Pos
+}
+
+// Expr is an expression node.
+type Expr interface {
+	Node
+	exprNode()
+}
+
+// Text is literal text outside of tags.
+type Text struct {
+	pos  Pos
+	Text string
+}
+
+// Output is an expression tag, `{{ expr }}`.
+type Output struct {
+	pos  Pos
+	Expr Expr
+}
+
+// If is an if statement with optional else if and else branches.
+type If struct {
+	pos Pos
+	// Conds holds the conditions of the if and else if branches, and Bodies their nodes.
+	Conds  []Expr
+	Bodies [][]Node
+	// Else holds the nodes of the else branch.
+	Else []Node
+}
+
+// For is a for statement over an array, `{% for value in expr %}`, or an object,
+// `{% for key, value in expr %}`.
+type For struct {
+	pos   Pos
+	Key   string
+	Value string
+	Iter  Expr
+	Body  []Node
+}
+
+// Set is a set statement, `{% set name = expr %}`.
+type Set struct {
+	pos   Pos
+	Name  string
+	Value Expr
+}
+
+// Literal is a JSON literal.
+type Literal struct {
+	pos   Pos
+	Value interface{}
+}
+
+// Variable is a reference to a variable, in dot or JSON pointer notation.
+type Variable struct {
+	pos  Pos
+	Name string
+}
+
+// Call is a function call. A pipe, `x | f(y)`, is parsed as the call `f(x, y)`.
+type Call struct {
+	pos  Pos
+	Name string
+	Args []Expr
+}
+
+// Unary is a unary operation, `not x` or `-x`.
+type Unary struct {
+	pos Pos
+	Op  string
+	X   Expr
+}
+
+// Binary is a binary operation.
+type Binary struct {
+	pos  Pos
+	Op   string
+	X, Y Expr
+}
+
+// List is an array literal.
+type List struct {
+	pos   Pos
+	Elems []Expr
+}
+
+// Object is an object literal.
+type Object struct {
+	pos    Pos
+	Keys   []string
+	Values []Expr
+}
+
+func (n *Text) Pos() Pos     { return n.pos }
+func (n *Output) Pos() Pos   { return n.pos }
+func (n *If) Pos() Pos       { return n.pos }
+func (n *For) Pos() Pos      { return n.pos }
+func (n *Set) Pos() Pos      { return n.pos }
+func (n *Literal) Pos() Pos  { return n.pos }
+func (n *Variable) Pos() Pos { return n.pos }
+func (n *Call) Pos() Pos     { return n.pos }
+func (n *Unary) Pos() Pos    { return n.pos }
+func (n *Binary) Pos() Pos   { return n.pos }
+func (n *List) Pos() Pos     { return n.pos }
+func (n *Object) Pos() Pos   { return n.pos }
+
+func (*Literal) exprNode()  {}
+func (*Variable) exprNode() {}
+func (*Call) exprNode()     {}
+func (*Unary) exprNode()    {}
+func (*Binary) exprNode()   {}
+func (*List) exprNode()     {}
+func (*Object) exprNode()   {}
+
+// Inspect traverses the syntax tree of t in depth-first order, calling fn for each
+// node. If fn returns false, the children of the node are not visited.
+func Inspect(t *Template, fn func(Node) bool) {
+	inspectNodes(t.Nodes, fn)
+}
+
+func inspectNodes(nodes []Node, fn func(Node) bool) {
+	for _, n := range nodes {
+		inspect(n, fn)
+	}
+}
+
+func inspect(n Node, fn func(Node) bool) {
+	if !fn(n) {
+		return
+	}
+	switch n := n.(type) {
+	case *Output:
+		inspect(n.Expr, fn)
+	case *If:
+		for i := range n.Conds {
+			inspect(n.Conds[i], fn)
+			inspectNodes(n.Bodies[i], fn)
+		}
+		inspectNodes(n.Else, fn)
+	case *For:
+		inspect(n.Iter, fn)
+		inspectNodes(n.Body, fn)
+	case *Set:
+		inspect(n.Value, fn)
+	case *Call:
+		for _, a := range n.Args {
+			inspect(a, fn)
+		}
+	case *Unary:
+		inspect(n.X, fn)
+	case *Binary:
+		inspect(n.X, fn)
+		inspect(n.Y, fn)
+	case *List:
+		for _, e := range n.Elems {
+			inspect(e, fn)
+		}
+	case *Object:
+		for _, v := range n.Values {
+			inspect(v, fn)
+		}
+	}
+}
@@ -0,0 +1,347 @@
+package inja
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// builtins are the Inja built-in functions. default, exists and existsIn are evaluated by
+// the renderer, as they need the unevaluated arguments or the template data.
+var builtins = map[string]function{
+	"at":          {2, 2, fnAt},
+	"capitalize":  {1, 1, stringFunc(capitalize)},
+	"default":     {2, 2, nil},
+	"divisibleBy": {2, 2, fnDivisibleBy},
+	"even":        {1, 1, parity(0)},
+	"exists":      {1, 1, nil},
+	"existsIn":    {2, 2, nil},
+	"first":       {1, 1, fnFirst},
+	"float":       {1, 1, fnFloat},
+	"int":         {1, 1, fnInt},
+	"isArray":     {1, 1, isType("array")},
+	"isBoolean":   {1, 1, isType("boolean")},
+	"isFloat":     {1, 1, fnIsFloat},
+	"isInteger":   {1, 1, fnIsInteger},
+	"isNumber":    {1, 1, isType("number")},
+	"isObject":    {1, 1, isType("object")},
+	"isString":    {1, 1, isType("string")},
+	"join":        {2, 2, fnJoin},
+	"last":        {1, 1, fnLast},
+	"length":      {1, 1, fnLength},
+	"lower":       {1, 1, stringFunc(strings.ToLower)},
+	"max":         {1, 1, extremum(1)},
+	"min":         {1, 1, extremum(-1)},
+	"odd":         {1, 1, parity(1)},
+	"range":       {1, 1, fnRange},
+	"replace":     {3, 3, fnReplace},
+	"round":       {2, 2, fnRound},
+	"sort":        {1, 1, fnSort},
+	"upper":       {1, 1, stringFunc(strings.ToUpper)},
+}
+
+func argString(v interface{}) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("expected string, got %s", typeName(v))
+	}
+	return s, nil
+}
+
+func argArray(v interface{}) ([]interface{}, error) {
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected array, got %s", typeName(v))
+	}
+	return list, nil
+}
+
+func argInt(v interface{}) (int64, error) {
+	switch v := v.(type) {
+	case int64:
+		return v, nil
+	case float64:
+		if v == math.Trunc(v) {
+			return int64(v), nil
+		}
+	}
+	return 0, fmt.Errorf("expected integer, got %s", typeName(v))
+}
+
+func stringFunc(fn func(string) string) Func {
+	return func(args []interface{}) (interface{}, error) {
+		s, err := argString(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return fn(s), nil
+	}
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + strings.ToLower(s[1:])
+}
+
+func isType(name string) Func {
+	return func(args []interface{}) (interface{}, error) {
+		return typeName(args[0]) == name, nil
+	}
+}
+
+func fnIsInteger(args []interface{}) (interface{}, error) {
+	_, ok := args[0].(int64)
+	return ok, nil
+}
+
+func fnIsFloat(args []interface{}) (interface{}, error) {
+	_, ok := args[0].(float64)
+	return ok, nil
+}
+
+func fnAt(args []interface{}) (interface{}, error) {
+	switch c := args[0].(type) {
+	case []interface{}:
+		i, err := argInt(args[1])
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || i >= int64(len(c)) {
+			return nil, fmt.Errorf("index %d out of range", i)
+		}
+		return c[i], nil
+	case map[string]interface{}:
+		k, err := argString(args[1])
+		if err != nil {
+			return nil, err
+		}
+		v, ok := c[k]
+		if !ok {
+			return nil, fmt.Errorf("key '%s' not found", k)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("expected array or object, got %s", typeName(args[0]))
+}
+
+func fnDivisibleBy(args []interface{}) (interface{}, error) {
+	x, err := argInt(args[0])
+	if err != nil {
+		return nil, err
+	}
+	y, err := argInt(args[1])
+	if err != nil {
+		return nil, err
+	}
+	return y != 0 && x%y == 0, nil
+}
+
+func parity(remainder int64) Func {
+	return func(args []interface{}) (interface{}, error) {
+		x, err := argInt(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return x%2 == remainder || -x%2 == remainder, nil
+	}
+}
+
+func fnFirst(args []interface{}) (interface{}, error) {
+	list, err := argArray(args[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.New("empty array")
+	}
+	return list[0], nil
+}
+
+func fnLast(args []interface{}) (interface{}, error) {
+	list, err := argArray(args[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.New("empty array")
+	}
+	return list[len(list)-1], nil
+}
+
+func fnFloat(args []interface{}) (interface{}, error) {
+	switch v := args[0].(type) {
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert %q to float", v)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("cannot convert %s to float", typeName(args[0]))
+}
+
+func fnInt(args []interface{}) (interface{}, error) {
+	switch v := args[0].(type) {
+	case int64:
+		return v, nil
+	case float64:
+		return int64(v), nil
+	case string:
+		s := strings.TrimSpace(v)
+		end := 0
+		if end < len(s) && (s[end] == '-' || s[end] == '+') {
+			end++
+		}
+		for end < len(s) && isDigit(s[end]) {
+			end++
+		}
+		// Like std::stoi, trailing characters after the number are ignored.
+		i, err := strconv.ParseInt(s[:end], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert %q to int", v)
+		}
+		return i, nil
+	}
+	return nil, fmt.Errorf("cannot convert %s to int", typeName(args[0]))
+}
+
+func fnJoin(args []interface{}) (interface{}, error) {
+	list, err := argArray(args[0])
+	if err != nil {
+		return nil, err
+	}
+	sep, err := argString(args[1])
+	if err != nil {
+		return nil, err
+	}
+	parts := make([]string, len(list))
+	for i, item := range list {
+		if s, ok := item.(string); ok {
+			parts[i] = s
+		} else {
+			parts[i] = Dump(item)
+		}
+	}
+	return strings.Join(parts, sep), nil
+}
+
+func fnLength(args []interface{}) (interface{}, error) {
+	switch v := args[0].(type) {
+	case string:
+		return int64(len(v)), nil
+	case []interface{}:
+		return int64(len(v)), nil
+	case map[string]interface{}:
+		return int64(len(v)), nil
+	}
+	return nil, fmt.Errorf("expected string, array or object, got %s", typeName(args[0]))
+}
+
+func extremum(sign int) Func {
+	return func(args []interface{}) (interface{}, error) {
+		list, err := argArray(args[0])
+		if err != nil {
+			return nil, err
+		}
+		var best interface{}
+		for i, item := range list {
+			item = normalize(item)
+			if i == 0 {
+				best = item
+				continue
+			}
+			c, err := compare(item, best)
+			if err != nil {
+				return nil, err
+			}
+			if c*sign > 0 {
+				best = item
+			}
+		}
+		return best, nil
+	}
+}
+
+// maxRangeLength bounds the lists created by range, so that templates can not exhaust
+// the memory of the renderer.
+const maxRangeLength = 1 << 20
+
+func fnRange(args []interface{}) (interface{}, error) {
+	n, err := argInt(args[0])
+	if err != nil {
+		return nil, err
+	}
+	if n > maxRangeLength {
+		return nil, fmt.Errorf("range: %d exceeds the maximum length of %d", n, maxRangeLength)
+	}
+	list := make([]interface{}, 0, max(n, 0))
+	for i := int64(0); i < n; i++ {
+		list = append(list, i)
+	}
+	return list, nil
+}
+
+func fnReplace(args []interface{}) (interface{}, error) {
+	s, err := argString(args[0])
+	if err != nil {
+		return nil, err
+	}
+	old, err := argString(args[1])
+	if err != nil {
+		return nil, err
+	}
+	repl, err := argString(args[2])
+	if err != nil {
+		return nil, err
+	}
+	return strings.ReplaceAll(s, old, repl), nil
+}
+
+func fnRound(args []interface{}) (interface{}, error) {
+	x, ok := toFloat(args[0])
+	if !ok {
+		return nil, fmt.Errorf("expected number, got %s", typeName(args[0]))
+	}
+	precision, err := argInt(args[1])
+	if err != nil {
+		return nil, err
+	}
+	scale := math.Pow(10, float64(precision))
+	result := math.Round(x*scale) / scale
+	if precision == 0 {
+		return int64(result), nil
+	}
+	return result, nil
+}
+
+func fnSort(args []interface{}) (interface{}, error) {
+	list, err := argArray(args[0])
+	if err != nil {
+		return nil, err
+	}
+	sorted := make([]interface{}, len(list))
+	for i := range list {
+		sorted[i] = normalize(list[i])
+	}
+	var cmpErr error
+	sort.SliceStable(sorted, func(i, j int) bool {
+		c, err := compare(sorted[i], sorted[j])
+		if err != nil && cmpErr == nil {
+			cmpErr = err
+		}
+		return c < 0
+	})
+	if cmpErr != nil {
+		return nil, cmpErr
+	}
+	return sorted, nil
+}
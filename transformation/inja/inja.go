@@ -0,0 +1,169 @@
+// Package inja implements the subset of the Inja template language
+// (https://github.com/pantor/inja) used by the transformation filter of
+// Solo Enterprise for kgateway to render InjaTemplate values.
+//
+// Supported are expressions (`{{ }}`), the if, for, set and raw statements
+// (`{% %}`), comments (`{# #}`), whitespace control and the Inja built-in
+// functions. Templates are parsed into a syntax tree that can be inspected,
+// and rendered by an Env, which holds the callback functions available to
+// templates, against JSON data as returned by DecodeJSON.
+package inja
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Pos is a position in the template source.
+type Pos struct {
+	// Offset is the byte offset, starting at 0.
+	Offset int
+	// Line is the line number, starting at 1.
+	Line int
+	// Column is the column number in bytes, starting at 1.
+	Column int
+}
+
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Error is a parse or render error at a position in the template source.
+type Error struct {
+	Pos Pos
+	Msg string
+
+	// notFound is set for references to undefined variables, so that `default` can recover.
+	notFound bool
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Pos, e.Msg)
+}
+
+// Func is a callback function that can be called from templates. It receives the
+// evaluated arguments as JSON values.
+type Func func(args []interface{}) (interface{}, error)
+
+// RawString is a string value that is rendered as is, even when EscapeStrings is set.
+type RawString string
+
+// Template is a parsed template.
+type Template struct {
+	// Nodes are the top-level nodes of the template.
+	Nodes []Node
+}
+
+// Env holds the functions available to templates and the rendering options.
+type Env struct {
+	// PointerNotation selects JSON pointer notation (e.g. "time/start") for variable
+	// names instead of the default dot notation (e.g. "time.start").
+	PointerNotation bool
+
+	// EscapeStrings escapes the rendered strings so that they are valid inside a JSON string.
+	EscapeStrings bool
+
+	funcs map[string]function
+}
+
+type function struct {
+	minArgs, maxArgs int
+	call             Func
+}
+
+// NewEnv returns an Env with the Inja built-in functions.
+func NewEnv() *Env {
+	return &Env{funcs: map[string]function{}}
+}
+
+// AddFunc registers a callback function taking between minArgs and maxArgs arguments.
+// A negative maxArgs allows any number of arguments from minArgs on.
+func (e *Env) AddFunc(name string, minArgs, maxArgs int, fn Func) {
+	e.funcs[name] = function{minArgs: minArgs, maxArgs: maxArgs, call: fn}
+}
+
+// HasFunc reports whether name is a built-in or registered function.
+func (e *Env) HasFunc(name string) bool {
+	_, ok := e.lookupFunc(name)
+	return ok
+}
+
+// Funcs returns the sorted names of the built-in and registered functions.
+func (e *Env) Funcs() []string {
+	names := make([]string, 0, len(builtins)+len(e.funcs))
+	for name := range builtins {
+		names = append(names, name)
+	}
+	for name := range e.funcs {
+		if _, ok := builtins[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (e *Env) lookupFunc(name string) (function, bool) {
+	if fn, ok := e.funcs[name]; ok {
+		return fn, true
+	}
+	fn, ok := builtins[name]
+	return fn, ok
+}
+
+// CheckCall returns an error if the called function is unknown or called with the wrong
+// number of arguments.
+func (e *Env) CheckCall(c *Call) error {
+	fn, ok := e.lookupFunc(c.Name)
+	if !ok {
+		return &Error{Pos: c.Pos(), Msg: fmt.Sprintf("unknown function %s", c.Name)}
+	}
+	n := len(c.Args)
+	if n < fn.minArgs || (fn.maxArgs >= 0 && n > fn.maxArgs) {
+		return &Error{Pos: c.Pos(), Msg: fmt.Sprintf("function %s called with %d arguments, expected %s", c.Name, n, arity(fn))}
+	}
+	return nil
+}
+
+func arity(fn function) string {
+	switch {
+	case fn.maxArgs < 0:
+		return fmt.Sprintf("at least %d", fn.minArgs)
+	case fn.minArgs == fn.maxArgs:
+		return fmt.Sprintf("%d", fn.minArgs)
+	default:
+		return fmt.Sprintf("%d to %d", fn.minArgs, fn.maxArgs)
+	}
+}
+
+// Parse parses src and checks that all called functions are known to the Env.
+func (e *Env) Parse(src string) (*Template, error) {
+	t, err := Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.check(t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (e *Env) check(t *Template) error {
+	var err error
+	Inspect(t, func(n Node) bool {
+		if c, ok := n.(*Call); ok && err == nil {
+			err = e.CheckCall(c)
+		}
+		return err == nil
+	})
+	return err
+}
+
+// splitName splits a variable name into the keys used to look it up in the data.
+func (e *Env) splitName(name string) []string {
+	if e.PointerNotation {
+		return strings.Split(strings.TrimPrefix(name, "/"), "/")
+	}
+	return strings.FieldsFunc(name, func(r rune) bool { return r == '.' || r == '/' })
+}
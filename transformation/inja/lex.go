@@ -0,0 +1,313 @@
+package inja
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenText
+	tokenExprOpen
+	tokenExprClose
+	tokenStmtOpen
+	tokenStmtClose
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenOp
+)
+
+type token struct {
+	kind tokenKind
+	// text is the source text of the token, or the unquoted value of a string.
+	text string
+	pos  Pos
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end"
+	case tokenText:
+		return "text"
+	case tokenString:
+		return fmt.Sprintf("%q", t.text)
+	default:
+		return fmt.Sprintf("'%s'", t.text)
+	}
+}
+
+var endRawPattern = regexp.MustCompile(`\{%(-?)\s*endraw\s*(-?)%\}`)
+
+// twoCharOps are the operators of two characters; all other operators are single characters.
+var twoCharOps = []string{"==", "!=", "<=", ">="}
+
+type lexer struct {
+	src        string
+	lineStarts []int
+	tokens     []token
+	// trimNext is set after a closing delimiter with whitespace control, `-}}`.
+	trimNext bool
+}
+
+func lex(src string) ([]token, error) {
+	l := &lexer{src: src, lineStarts: []int{0}}
+	for i := 0; i < len(src); i++ {
+		if src[i] == '\n' {
+			l.lineStarts = append(l.lineStarts, i+1)
+		}
+	}
+	if err := l.run(); err != nil {
+		return nil, err
+	}
+	return l.tokens, nil
+}
+
+func (l *lexer) pos(offset int) Pos {
+	line := sort.Search(len(l.lineStarts), func(i int) bool { return l.lineStarts[i] > offset })
+	return Pos{Offset: offset, Line: line, Column: offset - l.lineStarts[line-1] + 1}
+}
+
+func (l *lexer) errorf(offset int, format string, args ...interface{}) error {
+	return &Error{Pos: l.pos(offset), Msg: fmt.Sprintf(format, args...)}
+}
+
+func (l *lexer) emit(kind tokenKind, text string, offset int) {
+	l.tokens = append(l.tokens, token{kind: kind, text: text, pos: l.pos(offset)})
+}
+
+func (l *lexer) emitText(start, end int) {
+	text := l.src[start:end]
+	if l.trimNext {
+		trimmed := strings.TrimLeft(text, " \t\r\n")
+		start += len(text) - len(trimmed)
+		text = trimmed
+		l.trimNext = false
+	}
+	if text != "" {
+		l.emit(tokenText, text, start)
+	}
+}
+
+// trimLastText removes the trailing whitespace of the preceding text, for `{{-`.
+func (l *lexer) trimLastText() {
+	n := len(l.tokens)
+	if n == 0 || l.tokens[n-1].kind != tokenText {
+		return
+	}
+	l.tokens[n-1].text = strings.TrimRight(l.tokens[n-1].text, " \t\r\n")
+	if l.tokens[n-1].text == "" {
+		l.tokens = l.tokens[:n-1]
+	}
+}
+
+func (l *lexer) run() error {
+	off := 0
+	for off < len(l.src) {
+		start := nextTag(l.src, off)
+		if start < 0 {
+			l.emitText(off, len(l.src))
+			break
+		}
+		l.emitText(off, start)
+		kind := l.src[start+1]
+		off = start + 2
+		if off < len(l.src) && l.src[off] == '-' {
+			l.trimLastText()
+			off++
+		}
+
+		var err error
+		switch kind {
+		case '#':
+			end := strings.Index(l.src[off:], "#}")
+			if end < 0 {
+				return l.errorf(start, "unclosed comment")
+			}
+			end += off
+			l.trimNext = end > off && l.src[end-1] == '-'
+			off = end + 2
+		case '{':
+			l.emit(tokenExprOpen, "{{", start)
+			off, err = l.lexTag(off, start, "}}", tokenExprClose)
+		case '%':
+			l.emit(tokenStmtOpen, "{%", start)
+			if off, err = l.lexTag(off, start, "%}", tokenStmtClose); err == nil {
+				off, err = l.lexRaw(off, start)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	l.emit(tokenEOF, "", len(l.src))
+	return nil
+}
+
+// nextTag returns the offset of the next opening delimiter at or after off, or -1.
+func nextTag(src string, off int) int {
+	for {
+		i := strings.IndexByte(src[off:], '{')
+		if i < 0 || off+i+1 >= len(src) {
+			return -1
+		}
+		off += i
+		switch src[off+1] {
+		case '{', '%', '#':
+			return off
+		}
+		off++
+	}
+}
+
+// lexRaw turns the content of a `{% raw %}` statement that was just lexed into text.
+func (l *lexer) lexRaw(off, start int) (int, error) {
+	n := len(l.tokens)
+	if n < 3 || l.tokens[n-2].kind != tokenIdent || l.tokens[n-2].text != "raw" || l.tokens[n-3].kind != tokenStmtOpen {
+		return off, nil
+	}
+	l.tokens = l.tokens[:n-3]
+	m := endRawPattern.FindStringSubmatchIndex(l.src[off:])
+	if m == nil {
+		return 0, l.errorf(start, "unmatched raw")
+	}
+	l.emitText(off, off+m[0])
+	if m[3] > m[2] {
+		l.trimLastText()
+	}
+	l.trimNext = m[5] > m[4]
+	return off + m[1], nil
+}
+
+// lexTag lexes the content of a tag opened at start, up to and including the closing delimiter.
+func (l *lexer) lexTag(off, start int, closing string, closeKind tokenKind) (int, error) {
+	for {
+		for off < len(l.src) && isSpace(l.src[off]) {
+			off++
+		}
+		if off >= len(l.src) {
+			return 0, l.errorf(start, "expected %s close, got end", tagName(closeKind))
+		}
+		rest := l.src[off:]
+		switch {
+		case strings.HasPrefix(rest, closing):
+			l.emit(closeKind, closing, off)
+			return off + len(closing), nil
+		case rest[0] == '-' && strings.HasPrefix(rest[1:], closing):
+			l.emit(closeKind, closing, off)
+			l.trimNext = true
+			return off + 1 + len(closing), nil
+		case strings.HasPrefix(rest, "{{"), strings.HasPrefix(rest, "{%"),
+			closeKind == tokenExprClose && strings.HasPrefix(rest, "%}"):
+			return 0, l.errorf(off, "expected %s close, got '%s'", tagName(closeKind), rest[:2])
+		}
+
+		c := rest[0]
+		switch {
+		case isIdentStart(c):
+			end := 1
+			for end < len(rest) && isIdentChar(rest[end]) {
+				if rest[end] == '-' && (strings.HasPrefix(rest[end+1:], closing)) {
+					break
+				}
+				end++
+			}
+			l.emit(tokenIdent, rest[:end], off)
+			off += end
+		case isDigit(c):
+			end := scanNumber(rest)
+			l.emit(tokenNumber, rest[:end], off)
+			off += end
+		case c == '"':
+			end := 1
+			for end < len(rest) && rest[end] != '"' {
+				if rest[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(rest) {
+				return 0, l.errorf(off, "unterminated string")
+			}
+			var s string
+			if err := json.Unmarshal([]byte(rest[:end+1]), &s); err != nil {
+				return 0, l.errorf(off, "invalid string %s", rest[:end+1])
+			}
+			l.emit(tokenString, s, off)
+			off += end + 1
+		default:
+			op := ""
+			for _, o := range twoCharOps {
+				if strings.HasPrefix(rest, o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				if !strings.ContainsRune("()[]{},:|+-*/%^<>=", rune(c)) {
+					return 0, l.errorf(off, "unexpected character %q", rune(c))
+				}
+				op = rest[:1]
+			}
+			l.emit(tokenOp, op, off)
+			off += len(op)
+		}
+	}
+}
+
+func tagName(closeKind tokenKind) string {
+	if closeKind == tokenExprClose {
+		return "expression"
+	}
+	return "statement"
+}
+
+func scanNumber(s string) int {
+	end := 0
+	for end < len(s) && isDigit(s[end]) {
+		end++
+	}
+	if end+1 < len(s) && s[end] == '.' && isDigit(s[end+1]) {
+		end++
+		for end < len(s) && isDigit(s[end]) {
+			end++
+		}
+	}
+	if end < len(s) && (s[end] == 'e' || s[end] == 'E') {
+		exp := end + 1
+		if exp < len(s) && (s[exp] == '+' || s[exp] == '-') {
+			exp++
+		}
+		if exp < len(s) && isDigit(s[exp]) {
+			end = exp
+			for end < len(s) && isDigit(s[end]) {
+				end++
+			}
+		}
+	}
+	return end
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_' || c == '@'
+}
+
+// isIdentChar reports whether c can appear in an identifier. As in Inja, identifiers
+// may contain '.', '/' and '-', so that `my-extractor` and `time/start` are single names.
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || isDigit(c) || c == '.' || c == '/' || c == '-'
+}
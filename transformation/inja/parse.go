@@ -0,0 +1,463 @@
+package inja
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse parses src into a Template. It only checks the syntax; use Env.Parse to also
+// check the called functions.
+func Parse(src string) (*Template, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	nodes, end, tok, err := p.parseNodes()
+	if err != nil {
+		return nil, err
+	}
+	if end != "" {
+		return nil, p.errorf(tok, "unexpected %s", tok)
+	}
+	return &Template{Nodes: nodes}, nil
+}
+
+type parser struct {
+	tokens []token
+	i      int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.i]
+	if t.kind != tokenEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) error {
+	return &Error{Pos: t.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) isOp(op string) bool {
+	t := p.peek()
+	return t.kind == tokenOp && t.text == op
+}
+
+func (p *parser) isKeyword(kw string) bool {
+	t := p.peek()
+	return t.kind == tokenIdent && t.text == kw
+}
+
+func (p *parser) expectOp(op string) error {
+	if t := p.next(); t.kind != tokenOp || t.text != op {
+		return p.errorf(t, "expected '%s', got %s", op, t)
+	}
+	return nil
+}
+
+func (p *parser) expectStmtClose() error {
+	if t := p.next(); t.kind != tokenStmtClose {
+		return p.errorf(t, "expected statement close, got %s", t)
+	}
+	return nil
+}
+
+// parseNodes parses nodes up to the end of the template or a statement whose keyword
+// is one of ends. It returns that keyword, or "" at the end of the template, and its token.
+func (p *parser) parseNodes(ends ...string) ([]Node, string, token, error) {
+	var nodes []Node
+	for {
+		t := p.next()
+		switch t.kind {
+		case tokenEOF:
+			return nodes, "", t, nil
+		case tokenText:
+			nodes = append(nodes, &Text{pos: t.pos, Text: t.text})
+		case tokenExprOpen:
+			expr, err := p.parseExpr()
+			if err != nil {
+				return nil, "", t, err
+			}
+			if c := p.next(); c.kind != tokenExprClose {
+				return nil, "", t, p.errorf(c, "expected expression close, got %s", c)
+			}
+			nodes = append(nodes, &Output{pos: t.pos, Expr: expr})
+		case tokenStmtOpen:
+			kw := p.next()
+			if kw.kind != tokenIdent {
+				return nil, "", t, p.errorf(kw, "expected statement, got %s", kw)
+			}
+			for _, end := range ends {
+				if kw.text == end {
+					return nodes, end, kw, nil
+				}
+			}
+			var (
+				node Node
+				err  error
+			)
+			switch kw.text {
+			case "if":
+				node, err = p.parseIf(t)
+			case "for":
+				node, err = p.parseFor(t)
+			case "set":
+				node, err = p.parseSet(t)
+			case "else", "endif":
+				err = p.errorf(kw, "%s without matching if", kw.text)
+			case "endfor":
+				err = p.errorf(kw, "endfor without matching for")
+			case "endraw":
+				err = p.errorf(kw, "endraw without matching raw")
+			default:
+				err = p.errorf(kw, "unknown statement %s", kw.text)
+			}
+			if err != nil {
+				return nil, "", t, err
+			}
+			nodes = append(nodes, node)
+		default:
+			return nil, "", t, p.errorf(t, "unexpected %s", t)
+		}
+	}
+}
+
+func (p *parser) parseIf(open token) (Node, error) {
+	n := &If{pos: open.pos}
+	cond, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectStmtClose(); err != nil {
+		return nil, err
+	}
+	for {
+		body, end, _, err := p.parseNodes("else", "endif")
+		if err != nil {
+			return nil, err
+		}
+		if end == "" {
+			return nil, p.errorf(open, "unmatched if")
+		}
+		n.Conds = append(n.Conds, cond)
+		n.Bodies = append(n.Bodies, body)
+		if end == "endif" {
+			return n, p.expectStmtClose()
+		}
+		if p.isKeyword("if") {
+			p.next()
+			if cond, err = p.parseExpr(); err != nil {
+				return nil, err
+			}
+			if err := p.expectStmtClose(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := p.expectStmtClose(); err != nil {
+			return nil, err
+		}
+		body, end, _, err = p.parseNodes("endif")
+		if err != nil {
+			return nil, err
+		}
+		if end == "" {
+			return nil, p.errorf(open, "unmatched if")
+		}
+		n.Else = body
+		return n, p.expectStmtClose()
+	}
+}
+
+func (p *parser) parseFor(open token) (Node, error) {
+	n := &For{pos: open.pos}
+	v := p.next()
+	if v.kind != tokenIdent {
+		return nil, p.errorf(v, "expected loop variable, got %s", v)
+	}
+	if p.isOp(",") {
+		p.next()
+		n.Key = v.text
+		if v = p.next(); v.kind != tokenIdent {
+			return nil, p.errorf(v, "expected loop variable, got %s", v)
+		}
+	}
+	n.Value = v.text
+	if in := p.next(); in.kind != tokenIdent || in.text != "in" {
+		return nil, p.errorf(in, "expected 'in', got %s", in)
+	}
+	iter, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	n.Iter = iter
+	if err := p.expectStmtClose(); err != nil {
+		return nil, err
+	}
+	body, end, _, err := p.parseNodes("endfor")
+	if err != nil {
+		return nil, err
+	}
+	if end == "" {
+		return nil, p.errorf(open, "unmatched for")
+	}
+	n.Body = body
+	return n, p.expectStmtClose()
+}
+
+func (p *parser) parseSet(open token) (Node, error) {
+	name := p.next()
+	if name.kind != tokenIdent {
+		return nil, p.errorf(name, "expected variable name, got %s", name)
+	}
+	if err := p.expectOp("="); err != nil {
+		return nil, err
+	}
+	value, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &Set{pos: open.pos, Name: name.text, Value: value}, p.expectStmtClose()
+}
+
+func (p *parser) parseExpr() (Expr, error) {
+	return p.parseOr()
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	x, err := p.parseAnd()
+	for err == nil && p.isKeyword("or") {
+		t := p.next()
+		var y Expr
+		if y, err = p.parseAnd(); err == nil {
+			x = &Binary{pos: t.pos, Op: "or", X: x, Y: y}
+		}
+	}
+	return x, err
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	x, err := p.parseNot()
+	for err == nil && p.isKeyword("and") {
+		t := p.next()
+		var y Expr
+		if y, err = p.parseNot(); err == nil {
+			x = &Binary{pos: t.pos, Op: "and", X: x, Y: y}
+		}
+	}
+	return x, err
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if p.isKeyword("not") {
+		t := p.next()
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &Unary{pos: t.pos, Op: "not", X: x}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (Expr, error) {
+	x, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	t := p.peek()
+	isCmp := t.kind == tokenOp && strings.Contains(" == != < <= > >= ", " "+t.text+" ")
+	if !isCmp && !p.isKeyword("in") {
+		return x, nil
+	}
+	p.next()
+	y, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	return &Binary{pos: t.pos, Op: t.text, X: x, Y: y}, nil
+}
+
+func (p *parser) parseAdditive() (Expr, error) {
+	x, err := p.parseMultiplicative()
+	for err == nil && (p.isOp("+") || p.isOp("-")) {
+		t := p.next()
+		var y Expr
+		if y, err = p.parseMultiplicative(); err == nil {
+			x = &Binary{pos: t.pos, Op: t.text, X: x, Y: y}
+		}
+	}
+	return x, err
+}
+
+func (p *parser) parseMultiplicative() (Expr, error) {
+	x, err := p.parsePower()
+	for err == nil && (p.isOp("*") || p.isOp("/") || p.isOp("%")) {
+		t := p.next()
+		var y Expr
+		if y, err = p.parsePower(); err == nil {
+			x = &Binary{pos: t.pos, Op: t.text, X: x, Y: y}
+		}
+	}
+	return x, err
+}
+
+func (p *parser) parsePower() (Expr, error) {
+	x, err := p.parseUnary()
+	if err != nil || !p.isOp("^") {
+		return x, err
+	}
+	t := p.next()
+	y, err := p.parsePower()
+	if err != nil {
+		return nil, err
+	}
+	return &Binary{pos: t.pos, Op: "^", X: x, Y: y}, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	if p.isOp("-") {
+		t := p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &Unary{pos: t.pos, Op: "-", X: x}, nil
+	}
+	return p.parsePipe()
+}
+
+func (p *parser) parsePipe() (Expr, error) {
+	x, err := p.parsePrimary()
+	for err == nil && p.isOp("|") {
+		p.next()
+		name := p.next()
+		if name.kind != tokenIdent {
+			return nil, p.errorf(name, "expected function name, got %s", name)
+		}
+		call := &Call{pos: name.pos, Name: name.text, Args: []Expr{x}}
+		if p.isOp("(") {
+			p.next()
+			args, err := p.parseList(")")
+			if err != nil {
+				return nil, err
+			}
+			call.Args = append(call.Args, args...)
+		}
+		x = call
+	}
+	return x, err
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenNumber:
+		if !strings.ContainsAny(t.text, ".eE") {
+			if i, err := strconv.ParseInt(t.text, 10, 64); err == nil {
+				return &Literal{pos: t.pos, Value: i}, nil
+			}
+		}
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, p.errorf(t, "invalid number %s", t.text)
+		}
+		return &Literal{pos: t.pos, Value: f}, nil
+	case tokenString:
+		return &Literal{pos: t.pos, Value: t.text}, nil
+	case tokenIdent:
+		switch t.text {
+		case "true":
+			return &Literal{pos: t.pos, Value: true}, nil
+		case "false":
+			return &Literal{pos: t.pos, Value: false}, nil
+		case "null":
+			return &Literal{pos: t.pos, Value: nil}, nil
+		case "and", "or", "not", "in":
+			return nil, p.errorf(t, "unexpected %s", t)
+		}
+		if p.isOp("(") {
+			p.next()
+			args, err := p.parseList(")")
+			if err != nil {
+				return nil, err
+			}
+			return &Call{pos: t.pos, Name: t.text, Args: args}, nil
+		}
+		return &Variable{pos: t.pos, Name: t.text}, nil
+	case tokenOp:
+		switch t.text {
+		case "(":
+			x, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			return x, p.expectOp(")")
+		case "[":
+			elems, err := p.parseList("]")
+			if err != nil {
+				return nil, err
+			}
+			return &List{pos: t.pos, Elems: elems}, nil
+		case "{":
+			return p.parseObject(t)
+		}
+	}
+	return nil, p.errorf(t, "expected expression, got %s", t)
+}
+
+// parseList parses comma separated expressions up to the closing operator.
+func (p *parser) parseList(closing string) ([]Expr, error) {
+	var list []Expr
+	if p.isOp(closing) {
+		p.next()
+		return list, nil
+	}
+	for {
+		x, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, x)
+		if p.isOp(",") {
+			p.next()
+			continue
+		}
+		return list, p.expectOp(closing)
+	}
+}
+
+func (p *parser) parseObject(open token) (Expr, error) {
+	obj := &Object{pos: open.pos}
+	if p.isOp("}") {
+		p.next()
+		return obj, nil
+	}
+	for {
+		key := p.next()
+		if key.kind != tokenString {
+			return nil, p.errorf(key, "expected object key, got %s", key)
+		}
+		if err := p.expectOp(":"); err != nil {
+			return nil, err
+		}
+		value, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		obj.Keys = append(obj.Keys, key.text)
+		obj.Values = append(obj.Values, value)
+		if p.isOp(",") {
+			p.next()
+			continue
+		}
+		return obj, p.expectOp("}")
+	}
+}
@@ -0,0 +1,467 @@
+package inja
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Render renders t against data, which is typically the result of DecodeJSON.
+// Variables are looked up in the loop variables, then in the variables assigned with
+// set statements, then in data.
+func (e *Env) Render(t *Template, data interface{}) (string, error) {
+	if err := e.check(t); err != nil {
+		return "", err
+	}
+	s := &state{env: e, data: normalize(data), set: map[string]interface{}{}}
+	if err := s.renderNodes(t.Nodes); err != nil {
+		return "", err
+	}
+	return s.out.String(), nil
+}
+
+// RenderString parses and renders src.
+func (e *Env) RenderString(src string, data interface{}) (string, error) {
+	t, err := e.Parse(src)
+	if err != nil {
+		return "", err
+	}
+	return e.Render(t, data)
+}
+
+type state struct {
+	env    *Env
+	data   interface{}
+	set    map[string]interface{}
+	scopes []map[string]interface{}
+	out    strings.Builder
+}
+
+func errorAt(n Node, format string, args ...interface{}) error {
+	return &Error{Pos: n.Pos(), Msg: fmt.Sprintf(format, args...)}
+}
+
+func (s *state) renderNodes(nodes []Node) error {
+	for _, n := range nodes {
+		if err := s.render(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *state) render(n Node) error {
+	switch n := n.(type) {
+	case *Text:
+		s.out.WriteString(n.Text)
+	case *Output:
+		v, err := s.evalRaw(n.Expr)
+		if err != nil {
+			return err
+		}
+		switch v := v.(type) {
+		case RawString:
+			s.out.WriteString(string(v))
+		case string:
+			if s.env.EscapeStrings {
+				v = escape(v)
+			}
+			s.out.WriteString(v)
+		default:
+			s.out.WriteString(Dump(v))
+		}
+	case *If:
+		for i, cond := range n.Conds {
+			v, err := s.eval(cond)
+			if err != nil {
+				return err
+			}
+			if truthy(v) {
+				return s.renderNodes(n.Bodies[i])
+			}
+		}
+		return s.renderNodes(n.Else)
+	case *For:
+		return s.renderFor(n)
+	case *Set:
+		v, err := s.eval(n.Value)
+		if err != nil {
+			return err
+		}
+		setPath(s.set, s.env.splitName(n.Name), v)
+	}
+	return nil
+}
+
+func (s *state) renderFor(n *For) error {
+	v, err := s.eval(n.Iter)
+	if err != nil {
+		return err
+	}
+	var parent interface{}
+	if len(s.scopes) > 0 {
+		parent = s.scopes[len(s.scopes)-1]["loop"]
+	}
+	iterate := func(i, count int, vars map[string]interface{}) error {
+		vars["loop"] = map[string]interface{}{
+			"index":    int64(i),
+			"index1":   int64(i + 1),
+			"is_first": i == 0,
+			"is_last":  i == count-1,
+			"parent":   parent,
+		}
+		s.scopes = append(s.scopes, vars)
+		defer func() { s.scopes = s.scopes[:len(s.scopes)-1] }()
+		return s.renderNodes(n.Body)
+	}
+
+	if n.Key == "" {
+		list, ok := v.([]interface{})
+		if !ok {
+			return errorAt(n.Iter, "cannot iterate over %s with a single loop variable", typeName(v))
+		}
+		for i, item := range list {
+			if err := iterate(i, len(list), map[string]interface{}{n.Value: normalize(item)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return errorAt(n.Iter, "cannot iterate over %s with key and value loop variables", typeName(v))
+	}
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if err := iterate(i, len(keys), map[string]interface{}{n.Key: k, n.Value: normalize(obj[k])}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *state) lookup(name string) (interface{}, bool) {
+	keys := s.env.splitName(name)
+	if len(keys) == 0 {
+		return s.data, true
+	}
+	for i := len(s.scopes) - 1; i >= 0; i-- {
+		if v, ok := s.scopes[i][keys[0]]; ok {
+			return descend(v, keys[1:])
+		}
+	}
+	if v, ok := s.set[keys[0]]; ok {
+		return descend(v, keys[1:])
+	}
+	return descend(s.data, keys)
+}
+
+func descend(v interface{}, keys []string) (interface{}, bool) {
+	for _, k := range keys {
+		switch c := v.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if v, ok = c[k]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(k)
+			if err != nil || i < 0 || i >= len(c) {
+				return nil, false
+			}
+			v = c[i]
+		default:
+			return nil, false
+		}
+	}
+	return normalize(v), true
+}
+
+func setPath(m map[string]interface{}, keys []string, v interface{}) {
+	for _, k := range keys[:len(keys)-1] {
+		child, ok := m[k].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			m[k] = child
+		}
+		m = child
+	}
+	m[keys[len(keys)-1]] = v
+}
+
+// eval evaluates e to a template value.
+func (s *state) eval(e Expr) (interface{}, error) {
+	v, err := s.evalRaw(e)
+	return normalize(v), err
+}
+
+// evalRaw evaluates e, preserving a RawString result.
+func (s *state) evalRaw(e Expr) (interface{}, error) {
+	switch e := e.(type) {
+	case *Literal:
+		return e.Value, nil
+	case *Variable:
+		v, ok := s.lookup(e.Name)
+		if !ok {
+			return nil, &Error{Pos: e.Pos(), Msg: fmt.Sprintf("variable '%s' not found", e.Name), notFound: true}
+		}
+		return v, nil
+	case *List:
+		list := make([]interface{}, 0, len(e.Elems))
+		for _, elem := range e.Elems {
+			v, err := s.eval(elem)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+	case *Object:
+		obj := make(map[string]interface{}, len(e.Keys))
+		for i, k := range e.Keys {
+			v, err := s.eval(e.Values[i])
+			if err != nil {
+				return nil, err
+			}
+			obj[k] = v
+		}
+		return obj, nil
+	case *Unary:
+		x, err := s.eval(e.X)
+		if err != nil {
+			return nil, err
+		}
+		if e.Op == "not" {
+			return !truthy(x), nil
+		}
+		switch x := x.(type) {
+		case int64:
+			return -x, nil
+		case float64:
+			return -x, nil
+		}
+		return nil, errorAt(e, "cannot negate %s", typeName(x))
+	case *Binary:
+		return s.evalBinary(e)
+	case *Call:
+		return s.call(e)
+	}
+	return nil, errorAt(e, "unsupported expression %T", e)
+}
+
+func (s *state) evalBinary(e *Binary) (interface{}, error) {
+	x, err := s.eval(e.X)
+	if err != nil {
+		return nil, err
+	}
+	switch e.Op {
+	case "and":
+		if !truthy(x) {
+			return false, nil
+		}
+		y, err := s.eval(e.Y)
+		return truthy(y), err
+	case "or":
+		if truthy(x) {
+			return true, nil
+		}
+		y, err := s.eval(e.Y)
+		return truthy(y), err
+	}
+	y, err := s.eval(e.Y)
+	if err != nil {
+		return nil, err
+	}
+	v, err := binaryOp(e.Op, x, y)
+	if err != nil {
+		return nil, errorAt(e, "%v", err)
+	}
+	return v, nil
+}
+
+func binaryOp(op string, x, y interface{}) (interface{}, error) {
+	switch op {
+	case "==":
+		return equal(x, y), nil
+	case "!=":
+		return !equal(x, y), nil
+	case "<", "<=", ">", ">=":
+		c, err := compare(x, y)
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case "<":
+			return c < 0, nil
+		case "<=":
+			return c <= 0, nil
+		case ">":
+			return c > 0, nil
+		}
+		return c >= 0, nil
+	case "in":
+		list, ok := y.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("right operand of 'in' must be an array, got %s", typeName(y))
+		}
+		for _, item := range list {
+			if equal(x, normalize(item)) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "+":
+		if sx, ok := x.(string); ok {
+			if sy, ok := y.(string); ok {
+				return sx + sy, nil
+			}
+		}
+	}
+
+	ix, xInt := x.(int64)
+	iy, yInt := y.(int64)
+	fx, xNum := toFloat(x)
+	fy, yNum := toFloat(y)
+	if !xNum || !yNum {
+		return nil, fmt.Errorf("operator %s is not defined for %s and %s", op, typeName(x), typeName(y))
+	}
+	ints := xInt && yInt
+	switch op {
+	case "+":
+		if ints {
+			return ix + iy, nil
+		}
+		return fx + fy, nil
+	case "-":
+		if ints {
+			return ix - iy, nil
+		}
+		return fx - fy, nil
+	case "*":
+		if ints {
+			return ix * iy, nil
+		}
+		return fx * fy, nil
+	case "/":
+		if fy == 0 {
+			return nil, errors.New("division by zero")
+		}
+		return fx / fy, nil
+	case "%":
+		if !ints {
+			return nil, fmt.Errorf("operator %% requires integers")
+		}
+		if iy == 0 {
+			return nil, errors.New("division by zero")
+		}
+		return ix % iy, nil
+	case "^":
+		if ints && iy >= 0 {
+			if result, ok := intPow(ix, iy); ok {
+				return result, nil
+			}
+		}
+		return math.Pow(fx, fy), nil
+	}
+	return nil, fmt.Errorf("unknown operator %s", op)
+}
+
+// intPow returns base raised to the non-negative exp, by squaring, and false if the result
+// overflows an int64, in which case the caller falls back to floating point as JSON does.
+func intPow(base, exp int64) (int64, bool) {
+	result := int64(1)
+	for exp > 0 {
+		var ok bool
+		if exp&1 == 1 {
+			if result, ok = mulInt64(result, base); !ok {
+				return 0, false
+			}
+		}
+		exp >>= 1
+		if exp > 0 {
+			if base, ok = mulInt64(base, base); !ok {
+				return 0, false
+			}
+		}
+	}
+	return result, true
+}
+
+// mulInt64 returns a*b, and false if it overflows an int64.
+func mulInt64(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	c := a * b
+	if c/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return c, true
+}
+
+func (s *state) call(e *Call) (interface{}, error) {
+	switch e.Name {
+	case "default":
+		v, err := s.eval(e.Args[0])
+		var injaErr *Error
+		if errors.As(err, &injaErr) && injaErr.notFound {
+			return s.eval(e.Args[1])
+		}
+		return v, err
+	case "exists":
+		name, err := s.eval(e.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		n, ok := name.(string)
+		if !ok {
+			return nil, errorAt(e, "exists: argument must be a string, got %s", typeName(name))
+		}
+		_, found := s.lookup(n)
+		return found, nil
+	case "existsIn":
+		obj, err := s.eval(e.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		name, err := s.eval(e.Args[1])
+		if err != nil {
+			return nil, err
+		}
+		n, ok := name.(string)
+		if !ok {
+			return nil, errorAt(e, "existsIn: second argument must be a string, got %s", typeName(name))
+		}
+		_, found := descend(obj, s.env.splitName(n))
+		return found, nil
+	}
+
+	fn, ok := s.env.lookupFunc(e.Name)
+	if !ok {
+		return nil, errorAt(e, "unknown function %s", e.Name)
+	}
+	args := make([]interface{}, 0, len(e.Args))
+	for _, a := range e.Args {
+		v, err := s.eval(a)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, v)
+	}
+	v, err := fn.call(args)
+	if err != nil {
+		return nil, errorAt(e, "%s: %v", e.Name, err)
+	}
+	if _, raw := v.(RawString); raw {
+		return v, nil
+	}
+	return normalize(v), nil
+}
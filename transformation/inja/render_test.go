@@ -0,0 +1,117 @@
+package inja
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestRenderString(t *testing.T) {
+	data, err := DecodeJSON([]byte(`{
+		"name": "world",
+		"n": 3,
+		"user": {"id": 7, "roles": ["admin", "dev"]},
+		"empty": [],
+		"quote": "a\"b"
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		src     string
+		want    string
+		wantErr string
+	}{
+		{name: "text", src: "hello", want: "hello"},
+		{name: "variable", src: "hello {{ name }}", want: "hello world"},
+		{name: "nested variable", src: "{{ user.id }}", want: "7"},
+		{name: "array", src: "{{ user.roles }}", want: `["admin","dev"]`},
+		{name: "undefined variable", src: "{{ missing }}", wantErr: "missing"},
+		{name: "default", src: `{{ default(missing, "x") }}`, want: "x"},
+		{name: "exists", src: `{{ exists("name") }} {{ exists("missing") }}`, want: "true false"},
+		{name: "integer arithmetic", src: "{{ n + 2 * 3 - 1 }}", want: "8"},
+		{name: "division is float", src: "{{ 7 / 2 }}", want: "3.5"},
+		{name: "modulo", src: "{{ 7 % 3 }}", want: "1"},
+		{name: "division by zero", src: "{{ 1 / 0 }}", wantErr: "division by zero"},
+		{name: "power", src: "{{ 2 ^ 10 }}", want: "1024"},
+		{name: "power of negative base", src: "{{ -3 ^ 3 }}", want: "-27"},
+		{name: "power with zero exponent", src: "{{ 5 ^ 0 }}", want: "1"},
+		{name: "power with negative exponent", src: "{{ 2 ^ -1 }}", want: "0.5"},
+		{name: "power of one with huge exponent", src: "{{ 1 ^ 1000000000000 }}", want: "1"},
+		{name: "power largest int", src: "{{ 2 ^ 62 }}", want: "4611686018427387904"},
+		{name: "comparison", src: "{{ n > 2 and not (n == 4) }}", want: "true"},
+		{name: "if", src: "{% if n > 5 %}big{% else if n > 2 %}medium{% else %}small{% endif %}", want: "medium"},
+		{name: "for", src: "{% for r in user.roles %}{{ loop.index }}:{{ r }} {% endfor %}", want: "0:admin 1:dev "},
+		{name: "for over empty", src: "{% for r in empty %}x{% endfor %}", want: ""},
+		{name: "set", src: "{% set x = n * 2 %}{{ x }}", want: "6"},
+		{name: "raw", src: "{% raw %}{{ name }}{% endraw %}", want: "{{ name }}"},
+		{name: "comment", src: "a{# ignored #}b", want: "ab"},
+		{name: "whitespace control", src: "a  {{- name -}}  b", want: "aworldb"},
+		{name: "upper", src: "{{ upper(name) }}", want: "WORLD"},
+		{name: "length", src: "{{ length(user.roles) }}", want: "2"},
+		{name: "range", src: "{{ range(3) }}", want: "[0,1,2]"},
+		{name: "range too long", src: "{{ length(range(1000000000000)) }}", wantErr: "exceeds the maximum length"},
+		{name: "unknown function", src: "{{ nope() }}", wantErr: "unknown function nope"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEnv().RenderString(tt.src, data)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("RenderString(%q) error = %v, want error containing %q", tt.src, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RenderString(%q) error = %v", tt.src, err)
+			}
+			if got != tt.want {
+				t.Errorf("RenderString(%q) = %q, want %q", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPowerOverflowFallsBackToFloat(t *testing.T) {
+	tests := []struct {
+		x, y int64
+		want float64
+	}{
+		{2, 63, math.Pow(2, 63)},
+		{2, 1000, math.Pow(2, 1000)},
+		{-2, 1000000000000, math.Inf(1)},
+		{10, 19, 1e19},
+	}
+	for _, tt := range tests {
+		got, err := binaryOp("^", tt.x, tt.y)
+		if err != nil {
+			t.Fatalf("%d ^ %d: %v", tt.x, tt.y, err)
+		}
+		if f, ok := got.(float64); !ok || f != tt.want {
+			t.Errorf("%d ^ %d = %v (%T), want %v", tt.x, tt.y, got, got, tt.want)
+		}
+	}
+}
+
+func TestIntPow(t *testing.T) {
+	tests := []struct {
+		base, exp int64
+		want      int64
+		ok        bool
+	}{
+		{0, 0, 1, true},
+		{0, 5, 0, true},
+		{-1, 1000000000001, -1, true},
+		{3, 39, 4052555153018976267, true},
+		{3, 40, 0, false},
+		{-2, 63, math.MinInt64, true},
+		{2, 63, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := intPow(tt.base, tt.exp)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("intPow(%d, %d) = %d, %v, want %d, %v", tt.base, tt.exp, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,369 @@
+// Package transformation renders enterprise transformation templates
+// (enterprisekgateway.TransformationTemplate) against sample requests and
+// responses the way the transformation filter does at runtime, so that
+// templates can be previewed and tested without a gateway.
+//
+// Templates are written in Inja; see the inja package for the supported syntax.
+package transformation
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/enterprisekgateway"
+	"github.com/solo-io/kgateway-client/v2/transformation/inja"
+)
+
+// DefaultMetadataNamespace is the dynamic metadata namespace used when a
+// DynamicMetadataValue does not set one.
+const DefaultMetadataNamespace = "io.solo.transformation"
+
+// Message is an HTTP request or response to transform.
+type Message struct {
+	// Headers are the headers of the message. Requests should include the :method, :path,
+	// :authority and :scheme pseudo-headers and responses the :status pseudo-header.
+	Headers http.Header
+
+	// Body is the body of the message.
+	Body []byte
+
+	// RequestHeaders are the headers of the request a response belongs to. They are
+	// returned by the request_header function and default to Headers.
+	RequestHeaders http.Header
+}
+
+// NewRequestMessage returns the Message for req, including its pseudo-headers.
+// The body of req is read and replaced, so req can still be used.
+func NewRequestMessage(req *http.Request) (*Message, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &Message{Headers: requestHeaders(req), Body: body}, nil
+}
+
+// NewResponseMessage returns the Message for resp, including the :status pseudo-header.
+// The request headers are taken from resp.Request, if set. The body of resp is read and
+// replaced, so resp can still be used.
+func NewResponseMessage(resp *http.Response) (*Message, error) {
+	body, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	headers := resp.Header.Clone()
+	if headers == nil {
+		headers = http.Header{}
+	}
+	headers[":status"] = []string{strconv.Itoa(resp.StatusCode)}
+	msg := &Message{Headers: headers, Body: body}
+	if resp.Request != nil {
+		msg.RequestHeaders = requestHeaders(resp.Request)
+	}
+	return msg, nil
+}
+
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	if err != nil {
+		return nil, err
+	}
+	_ = (*body).Close()
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+func requestHeaders(req *http.Request) http.Header {
+	headers := req.Header.Clone()
+	if headers == nil {
+		headers = http.Header{}
+	}
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	host := req.Host
+	if host == "" && req.URL != nil {
+		host = req.URL.Host
+	}
+	path := req.RequestURI
+	if path == "" && req.URL != nil {
+		path = req.URL.RequestURI()
+	}
+	headers[":method"] = []string{req.Method}
+	headers[":path"] = []string{path}
+	headers[":authority"] = []string{host}
+	headers[":scheme"] = []string{scheme}
+	return headers
+}
+
+// Result is the outcome of rendering a TransformationTemplate.
+type Result struct {
+	// Headers are the transformed headers.
+	Headers http.Header
+
+	// Body is the transformed body.
+	Body []byte
+
+	// DynamicMetadata holds the rendered dynamic metadata, keyed by namespace and key.
+	DynamicMetadata map[string]map[string]interface{}
+
+	// SpanName is the rendered span name, if a span transformer is set.
+	SpanName string
+
+	// Extractions holds the values of the extractors, keyed by name.
+	Extractions map[string]string
+}
+
+// Renderer renders transformation templates.
+type Renderer struct {
+	// Env holds the environment variables returned by the env function.
+	Env map[string]string
+
+	// EscapeCharacters is the behavior inherited from StagedTransformations when the
+	// template does not set EscapeCharacters. Characters are not escaped by default.
+	EscapeCharacters *enterprisekgateway.EscapeCharactersBehavior
+}
+
+// Render renders tmpl against msg with a zero Renderer.
+func Render(tmpl *enterprisekgateway.TransformationTemplate, msg *Message) (*Result, error) {
+	return (&Renderer{}).Render(tmpl, msg)
+}
+
+// Render renders tmpl against msg and returns the transformed message.
+//
+// As in the filter, the body is parsed according to ParseBodyBehavior, the extractors
+// are run, and the dynamic metadata, headers, headers to append, headers to remove and
+// body are then rendered in that order. The header function observes the headers
+// rendered so far. Errors are prefixed with the path of the failing field.
+func (r *Renderer) Render(tmpl *enterprisekgateway.TransformationTemplate, msg *Message) (*Result, error) {
+	advanced := tmpl.AdvancedTemplates != nil && *tmpl.AdvancedTemplates
+	escapeBehavior := r.EscapeCharacters
+	if tmpl.EscapeCharacters != nil {
+		escapeBehavior = tmpl.EscapeCharacters
+	}
+	escape := escapeBehavior != nil && *escapeBehavior == enterprisekgateway.EscapeCharactersEscape
+
+	bodyType := enterprisekgateway.BodyTransformationType("")
+	if tmpl.BodyTransformation != nil {
+		bodyType = tmpl.BodyTransformation.Type
+	}
+
+	headers := msg.Headers.Clone()
+	if headers == nil {
+		headers = http.Header{}
+	}
+	res := &Result{Headers: headers, Body: msg.Body, Extractions: map[string]string{}}
+	ctx := &renderContext{msg: msg, headers: res.Headers, extractions: map[string]string{}, env: r.Env}
+
+	parseBehavior := enterprisekgateway.ParseAsJson
+	if tmpl.ParseBodyBehavior != nil {
+		parseBehavior = *tmpl.ParseBodyBehavior
+	}
+	if bodyType != enterprisekgateway.BodyTransformationTypePassthrough &&
+		parseBehavior == enterprisekgateway.ParseAsJson && len(msg.Body) > 0 {
+		body, err := inja.DecodeJSON(msg.Body)
+		if err != nil && (tmpl.IgnoreErrorOnParse == nil || !*tmpl.IgnoreErrorOnParse) {
+			return nil, fmt.Errorf("parsing body as JSON: %v", err)
+		}
+		ctx.body = body
+	}
+
+	extractorsPath := field.NewPath("extractors")
+	for _, name := range slices.Sorted(maps.Keys(tmpl.Extractors)) {
+		ext := tmpl.Extractors[name]
+		value, err := extract(&ext, msg.Headers, msg.Body)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", extractorsPath.Key(name), err)
+		}
+		res.Extractions[name] = value
+		if advanced {
+			ctx.extractions[name] = value
+		} else if ctx.body, err = mergeExtraction(ctx.body, name, value); err != nil {
+			// Without advanced templates, extractors are available as variables in the body.
+			return nil, fmt.Errorf("%s: %v", extractorsPath.Key(name), err)
+		}
+	}
+
+	env := newEnv(ctx, advanced, escape)
+	render := func(fldPath *field.Path, tmpl enterprisekgateway.InjaTemplate) (string, error) {
+		out, err := env.RenderString(string(tmpl), ctx.body)
+		if err != nil {
+			return "", fmt.Errorf("%s: %v", fldPath, err)
+		}
+		return out, nil
+	}
+
+	for i, md := range tmpl.DynamicMetadataValues {
+		fldPath := field.NewPath("dynamicMetadataValues").Index(i)
+		out, err := render(fldPath.Child("value"), md.Value)
+		if err != nil {
+			return nil, err
+		}
+		if out == "" {
+			continue
+		}
+		var value interface{} = out
+		if md.JsonToProto != nil && *md.JsonToProto {
+			if value, err = inja.DecodeJSON([]byte(out)); err != nil {
+				return nil, fmt.Errorf("%s: rendered value is not valid JSON: %v", fldPath.Child("value"), err)
+			}
+		}
+		namespace := DefaultMetadataNamespace
+		if md.MetadataNamespace != nil {
+			namespace = *md.MetadataNamespace
+		}
+		if res.DynamicMetadata == nil {
+			res.DynamicMetadata = map[string]map[string]interface{}{}
+		}
+		if res.DynamicMetadata[namespace] == nil {
+			res.DynamicMetadata[namespace] = map[string]interface{}{}
+		}
+		res.DynamicMetadata[namespace][md.Key] = value
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(tmpl.Headers)) {
+		out, err := render(field.NewPath("headers").Key(name), tmpl.Headers[name])
+		if err != nil {
+			return nil, err
+		}
+		// An empty value removes the header.
+		res.Headers.Del(name)
+		if out != "" {
+			res.Headers.Add(name, out)
+		}
+	}
+	for i, h := range tmpl.HeadersToAppend {
+		out, err := render(field.NewPath("headersToAppend").Index(i).Child("value"), h.Value)
+		if err != nil {
+			return nil, err
+		}
+		if out != "" {
+			res.Headers.Add(h.Key, out)
+		}
+	}
+	for _, name := range tmpl.HeadersToRemove {
+		res.Headers.Del(name)
+	}
+
+	bodyPath := field.NewPath("bodyTransformation")
+	bodyChanged := true
+	switch bodyType {
+	case enterprisekgateway.BodyTransformationTypeBody:
+		var src enterprisekgateway.InjaTemplate
+		if tmpl.BodyTransformation.Body != nil {
+			src = *tmpl.BodyTransformation.Body
+		}
+		out, err := render(bodyPath.Child("body"), src)
+		if err != nil {
+			return nil, err
+		}
+		res.Body = []byte(out)
+	case enterprisekgateway.BodyTransformationTypeMergeExtractorsToBody:
+		body := ctx.body
+		if advanced {
+			var err error
+			for _, name := range slices.Sorted(maps.Keys(res.Extractions)) {
+				if body, err = mergeExtraction(body, name, res.Extractions[name]); err != nil {
+					return nil, fmt.Errorf("%s: %v", extractorsPath.Key(name), err)
+				}
+			}
+		}
+		if body == nil {
+			body = map[string]interface{}{}
+		}
+		res.Body = []byte(inja.Dump(body))
+	case enterprisekgateway.BodyTransformationTypeMergeJsonKeys:
+		obj, ok := ctx.body.(map[string]interface{})
+		if ctx.body == nil {
+			obj, ok = map[string]interface{}{}, true
+		}
+		if !ok {
+			return nil, fmt.Errorf("%s: body must be a JSON object to merge keys", bodyPath.Child("mergeJsonKeys"))
+		}
+		merged := make(map[string]interface{}, len(obj))
+		for k, v := range obj {
+			merged[k] = v
+		}
+		for _, key := range slices.Sorted(maps.Keys(tmpl.BodyTransformation.MergeJsonKeys)) {
+			t := tmpl.BodyTransformation.MergeJsonKeys[key]
+			out, err := render(bodyPath.Child("mergeJsonKeys").Key(key).Child("tmpl"), t.Tmpl)
+			if err != nil {
+				return nil, err
+			}
+			if out == "" && (t.OverrideEmpty == nil || !*t.OverrideEmpty) {
+				continue
+			}
+			// Rendered values that are valid JSON are merged as JSON, others as strings.
+			var value interface{} = out
+			if v, err := inja.DecodeJSON([]byte(out)); err == nil {
+				value = v
+			}
+			merged[key] = value
+		}
+		res.Body = []byte(inja.Dump(merged))
+	default:
+		bodyChanged = false
+	}
+	if bodyChanged && res.Headers.Get("Content-Length") != "" {
+		res.Headers.Set("Content-Length", strconv.Itoa(len(res.Body)))
+	}
+
+	if tmpl.SpanTransformer != nil {
+		out, err := render(field.NewPath("spanTransformer", "name"), tmpl.SpanTransformer.Name)
+		if err != nil {
+			return nil, err
+		}
+		res.SpanName = out
+	}
+	return res, nil
+}
+
+// mergeExtraction sets the extracted value in body, where a dotted extractor name such as
+// "a.b" denotes a nested key. A nil body becomes an object.
+func mergeExtraction(body interface{}, name, value string) (interface{}, error) {
+	if body == nil {
+		body = map[string]interface{}{}
+	}
+	current, ok := body.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("cannot set extraction in a JSON body of type %s", jsonType(body))
+	}
+	keys := strings.Split(name, ".")
+	for _, k := range keys[:len(keys)-1] {
+		child, ok := current[k]
+		if !ok || child == nil {
+			child = map[string]interface{}{}
+			current[k] = child
+		}
+		if current, ok = child.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("cannot set extraction in a JSON value of type %s", jsonType(child))
+		}
+	}
+	current[keys[len(keys)-1]] = value
+	return body, nil
+}
+
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case int64, float64:
+		return "number"
+	}
+	return "object"
+}
@@ -0,0 +1,190 @@
+// Package wafdirectives assembles the SecLang directives of a WAFPolicy in the order the
+// WAF server loads them, resolving the ConfigMaps its directive sources reference.
+//
+// The directives are loaded in this order:
+//
+//  1. the rule engine settings,
+//  2. the CoreRuleSet settings, if the CoreRuleSet is enabled,
+//  3. the CoreRuleSet rules bundled with the WAF server, included by CoreRuleSetInclude,
+//  4. the custom directives, in the order they are listed.
+//
+// The directives of a ConfigMap are the values of the listed keys, in the order they are
+// listed, or the values of all its keys, in lexicographic order by key.
+package wafdirectives
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+
+	"github.com/solo-io/kgateway-client/v2/api/v1alpha1/waf"
+)
+
+// CoreRuleSetInclude is the directive that loads the CoreRuleSet rules bundled with the WAF
+// server.
+const CoreRuleSetInclude = "Include @owasp_crs/*.conf"
+
+// Source is where a chunk of directives comes from.
+type Source struct {
+	// Field is the path of the directive source in the policy, such as
+	// "spec.customDirectives[1]", or "spec.coreRuleSet" for the bundled rules.
+	Field *field.Path
+	// ConfigMap and Key are the ConfigMap and key holding the directives. ConfigMap is nil
+	// for inline directives and bundled rules.
+	ConfigMap *types.NamespacedName
+	Key       string
+}
+
+func (s Source) String() string {
+	switch {
+	case s.ConfigMap != nil:
+		return fmt.Sprintf("%s: ConfigMap %s key %s", s.Field, s.ConfigMap, s.Key)
+	case s.Field.String() == coreRuleSetPath.String():
+		return fmt.Sprintf("%s: bundled CoreRuleSet rules", s.Field)
+	default:
+		return fmt.Sprintf("%s: inline", s.Field)
+	}
+}
+
+// Line is a line of the directive stream.
+type Line struct {
+	Text   string
+	Source Source
+	// Number is the number of the line in its inline string or ConfigMap value, starting
+	// at 1.
+	Number int
+}
+
+// Stream is the ordered directives of a WAFPolicy.
+type Stream struct {
+	Lines []Line
+}
+
+// String returns the directive text, as loaded by the WAF server.
+func (s *Stream) String() string {
+	var b strings.Builder
+	for _, l := range s.Lines {
+		b.WriteString(l.Text)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// WriteAnnotated writes the directive text to w, with a comment before each chunk of lines
+// from the same source naming the source and the number of its first line. SecLang
+// comments can not follow a directive on the same line.
+func (s *Stream) WriteAnnotated(w io.Writer) error {
+	var prev *Source
+	for i, l := range s.Lines {
+		if prev == nil || !sameSource(*prev, l.Source) {
+			if _, err := fmt.Fprintf(w, "# %s, line %d\n", l.Source, l.Number); err != nil {
+				return err
+			}
+			prev = &s.Lines[i].Source
+		}
+		if _, err := fmt.Fprintln(w, l.Text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sameSource(a, b Source) bool {
+	if a.Field.String() != b.Field.String() || a.Key != b.Key || (a.ConfigMap == nil) != (b.ConfigMap == nil) {
+		return false
+	}
+	return a.ConfigMap == nil || *a.ConfigMap == *b.ConfigMap
+}
+
+var (
+	specPath        = field.NewPath("spec")
+	coreRuleSetPath = specPath.Child("coreRuleSet")
+)
+
+// Assemble returns the directive stream of spec, reading the referenced ConfigMaps with
+// configMaps. Missing ConfigMaps and keys are reported with the path of their reference.
+func Assemble(spec *waf.WAFPolicySpec, configMaps corev1listers.ConfigMapLister) (*Stream, error) {
+	a := &assembler{configMaps: configMaps}
+	a.directiveSource(&spec.RuleEngineSettings, specPath.Child("ruleEngineSettings"))
+	if spec.CoreRuleSet != nil {
+		a.directiveSource(&spec.CoreRuleSet.Settings, coreRuleSetPath.Child("settings"))
+		a.stream.Lines = append(a.stream.Lines, Line{Text: CoreRuleSetInclude, Source: Source{Field: coreRuleSetPath}, Number: 1})
+	}
+	for i := range spec.CustomDirectives {
+		a.directiveSource(&spec.CustomDirectives[i], specPath.Child("customDirectives").Index(i))
+	}
+	if len(a.errs) > 0 {
+		return nil, a.errs.ToAggregate()
+	}
+	return &a.stream, nil
+}
+
+type assembler struct {
+	configMaps corev1listers.ConfigMapLister
+	stream     Stream
+	errs       field.ErrorList
+}
+
+func (a *assembler) directiveSource(ds *waf.DirectiveSource, fldPath *field.Path) {
+	switch {
+	case ds.Inline != nil && ds.ConfigMap != nil:
+		a.errs = append(a.errs, field.Invalid(fldPath, "", "exactly one of inline or configMap must be set"))
+	case ds.Inline != nil:
+		a.add(*ds.Inline, Source{Field: fldPath})
+	case ds.ConfigMap != nil:
+		a.configMap(ds.ConfigMap, fldPath)
+	default:
+		a.errs = append(a.errs, field.Required(fldPath, "exactly one of inline or configMap must be set"))
+	}
+}
+
+func (a *assembler) configMap(ref *waf.ConfigMapRef, fldPath *field.Path) {
+	refPath := fldPath.Child("configMap")
+	if a.configMaps == nil {
+		a.errs = append(a.errs, field.InternalError(refPath, fmt.Errorf("no ConfigMap lister to read ConfigMap %s/%s", ref.Namespace, ref.Name)))
+		return
+	}
+	cm, err := a.configMaps.ConfigMaps(ref.Namespace).Get(ref.Name)
+	if k8serrors.IsNotFound(err) {
+		a.errs = append(a.errs, field.NotFound(refPath, ref.Namespace+"/"+ref.Name))
+		return
+	}
+	if err != nil {
+		a.errs = append(a.errs, field.InternalError(refPath, err))
+		return
+	}
+
+	keys := ref.Keys
+	if len(keys) == 0 {
+		keys = make([]string, 0, len(cm.Data))
+		for key := range cm.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+	}
+	name := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+	for i, key := range keys {
+		value, ok := cm.Data[key]
+		if !ok {
+			a.errs = append(a.errs, field.NotFound(refPath.Child("keys").Index(i), key))
+			continue
+		}
+		a.add(value, Source{Field: fldPath, ConfigMap: &name, Key: key})
+	}
+}
+
+func (a *assembler) add(text string, src Source) {
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return
+	}
+	for i, line := range strings.Split(text, "\n") {
+		a.stream.Lines = append(a.stream.Lines, Line{Text: strings.TrimSuffix(line, "\r"), Source: src, Number: i + 1})
+	}
+}